@@ -19,9 +19,11 @@ import (
 
 	"api-gateway/proto/blog"
 	"api-gateway/proto/follower"
+	"api-gateway/proto/notification"
 	"api-gateway/proto/stakeholders"
 	stakeproto "api-gateway/proto/stakeholders"
 	"api-gateway/utils"
+	"shared/storage"
 )
 
 const (
@@ -81,7 +83,7 @@ func main() {
 		runtime.WithMarshalerOption(runtime.MIMEWildcard, jsonpb),
 		runtime.WithMetadata(func(ctx context.Context, req *http.Request) metadata.MD {
 			// za public rute ne salji
-			if req.URL.Path == "/api/auth/login" || req.URL.Path == "/api/auth/register" {
+			if utils.IsPublicPath(req.URL.Path) {
 				return nil
 			}
 
@@ -123,6 +125,12 @@ func main() {
 		log.Fatalf("failed to register follower service: %v", err)
 	}
 
+	err = notification.RegisterNotificationServiceHandlerFromEndpoint(ctx, mux, "notification-service:8089", opts)
+	// err = notification.RegisterNotificationServiceHandlerFromEndpoint(ctx, mux, "localhost:8089", opts)
+	if err != nil {
+		log.Fatalf("failed to register notification service: %v", err)
+	}
+
 	tourProxy := newReverseProxy("http://tours-service:8083")
 	// tourProxy := newReverseProxy("http://localhost:8083")
 	purchaseProxy := newReverseProxy("http://purchase-service:8088")
@@ -139,6 +147,13 @@ func main() {
 	mux.HandlePath("POST", "/api/keypoints", proxyHandlerFunc(tourProxy))
 	mux.HandlePath("POST", "/api/tours/{tourId}/start", proxyHandlerFunc(tourProxy))
 	mux.HandlePath("POST", "/api/reviews", proxyHandlerFunc(tourProxy))
+	mux.HandlePath("PUT", "/api/reviews/{reviewId}", proxyHandlerFunc(tourProxy))
+	mux.HandlePath("DELETE", "/api/reviews/{reviewId}", proxyHandlerFunc(tourProxy))
+	mux.HandlePath("POST", "/api/reviews/{reviewId}/report", proxyHandlerFunc(tourProxy))
+	mux.HandlePath("POST", "/api/reviews/{reviewId}/reply", proxyHandlerFunc(tourProxy))
+	mux.HandlePath("PUT", "/api/reviews/{reviewId}/reply", proxyHandlerFunc(tourProxy))
+	mux.HandlePath("GET", "/api/admin/review-reports", proxyHandlerFunc(tourProxy))
+	mux.HandlePath("PATCH", "/api/admin/reviews/{reviewId}/moderation", proxyHandlerFunc(tourProxy))
 	mux.HandlePath("GET", "/api/tours/{tourId}/reviews", proxyHandlerFunc(tourProxy))
 	mux.HandlePath("GET", "/api/tours/published", proxyHandlerFunc(tourProxy))
 	mux.HandlePath("GET", "/uploads/{path=**}", proxyHandlerFunc(tourProxy))
@@ -146,12 +161,14 @@ func main() {
 	mux.HandlePath("PUT", "/api/keypoints/{id}", proxyHandlerFunc(tourProxy))
 	mux.HandlePath("DELETE", "/api/keypoints/{id}", proxyHandlerFunc(tourProxy))
 	mux.HandlePath("PATCH", "/api/tour-executions/{tourExecutionId}/status", proxyHandlerFunc(tourProxy))
+	mux.HandlePath("GET", "/api/tour-executions", proxyHandlerFunc(tourProxy))
 	mux.HandlePath("GET", "/api/tour-executions/active", proxyHandlerFunc(tourProxy))
 	mux.HandlePath("POST", "/api/tour-executions/{tourExecutionId}/check-location", proxyHandlerFunc(tourProxy))
 	mux.HandlePath("PATCH", "/api/tours/{tourId}/publish", proxyHandlerFunc(tourProxy))
 	mux.HandlePath("POST", "/api/tours/{tourId}/required-times", proxyHandlerFunc(tourProxy))
 	mux.HandlePath("PATCH", "/api/tours/{tourId}/archive", proxyHandlerFunc(tourProxy))
 	mux.HandlePath("PATCH", "/api/tours/{tourId}/unarchive", proxyHandlerFunc(tourProxy))
+	mux.HandlePath("GET", "/api/tours/{tourId}/execution-stats", proxyHandlerFunc(tourProxy))
 	mux.HandlePath("POST", "/api/shopping-cart/{touristId}", proxyHandlerFunc(purchaseProxy))
 	mux.HandlePath("POST", "/api/shopping-cart/{touristId}/items", proxyHandlerFunc(purchaseProxy))
 	mux.HandlePath("GET", "/api/shopping-cart/{touristId}", proxyHandlerFunc(purchaseProxy))
@@ -187,7 +204,12 @@ func main() {
 	mainRouter.Handle("/", apiHandler)
 
 	// --- 2. Handler za SLIKE (bez JWT provjere) ---
-	var uploadsHandler http.Handler = tourProxy
+	fileStorage, err := storage.NewFromEnv()
+	if err != nil {
+		log.Fatalf("failed to initialize file storage: %v", err)
+	}
+
+	var uploadsHandler http.Handler = utils.UploadsHandler(fileStorage, os.Getenv("UPLOADS_SIGNED_URLS") == "true", tourProxy)
 
 	uploadsHandler = handlers.CORS(originsOk, headersOk, methodsOk)(uploadsHandler)
 	uploadsHandler = loggingHandler(uploadsHandler)
@@ -23,12 +23,16 @@ const (
 )
 
 type CreatePostRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	ImageUrls     []string               `protobuf:"bytes,5,rep,name=imageUrls,proto3" json:"imageUrls,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	UserId      string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Username    string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Title       string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	ImageUrls   []string               `protobuf:"bytes,5,rep,name=imageUrls,proto3" json:"imageUrls,omitempty"`
+	Draft       bool                   `protobuf:"varint,6,opt,name=draft,proto3" json:"draft,omitempty"`
+	TourIds     []string               `protobuf:"bytes,7,rep,name=tourIds,proto3" json:"tourIds,omitempty"`
+	// public (podrazumevano), followers ili private
+	Visibility    string `protobuf:"bytes,8,opt,name=visibility,proto3" json:"visibility,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -98,6 +102,27 @@ func (x *CreatePostRequest) GetImageUrls() []string {
 	return nil
 }
 
+func (x *CreatePostRequest) GetDraft() bool {
+	if x != nil {
+		return x.Draft
+	}
+	return false
+}
+
+func (x *CreatePostRequest) GetTourIds() []string {
+	if x != nil {
+		return x.TourIds
+	}
+	return nil
+}
+
+func (x *CreatePostRequest) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
 type GetPostsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -178,27 +203,30 @@ func (x *GetPostsResponse) GetPosts() []*Post {
 	return nil
 }
 
-type GetPostByIDRequest struct {
+// mode je "recent" (podrazumevano) ili "ranked".
+type GetFeedRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Cursor        string                 `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Mode          string                 `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPostByIDRequest) Reset() {
-	*x = GetPostByIDRequest{}
+func (x *GetFeedRequest) Reset() {
+	*x = GetFeedRequest{}
 	mi := &file_blog_blog_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPostByIDRequest) String() string {
+func (x *GetFeedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostByIDRequest) ProtoMessage() {}
+func (*GetFeedRequest) ProtoMessage() {}
 
-func (x *GetPostByIDRequest) ProtoReflect() protoreflect.Message {
+func (x *GetFeedRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_blog_blog_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -210,40 +238,57 @@ func (x *GetPostByIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostByIDRequest.ProtoReflect.Descriptor instead.
-func (*GetPostByIDRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetFeedRequest) Descriptor() ([]byte, []int) {
 	return file_blog_blog_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetPostByIDRequest) GetId() string {
+func (x *GetFeedRequest) GetCursor() string {
 	if x != nil {
-		return x.Id
+		return x.Cursor
 	}
 	return ""
 }
 
-type UploadImageRequest struct {
+func (x *GetFeedRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetFeedRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+// degraded je true kada follower-service nije dostupan i feed je sastavljen
+// bez svezeg spiska pracenih korisnika.
+type GetFeedResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
-	ImageData     []byte                 `protobuf:"bytes,2,opt,name=imageData,proto3" json:"imageData,omitempty"`
+	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+	Degraded      bool                   `protobuf:"varint,3,opt,name=degraded,proto3" json:"degraded,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadImageRequest) Reset() {
-	*x = UploadImageRequest{}
+func (x *GetFeedResponse) Reset() {
+	*x = GetFeedResponse{}
 	mi := &file_blog_blog_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadImageRequest) String() string {
+func (x *GetFeedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadImageRequest) ProtoMessage() {}
+func (*GetFeedResponse) ProtoMessage() {}
 
-func (x *UploadImageRequest) ProtoReflect() protoreflect.Message {
+func (x *GetFeedResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_blog_blog_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -255,46 +300,55 @@ func (x *UploadImageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadImageRequest.ProtoReflect.Descriptor instead.
-func (*UploadImageRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetFeedResponse.ProtoReflect.Descriptor instead.
+func (*GetFeedResponse) Descriptor() ([]byte, []int) {
 	return file_blog_blog_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *UploadImageRequest) GetFilename() string {
+func (x *GetFeedResponse) GetPosts() []*Post {
 	if x != nil {
-		return x.Filename
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *GetFeedResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
 	}
 	return ""
 }
 
-func (x *UploadImageRequest) GetImageData() []byte {
+func (x *GetFeedResponse) GetDegraded() bool {
 	if x != nil {
-		return x.ImageData
+		return x.Degraded
 	}
-	return nil
+	return false
 }
 
-type UploadImageResponse struct {
+type GetPostsForTourRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	TourId        string                 `protobuf:"bytes,1,opt,name=tourId,proto3" json:"tourId,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadImageResponse) Reset() {
-	*x = UploadImageResponse{}
+func (x *GetPostsForTourRequest) Reset() {
+	*x = GetPostsForTourRequest{}
 	mi := &file_blog_blog_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadImageResponse) String() string {
+func (x *GetPostsForTourRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadImageResponse) ProtoMessage() {}
+func (*GetPostsForTourRequest) ProtoMessage() {}
 
-func (x *UploadImageResponse) ProtoReflect() protoreflect.Message {
+func (x *GetPostsForTourRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_blog_blog_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -306,41 +360,978 @@ func (x *UploadImageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadImageResponse.ProtoReflect.Descriptor instead.
-func (*UploadImageResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPostsForTourRequest.ProtoReflect.Descriptor instead.
+func (*GetPostsForTourRequest) Descriptor() ([]byte, []int) {
 	return file_blog_blog_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *UploadImageResponse) GetUrl() string {
+func (x *GetPostsForTourRequest) GetTourId() string {
 	if x != nil {
-		return x.Url
+		return x.TourId
 	}
 	return ""
 }
 
-type ToggleLikeRequest struct {
+func (x *GetPostsForTourRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetPostsForTourRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetPostsForTourResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PostId        string                 `protobuf:"bytes,1,opt,name=postId,proto3" json:"postId,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ToggleLikeRequest) Reset() {
-	*x = ToggleLikeRequest{}
+func (x *GetPostsForTourResponse) Reset() {
+	*x = GetPostsForTourResponse{}
 	mi := &file_blog_blog_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ToggleLikeRequest) String() string {
+func (x *GetPostsForTourResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostsForTourResponse) ProtoMessage() {}
+
+func (x *GetPostsForTourResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_blog_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostsForTourResponse.ProtoReflect.Descriptor instead.
+func (*GetPostsForTourResponse) Descriptor() ([]byte, []int) {
+	return file_blog_blog_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetPostsForTourResponse) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *GetPostsForTourResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type GetPostByIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostByIDRequest) Reset() {
+	*x = GetPostByIDRequest{}
+	mi := &file_blog_blog_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostByIDRequest) ProtoMessage() {}
+
+func (x *GetPostByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_blog_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostByIDRequest.ProtoReflect.Descriptor instead.
+func (*GetPostByIDRequest) Descriptor() ([]byte, []int) {
+	return file_blog_blog_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetPostByIDRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdatePostRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ImageUrls   []string               `protobuf:"bytes,4,rep,name=imageUrls,proto3" json:"imageUrls,omitempty"`
+	// Prazna vrednost ne menja vidljivost posta
+	Visibility string `protobuf:"bytes,5,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	// Ture na koje se post odnosi; prazan spisak uklanja sve reference
+	TourIds       []string `protobuf:"bytes,6,rep,name=tourIds,proto3" json:"tourIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePostRequest) Reset() {
+	*x = UpdatePostRequest{}
+	mi := &file_blog_blog_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePostRequest) ProtoMessage() {}
+
+func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_blog_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePostRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePostRequest) Descriptor() ([]byte, []int) {
+	return file_blog_blog_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdatePostRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdatePostRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdatePostRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdatePostRequest) GetImageUrls() []string {
+	if x != nil {
+		return x.ImageUrls
+	}
+	return nil
+}
+
+func (x *UpdatePostRequest) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *UpdatePostRequest) GetTourIds() []string {
+	if x != nil {
+		return x.TourIds
+	}
+	return nil
+}
+
+type DeletePostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePostRequest) Reset() {
+	*x = DeletePostRequest{}
+	mi := &file_blog_blog_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostRequest) ProtoMessage() {}
+
+func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_blog_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
+func (*DeletePostRequest) Descriptor() ([]byte, []int) {
+	return file_blog_blog_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeletePostRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeletePostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePostResponse) Reset() {
+	*x = DeletePostResponse{}
+	mi := &file_blog_blog_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostResponse) ProtoMessage() {}
+
+func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_blog_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostResponse.ProtoReflect.Descriptor instead.
+func (*DeletePostResponse) Descriptor() ([]byte, []int) {
+	return file_blog_blog_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeletePostResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type PublishPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishPostRequest) Reset() {
+	*x = PublishPostRequest{}
+	mi := &file_blog_blog_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishPostRequest) ProtoMessage() {}
+
+func (x *PublishPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_blog_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishPostRequest.ProtoReflect.Descriptor instead.
+func (*PublishPostRequest) Descriptor() ([]byte, []int) {
+	return file_blog_blog_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PublishPostRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetPostHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostHistoryRequest) Reset() {
+	*x = GetPostHistoryRequest{}
+	mi := &file_blog_blog_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostHistoryRequest) ProtoMessage() {}
+
+func (x *GetPostHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_blog_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetPostHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_blog_blog_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetPostHistoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetPostHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*PostRevision        `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostHistoryResponse) Reset() {
+	*x = GetPostHistoryResponse{}
+	mi := &file_blog_blog_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostHistoryResponse) ProtoMessage() {}
+
+func (x *GetPostHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_blog_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetPostHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_blog_blog_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetPostHistoryResponse) GetRevisions() []*PostRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type GetMyDraftsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyDraftsRequest) Reset() {
+	*x = GetMyDraftsRequest{}
+	mi := &file_blog_blog_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyDraftsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyDraftsRequest) ProtoMessage() {}
+
+func (x *GetMyDraftsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_blog_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyDraftsRequest.ProtoReflect.Descriptor instead.
+func (*GetMyDraftsRequest) Descriptor() ([]byte, []int) {
+	return file_blog_blog_proto_rawDescGZIP(), []int{14}
+}
+
+type PostRevision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PostId        string                 `protobuf:"bytes,2,opt,name=postId,proto3" json:"postId,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	ImageUrls     []string               `protobuf:"bytes,5,rep,name=imageUrls,proto3" json:"imageUrls,omitempty"`
+	EditedAt      string                 `protobuf:"bytes,6,opt,name=editedAt,proto3" json:"editedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostRevision) Reset() {
+	*x = PostRevision{}
+	mi := &file_blog_blog_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostRevision) ProtoMessage() {}
+
+func (x *PostRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_blog_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostRevision.ProtoReflect.Descriptor instead.
+func (*PostRevision) Descriptor() ([]byte, []int) {
+	return file_blog_blog_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PostRevision) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PostRevision) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *PostRevision) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PostRevision) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PostRevision) GetImageUrls() []string {
+	if x != nil {
+		return x.ImageUrls
+	}
+	return nil
+}
+
+func (x *PostRevision) GetEditedAt() string {
+	if x != nil {
+		return x.EditedAt
+	}
+	return ""
+}
+
+type UploadImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	ImageData     []byte                 `protobuf:"bytes,2,opt,name=imageData,proto3" json:"imageData,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadImageRequest) Reset() {
+	*x = UploadImageRequest{}
+	mi := &file_blog_blog_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadImageRequest) ProtoMessage() {}
+
+func (x *UploadImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_blog_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadImageRequest.ProtoReflect.Descriptor instead.
+func (*UploadImageRequest) Descriptor() ([]byte, []int) {
+	return file_blog_blog_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UploadImageRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *UploadImageRequest) GetImageData() []byte {
+	if x != nil {
+		return x.ImageData
+	}
+	return nil
+}
+
+type UploadImageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadImageResponse) Reset() {
+	*x = UploadImageResponse{}
+	mi := &file_blog_blog_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadImageResponse) ProtoMessage() {}
+
+func (x *UploadImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_blog_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadImageResponse.ProtoReflect.Descriptor instead.
+func (*UploadImageResponse) Descriptor() ([]byte, []int) {
+	return file_blog_blog_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UploadImageResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// userId se ignorise; korisnik se uzima iz tokena.
+type ToggleLikeRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	PostId string                 `protobuf:"bytes,1,opt,name=postId,proto3" json:"postId,omitempty"`
+	// Deprecated: Marked as deprecated in blog/blog.proto.
+	UserId        string `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToggleLikeRequest) Reset() {
+	*x = ToggleLikeRequest{}
+	mi := &file_blog_blog_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToggleLikeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleLikeRequest) ProtoMessage() {}
+
+func (x *ToggleLikeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_blog_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleLikeRequest.ProtoReflect.Descriptor instead.
+func (*ToggleLikeRequest) Descriptor() ([]byte, []int) {
+	return file_blog_blog_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ToggleLikeRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in blog/blog.proto.
+func (x *ToggleLikeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ToggleLikeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	LikesCount    int32                  `protobuf:"varint,2,opt,name=likesCount,proto3" json:"likesCount,omitempty"`
+	Liked         bool                   `protobuf:"varint,3,opt,name=liked,proto3" json:"liked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToggleLikeResponse) Reset() {
+	*x = ToggleLikeResponse{}
+	mi := &file_blog_blog_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToggleLikeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleLikeResponse) ProtoMessage() {}
+
+func (x *ToggleLikeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_blog_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleLikeResponse.ProtoReflect.Descriptor instead.
+func (*ToggleLikeResponse) Descriptor() ([]byte, []int) {
+	return file_blog_blog_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ToggleLikeResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ToggleLikeResponse) GetLikesCount() int32 {
+	if x != nil {
+		return x.LikesCount
+	}
+	return 0
+}
+
+func (x *ToggleLikeResponse) GetLiked() bool {
+	if x != nil {
+		return x.Liked
+	}
+	return false
+}
+
+type GetLikesForPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=postId,proto3" json:"postId,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLikesForPostRequest) Reset() {
+	*x = GetLikesForPostRequest{}
+	mi := &file_blog_blog_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLikesForPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLikesForPostRequest) ProtoMessage() {}
+
+func (x *GetLikesForPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_blog_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLikesForPostRequest.ProtoReflect.Descriptor instead.
+func (*GetLikesForPostRequest) Descriptor() ([]byte, []int) {
+	return file_blog_blog_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetLikesForPostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *GetLikesForPostRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetLikesForPostRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetLikesForPostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Likes         []*Like                `protobuf:"bytes,1,rep,name=likes,proto3" json:"likes,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+	LikesCount    int32                  `protobuf:"varint,3,opt,name=likesCount,proto3" json:"likesCount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLikesForPostResponse) Reset() {
+	*x = GetLikesForPostResponse{}
+	mi := &file_blog_blog_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLikesForPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLikesForPostResponse) ProtoMessage() {}
+
+func (x *GetLikesForPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_blog_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLikesForPostResponse.ProtoReflect.Descriptor instead.
+func (*GetLikesForPostResponse) Descriptor() ([]byte, []int) {
+	return file_blog_blog_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetLikesForPostResponse) GetLikes() []*Like {
+	if x != nil {
+		return x.Likes
+	}
+	return nil
+}
+
+func (x *GetLikesForPostResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetLikesForPostResponse) GetLikesCount() int32 {
+	if x != nil {
+		return x.LikesCount
+	}
+	return 0
+}
+
+// Bez parentId vracaju se komentari prvog nivoa, a sa njim odgovori na taj
+// komentar. Sledeca stranica se trazi sa nextCursor iz prethodnog odgovora.
+type GetCommentsForPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=postId,proto3" json:"postId,omitempty"`
+	ParentId      string                 `protobuf:"bytes,2,opt,name=parentId,proto3" json:"parentId,omitempty"`
+	Cursor        string                 `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommentsForPostRequest) Reset() {
+	*x = GetCommentsForPostRequest{}
+	mi := &file_blog_blog_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommentsForPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentsForPostRequest) ProtoMessage() {}
+
+func (x *GetCommentsForPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_blog_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentsForPostRequest.ProtoReflect.Descriptor instead.
+func (*GetCommentsForPostRequest) Descriptor() ([]byte, []int) {
+	return file_blog_blog_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetCommentsForPostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *GetCommentsForPostRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *GetCommentsForPostRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetCommentsForPostRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetCommentsForPostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comments      []*Comment             `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommentsForPostResponse) Reset() {
+	*x = GetCommentsForPostResponse{}
+	mi := &file_blog_blog_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommentsForPostResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToggleLikeRequest) ProtoMessage() {}
+func (*GetCommentsForPostResponse) ProtoMessage() {}
 
-func (x *ToggleLikeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_blog_blog_proto_msgTypes[6]
+func (x *GetCommentsForPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_blog_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -351,48 +1342,48 @@ func (x *ToggleLikeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToggleLikeRequest.ProtoReflect.Descriptor instead.
-func (*ToggleLikeRequest) Descriptor() ([]byte, []int) {
-	return file_blog_blog_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use GetCommentsForPostResponse.ProtoReflect.Descriptor instead.
+func (*GetCommentsForPostResponse) Descriptor() ([]byte, []int) {
+	return file_blog_blog_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *ToggleLikeRequest) GetPostId() string {
+func (x *GetCommentsForPostResponse) GetComments() []*Comment {
 	if x != nil {
-		return x.PostId
+		return x.Comments
 	}
-	return ""
+	return nil
 }
 
-func (x *ToggleLikeRequest) GetUserId() string {
+func (x *GetCommentsForPostResponse) GetNextCursor() string {
 	if x != nil {
-		return x.UserId
+		return x.NextCursor
 	}
 	return ""
 }
 
-type ToggleLikeResponse struct {
+type UpdateCommentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	LikesCount    int32                  `protobuf:"varint,2,opt,name=likesCount,proto3" json:"likesCount,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ToggleLikeResponse) Reset() {
-	*x = ToggleLikeResponse{}
-	mi := &file_blog_blog_proto_msgTypes[7]
+func (x *UpdateCommentRequest) Reset() {
+	*x = UpdateCommentRequest{}
+	mi := &file_blog_blog_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ToggleLikeResponse) String() string {
+func (x *UpdateCommentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToggleLikeResponse) ProtoMessage() {}
+func (*UpdateCommentRequest) ProtoMessage() {}
 
-func (x *ToggleLikeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_blog_blog_proto_msgTypes[7]
+func (x *UpdateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_blog_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -403,47 +1394,47 @@ func (x *ToggleLikeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToggleLikeResponse.ProtoReflect.Descriptor instead.
-func (*ToggleLikeResponse) Descriptor() ([]byte, []int) {
-	return file_blog_blog_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use UpdateCommentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_blog_blog_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ToggleLikeResponse) GetStatus() string {
+func (x *UpdateCommentRequest) GetId() string {
 	if x != nil {
-		return x.Status
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ToggleLikeResponse) GetLikesCount() int32 {
+func (x *UpdateCommentRequest) GetText() string {
 	if x != nil {
-		return x.LikesCount
+		return x.Text
 	}
-	return 0
+	return ""
 }
 
-type GetCommentsForPostRequest struct {
+type DeleteCommentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PostId        string                 `protobuf:"bytes,1,opt,name=postId,proto3" json:"postId,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCommentsForPostRequest) Reset() {
-	*x = GetCommentsForPostRequest{}
-	mi := &file_blog_blog_proto_msgTypes[8]
+func (x *DeleteCommentRequest) Reset() {
+	*x = DeleteCommentRequest{}
+	mi := &file_blog_blog_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCommentsForPostRequest) String() string {
+func (x *DeleteCommentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommentsForPostRequest) ProtoMessage() {}
+func (*DeleteCommentRequest) ProtoMessage() {}
 
-func (x *GetCommentsForPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_blog_blog_proto_msgTypes[8]
+func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_blog_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -454,40 +1445,40 @@ func (x *GetCommentsForPostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommentsForPostRequest.ProtoReflect.Descriptor instead.
-func (*GetCommentsForPostRequest) Descriptor() ([]byte, []int) {
-	return file_blog_blog_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+	return file_blog_blog_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *GetCommentsForPostRequest) GetPostId() string {
+func (x *DeleteCommentRequest) GetId() string {
 	if x != nil {
-		return x.PostId
+		return x.Id
 	}
 	return ""
 }
 
-type GetCommentsForPostResponse struct {
+type DeleteCommentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Comments      []*Comment             `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCommentsForPostResponse) Reset() {
-	*x = GetCommentsForPostResponse{}
-	mi := &file_blog_blog_proto_msgTypes[9]
+func (x *DeleteCommentResponse) Reset() {
+	*x = DeleteCommentResponse{}
+	mi := &file_blog_blog_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCommentsForPostResponse) String() string {
+func (x *DeleteCommentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommentsForPostResponse) ProtoMessage() {}
+func (*DeleteCommentResponse) ProtoMessage() {}
 
-func (x *GetCommentsForPostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_blog_blog_proto_msgTypes[9]
+func (x *DeleteCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_blog_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -498,16 +1489,16 @@ func (x *GetCommentsForPostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommentsForPostResponse.ProtoReflect.Descriptor instead.
-func (*GetCommentsForPostResponse) Descriptor() ([]byte, []int) {
-	return file_blog_blog_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use DeleteCommentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCommentResponse) Descriptor() ([]byte, []int) {
+	return file_blog_blog_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GetCommentsForPostResponse) GetComments() []*Comment {
+func (x *DeleteCommentResponse) GetStatus() string {
 	if x != nil {
-		return x.Comments
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
 type AddCommentToPostRequest struct {
@@ -516,13 +1507,14 @@ type AddCommentToPostRequest struct {
 	UserId        string                 `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
 	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
 	Text          string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	ParentId      string                 `protobuf:"bytes,5,opt,name=parentId,proto3" json:"parentId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AddCommentToPostRequest) Reset() {
 	*x = AddCommentToPostRequest{}
-	mi := &file_blog_blog_proto_msgTypes[10]
+	mi := &file_blog_blog_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -534,7 +1526,7 @@ func (x *AddCommentToPostRequest) String() string {
 func (*AddCommentToPostRequest) ProtoMessage() {}
 
 func (x *AddCommentToPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_blog_blog_proto_msgTypes[10]
+	mi := &file_blog_blog_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -547,7 +1539,7 @@ func (x *AddCommentToPostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddCommentToPostRequest.ProtoReflect.Descriptor instead.
 func (*AddCommentToPostRequest) Descriptor() ([]byte, []int) {
-	return file_blog_blog_proto_rawDescGZIP(), []int{10}
+	return file_blog_blog_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *AddCommentToPostRequest) GetPostId() string {
@@ -578,6 +1570,13 @@ func (x *AddCommentToPostRequest) GetText() string {
 	return ""
 }
 
+func (x *AddCommentToPostRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
 type Comment struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -587,13 +1586,20 @@ type Comment struct {
 	Text          string                 `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	UpdatedAt     string                 `protobuf:"bytes,7,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	TextHtml      string                 `protobuf:"bytes,8,opt,name=textHtml,proto3" json:"textHtml,omitempty"`
+	Mentions      []string               `protobuf:"bytes,9,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	Hashtags      []string               `protobuf:"bytes,10,rep,name=hashtags,proto3" json:"hashtags,omitempty"`
+	ParentId      string                 `protobuf:"bytes,11,opt,name=parentId,proto3" json:"parentId,omitempty"`
+	Depth         int32                  `protobuf:"varint,12,opt,name=depth,proto3" json:"depth,omitempty"`
+	ReplyCount    int32                  `protobuf:"varint,13,opt,name=replyCount,proto3" json:"replyCount,omitempty"`
+	Deleted       bool                   `protobuf:"varint,14,opt,name=deleted,proto3" json:"deleted,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Comment) Reset() {
 	*x = Comment{}
-	mi := &file_blog_blog_proto_msgTypes[11]
+	mi := &file_blog_blog_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -605,7 +1611,7 @@ func (x *Comment) String() string {
 func (*Comment) ProtoMessage() {}
 
 func (x *Comment) ProtoReflect() protoreflect.Message {
-	mi := &file_blog_blog_proto_msgTypes[11]
+	mi := &file_blog_blog_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -618,7 +1624,7 @@ func (x *Comment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Comment.ProtoReflect.Descriptor instead.
 func (*Comment) Descriptor() ([]byte, []int) {
-	return file_blog_blog_proto_rawDescGZIP(), []int{11}
+	return file_blog_blog_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *Comment) GetId() string {
@@ -670,19 +1676,69 @@ func (x *Comment) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *Comment) GetTextHtml() string {
+	if x != nil {
+		return x.TextHtml
+	}
+	return ""
+}
+
+func (x *Comment) GetMentions() []string {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+func (x *Comment) GetHashtags() []string {
+	if x != nil {
+		return x.Hashtags
+	}
+	return nil
+}
+
+func (x *Comment) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Comment) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *Comment) GetReplyCount() int32 {
+	if x != nil {
+		return x.ReplyCount
+	}
+	return 0
+}
+
+func (x *Comment) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
 type Like struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	PostId        string                 `protobuf:"bytes,2,opt,name=postId,proto3" json:"postId,omitempty"`
 	UserId        string                 `protobuf:"bytes,3,opt,name=userId,proto3" json:"userId,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Username      string                 `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Like) Reset() {
 	*x = Like{}
-	mi := &file_blog_blog_proto_msgTypes[12]
+	mi := &file_blog_blog_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -694,7 +1750,7 @@ func (x *Like) String() string {
 func (*Like) ProtoMessage() {}
 
 func (x *Like) ProtoReflect() protoreflect.Message {
-	mi := &file_blog_blog_proto_msgTypes[12]
+	mi := &file_blog_blog_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -707,7 +1763,7 @@ func (x *Like) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Like.ProtoReflect.Descriptor instead.
 func (*Like) Descriptor() ([]byte, []int) {
-	return file_blog_blog_proto_rawDescGZIP(), []int{12}
+	return file_blog_blog_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *Like) GetId() string {
@@ -738,23 +1794,39 @@ func (x *Like) GetCreatedAt() string {
 	return ""
 }
 
+func (x *Like) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
 type Post struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
-	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	ImageUrls     []string               `protobuf:"bytes,7,rep,name=imageUrls,proto3" json:"imageUrls,omitempty"`
-	LikesCount    int32                  `protobuf:"varint,8,opt,name=likesCount,proto3" json:"likesCount,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId          string                 `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	Username        string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Title           string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description     string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt       string                 `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	ImageUrls       []string               `protobuf:"bytes,7,rep,name=imageUrls,proto3" json:"imageUrls,omitempty"`
+	LikesCount      int32                  `protobuf:"varint,8,opt,name=likesCount,proto3" json:"likesCount,omitempty"`
+	Status          string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	UpdatedAt       string                 `protobuf:"bytes,10,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	PublishedAt     string                 `protobuf:"bytes,11,opt,name=publishedAt,proto3" json:"publishedAt,omitempty"`
+	DescriptionHtml string                 `protobuf:"bytes,12,opt,name=descriptionHtml,proto3" json:"descriptionHtml,omitempty"`
+	Mentions        []string               `protobuf:"bytes,13,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	Hashtags        []string               `protobuf:"bytes,14,rep,name=hashtags,proto3" json:"hashtags,omitempty"`
+	TourIds         []string               `protobuf:"bytes,15,rep,name=tourIds,proto3" json:"tourIds,omitempty"`
+	Tours           []*TourSummary         `protobuf:"bytes,16,rep,name=tours,proto3" json:"tours,omitempty"`
+	Visibility      string                 `protobuf:"bytes,17,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Post) Reset() {
 	*x = Post{}
-	mi := &file_blog_blog_proto_msgTypes[13]
+	mi := &file_blog_blog_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -766,7 +1838,7 @@ func (x *Post) String() string {
 func (*Post) ProtoMessage() {}
 
 func (x *Post) ProtoReflect() protoreflect.Message {
-	mi := &file_blog_blog_proto_msgTypes[13]
+	mi := &file_blog_blog_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -779,7 +1851,7 @@ func (x *Post) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Post.ProtoReflect.Descriptor instead.
 func (*Post) Descriptor() ([]byte, []int) {
-	return file_blog_blog_proto_rawDescGZIP(), []int{13}
+	return file_blog_blog_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *Post) GetId() string {
@@ -838,45 +1910,267 @@ func (x *Post) GetLikesCount() int32 {
 	return 0
 }
 
+func (x *Post) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Post) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Post) GetPublishedAt() string {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return ""
+}
+
+func (x *Post) GetDescriptionHtml() string {
+	if x != nil {
+		return x.DescriptionHtml
+	}
+	return ""
+}
+
+func (x *Post) GetMentions() []string {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+func (x *Post) GetHashtags() []string {
+	if x != nil {
+		return x.Hashtags
+	}
+	return nil
+}
+
+func (x *Post) GetTourIds() []string {
+	if x != nil {
+		return x.TourIds
+	}
+	return nil
+}
+
+func (x *Post) GetTours() []*TourSummary {
+	if x != nil {
+		return x.Tours
+	}
+	return nil
+}
+
+func (x *Post) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+// TourSummary je sazetak ture iz tours-service. Ako tura vise nije
+// dostupna, u postu ostaje samo njen ID.
+type TourSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Difficulty    string                 `protobuf:"bytes,3,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	AverageRating float64                `protobuf:"fixed64,4,opt,name=averageRating,proto3" json:"averageRating,omitempty"`
+	RatingCount   int32                  `protobuf:"varint,5,opt,name=ratingCount,proto3" json:"ratingCount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TourSummary) Reset() {
+	*x = TourSummary{}
+	mi := &file_blog_blog_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TourSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TourSummary) ProtoMessage() {}
+
+func (x *TourSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_blog_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TourSummary.ProtoReflect.Descriptor instead.
+func (*TourSummary) Descriptor() ([]byte, []int) {
+	return file_blog_blog_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *TourSummary) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TourSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TourSummary) GetDifficulty() string {
+	if x != nil {
+		return x.Difficulty
+	}
+	return ""
+}
+
+func (x *TourSummary) GetAverageRating() float64 {
+	if x != nil {
+		return x.AverageRating
+	}
+	return 0
+}
+
+func (x *TourSummary) GetRatingCount() int32 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
 var File_blog_blog_proto protoreflect.FileDescriptor
 
 const file_blog_blog_proto_rawDesc = "" +
 	"\n" +
-	"\x0fblog/blog.proto\x12\x04blog\x1a\x1cgoogle/api/annotations.proto\x1a\x15google/api/http.proto\"\x9d\x01\n" +
+	"\x0fblog/blog.proto\x12\x04blog\x1a\x1cgoogle/api/annotations.proto\x1a\x15google/api/http.proto\"\xed\x01\n" +
 	"\x11CreatePostRequest\x12\x16\n" +
 	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
 	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1c\n" +
-	"\timageUrls\x18\x05 \x03(\tR\timageUrls\"\x11\n" +
+	"\timageUrls\x18\x05 \x03(\tR\timageUrls\x12\x14\n" +
+	"\x05draft\x18\x06 \x01(\bR\x05draft\x12\x18\n" +
+	"\atourIds\x18\a \x03(\tR\atourIds\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\b \x01(\tR\n" +
+	"visibility\"\x11\n" +
 	"\x0fGetPostsRequest\"4\n" +
 	"\x10GetPostsResponse\x12 \n" +
 	"\x05posts\x18\x01 \x03(\v2\n" +
-	".blog.PostR\x05posts\"$\n" +
+	".blog.PostR\x05posts\"R\n" +
+	"\x0eGetFeedRequest\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x12\n" +
+	"\x04mode\x18\x03 \x01(\tR\x04mode\"o\n" +
+	"\x0fGetFeedResponse\x12 \n" +
+	"\x05posts\x18\x01 \x03(\v2\n" +
+	".blog.PostR\x05posts\x12\x1e\n" +
+	"\n" +
+	"nextCursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x1a\n" +
+	"\bdegraded\x18\x03 \x01(\bR\bdegraded\"^\n" +
+	"\x16GetPostsForTourRequest\x12\x16\n" +
+	"\x06tourId\x18\x01 \x01(\tR\x06tourId\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"[\n" +
+	"\x17GetPostsForTourResponse\x12 \n" +
+	"\x05posts\x18\x01 \x03(\v2\n" +
+	".blog.PostR\x05posts\x12\x1e\n" +
+	"\n" +
+	"nextCursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"$\n" +
 	"\x12GetPostByIDRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"N\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xb3\x01\n" +
+	"\x11UpdatePostRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1c\n" +
+	"\timageUrls\x18\x04 \x03(\tR\timageUrls\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x05 \x01(\tR\n" +
+	"visibility\x12\x18\n" +
+	"\atourIds\x18\x06 \x03(\tR\atourIds\"#\n" +
+	"\x11DeletePostRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\",\n" +
+	"\x12DeletePostResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"$\n" +
+	"\x12PublishPostRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"'\n" +
+	"\x15GetPostHistoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"J\n" +
+	"\x16GetPostHistoryResponse\x120\n" +
+	"\trevisions\x18\x01 \x03(\v2\x12.blog.PostRevisionR\trevisions\"\x14\n" +
+	"\x12GetMyDraftsRequest\"\xa8\x01\n" +
+	"\fPostRevision\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06postId\x18\x02 \x01(\tR\x06postId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1c\n" +
+	"\timageUrls\x18\x05 \x03(\tR\timageUrls\x12\x1a\n" +
+	"\beditedAt\x18\x06 \x01(\tR\beditedAt\"N\n" +
 	"\x12UploadImageRequest\x12\x1a\n" +
 	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x1c\n" +
 	"\timageData\x18\x02 \x01(\fR\timageData\"'\n" +
 	"\x13UploadImageResponse\x12\x10\n" +
-	"\x03url\x18\x01 \x01(\tR\x03url\"C\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"G\n" +
 	"\x11ToggleLikeRequest\x12\x16\n" +
-	"\x06postId\x18\x01 \x01(\tR\x06postId\x12\x16\n" +
-	"\x06userId\x18\x02 \x01(\tR\x06userId\"L\n" +
+	"\x06postId\x18\x01 \x01(\tR\x06postId\x12\x1a\n" +
+	"\x06userId\x18\x02 \x01(\tB\x02\x18\x01R\x06userId\"b\n" +
 	"\x12ToggleLikeResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1e\n" +
 	"\n" +
 	"likesCount\x18\x02 \x01(\x05R\n" +
-	"likesCount\"3\n" +
+	"likesCount\x12\x14\n" +
+	"\x05liked\x18\x03 \x01(\bR\x05liked\"^\n" +
+	"\x16GetLikesForPostRequest\x12\x16\n" +
+	"\x06postId\x18\x01 \x01(\tR\x06postId\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"{\n" +
+	"\x17GetLikesForPostResponse\x12 \n" +
+	"\x05likes\x18\x01 \x03(\v2\n" +
+	".blog.LikeR\x05likes\x12\x1e\n" +
+	"\n" +
+	"nextCursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x1e\n" +
+	"\n" +
+	"likesCount\x18\x03 \x01(\x05R\n" +
+	"likesCount\"}\n" +
 	"\x19GetCommentsForPostRequest\x12\x16\n" +
-	"\x06postId\x18\x01 \x01(\tR\x06postId\"G\n" +
+	"\x06postId\x18\x01 \x01(\tR\x06postId\x12\x1a\n" +
+	"\bparentId\x18\x02 \x01(\tR\bparentId\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"g\n" +
 	"\x1aGetCommentsForPostResponse\x12)\n" +
-	"\bcomments\x18\x01 \x03(\v2\r.blog.CommentR\bcomments\"y\n" +
+	"\bcomments\x18\x01 \x03(\v2\r.blog.CommentR\bcomments\x12\x1e\n" +
+	"\n" +
+	"nextCursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\":\n" +
+	"\x14UpdateCommentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"&\n" +
+	"\x14DeleteCommentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"/\n" +
+	"\x15DeleteCommentResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"\x95\x01\n" +
 	"\x17AddCommentToPostRequest\x12\x16\n" +
 	"\x06postId\x18\x01 \x01(\tR\x06postId\x12\x16\n" +
 	"\x06userId\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
 	"\busername\x18\x03 \x01(\tR\busername\x12\x12\n" +
-	"\x04text\x18\x04 \x01(\tR\x04text\"\xb5\x01\n" +
+	"\x04text\x18\x04 \x01(\tR\x04text\x12\x1a\n" +
+	"\bparentId\x18\x05 \x01(\tR\bparentId\"\xf5\x02\n" +
 	"\aComment\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
 	"\x06postId\x18\x02 \x01(\tR\x06postId\x12\x16\n" +
@@ -884,12 +2178,23 @@ const file_blog_blog_proto_rawDesc = "" +
 	"\busername\x18\x04 \x01(\tR\busername\x12\x12\n" +
 	"\x04text\x18\x05 \x01(\tR\x04text\x12\x1c\n" +
 	"\tcreatedAt\x18\x06 \x01(\tR\tcreatedAt\x12\x1c\n" +
-	"\tupdatedAt\x18\a \x01(\tR\tupdatedAt\"d\n" +
+	"\tupdatedAt\x18\a \x01(\tR\tupdatedAt\x12\x1a\n" +
+	"\btextHtml\x18\b \x01(\tR\btextHtml\x12\x1a\n" +
+	"\bmentions\x18\t \x03(\tR\bmentions\x12\x1a\n" +
+	"\bhashtags\x18\n" +
+	" \x03(\tR\bhashtags\x12\x1a\n" +
+	"\bparentId\x18\v \x01(\tR\bparentId\x12\x14\n" +
+	"\x05depth\x18\f \x01(\x05R\x05depth\x12\x1e\n" +
+	"\n" +
+	"replyCount\x18\r \x01(\x05R\n" +
+	"replyCount\x12\x18\n" +
+	"\adeleted\x18\x0e \x01(\bR\adeleted\"\x80\x01\n" +
 	"\x04Like\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
 	"\x06postId\x18\x02 \x01(\tR\x06postId\x12\x16\n" +
 	"\x06userId\x18\x03 \x01(\tR\x06userId\x12\x1c\n" +
-	"\tcreatedAt\x18\x04 \x01(\tR\tcreatedAt\"\xde\x01\n" +
+	"\tcreatedAt\x18\x04 \x01(\tR\tcreatedAt\x12\x1a\n" +
+	"\busername\x18\x05 \x01(\tR\busername\"\xfb\x03\n" +
 	"\x04Post\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
 	"\x06userId\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
@@ -900,19 +2205,53 @@ const file_blog_blog_proto_rawDesc = "" +
 	"\timageUrls\x18\a \x03(\tR\timageUrls\x12\x1e\n" +
 	"\n" +
 	"likesCount\x18\b \x01(\x05R\n" +
-	"likesCount2\x8a\x05\n" +
+	"likesCount\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12\x1c\n" +
+	"\tupdatedAt\x18\n" +
+	" \x01(\tR\tupdatedAt\x12 \n" +
+	"\vpublishedAt\x18\v \x01(\tR\vpublishedAt\x12(\n" +
+	"\x0fdescriptionHtml\x18\f \x01(\tR\x0fdescriptionHtml\x12\x1a\n" +
+	"\bmentions\x18\r \x03(\tR\bmentions\x12\x1a\n" +
+	"\bhashtags\x18\x0e \x03(\tR\bhashtags\x12\x18\n" +
+	"\atourIds\x18\x0f \x03(\tR\atourIds\x12'\n" +
+	"\x05tours\x18\x10 \x03(\v2\x11.blog.TourSummaryR\x05tours\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x11 \x01(\tR\n" +
+	"visibility\"\x99\x01\n" +
+	"\vTourSummary\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"difficulty\x18\x03 \x01(\tR\n" +
+	"difficulty\x12$\n" +
+	"\raverageRating\x18\x04 \x01(\x01R\raverageRating\x12 \n" +
+	"\vratingCount\x18\x05 \x01(\x05R\vratingCount2\xa0\f\n" +
 	"\vBlogService\x12D\n" +
 	"\n" +
 	"CreatePost\x12\x17.blog.CreatePostRequest\x1a\n" +
 	".blog.Post\"\x11\x82\xd3\xe4\x93\x02\v:\x01*\"\x06/posts\x12I\n" +
-	"\bGetPosts\x12\x15.blog.GetPostsRequest\x1a\x16.blog.GetPostsResponse\"\x0e\x82\xd3\xe4\x93\x02\b\x12\x06/posts\x12H\n" +
+	"\bGetPosts\x12\x15.blog.GetPostsRequest\x1a\x16.blog.GetPostsResponse\"\x0e\x82\xd3\xe4\x93\x02\b\x12\x06/posts\x12E\n" +
+	"\aGetFeed\x12\x14.blog.GetFeedRequest\x1a\x15.blog.GetFeedResponse\"\r\x82\xd3\xe4\x93\x02\a\x12\x05/feed\x12H\n" +
 	"\vGetPostByID\x12\x18.blog.GetPostByIDRequest\x1a\n" +
-	".blog.Post\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/posts/{id}\x12\\\n" +
+	".blog.Post\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/posts/{id}\x12I\n" +
+	"\n" +
+	"UpdatePost\x12\x17.blog.UpdatePostRequest\x1a\n" +
+	".blog.Post\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\x1a\v/posts/{id}\x12T\n" +
+	"\n" +
+	"DeletePost\x12\x17.blog.DeletePostRequest\x1a\x18.blog.DeletePostResponse\"\x13\x82\xd3\xe4\x93\x02\r*\v/posts/{id}\x12S\n" +
+	"\vPublishPost\x12\x18.blog.PublishPostRequest\x1a\n" +
+	".blog.Post\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/posts/{id}/publish\x12h\n" +
+	"\x0eGetPostHistory\x12\x1b.blog.GetPostHistoryRequest\x1a\x1c.blog.GetPostHistoryResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/posts/{id}/history\x12V\n" +
+	"\vGetMyDrafts\x12\x18.blog.GetMyDraftsRequest\x1a\x16.blog.GetPostsResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/posts/drafts\x12m\n" +
+	"\x0fGetPostsForTour\x12\x1c.blog.GetPostsForTourRequest\x1a\x1d.blog.GetPostsForTourResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/tours/{tourId}/posts\x12\\\n" +
 	"\vUploadImage\x12\x18.blog.UploadImageRequest\x1a\x19.blog.UploadImageResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/upload-image\x12`\n" +
 	"\n" +
-	"ToggleLike\x12\x17.blog.ToggleLikeRequest\x1a\x18.blog.ToggleLikeResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/posts/{postId}/like\x12y\n" +
+	"ToggleLike\x12\x17.blog.ToggleLikeRequest\x1a\x18.blog.ToggleLikeResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/posts/{postId}/like\x12m\n" +
+	"\x0fGetLikesForPost\x12\x1c.blog.GetLikesForPostRequest\x1a\x1d.blog.GetLikesForPostResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/posts/{postId}/likes\x12y\n" +
 	"\x12GetCommentsForPost\x12\x1f.blog.GetCommentsForPostRequest\x1a .blog.GetCommentsForPostResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/posts/{postId}/comments\x12e\n" +
-	"\x10AddCommentToPost\x12\x1d.blog.AddCommentToPostRequest\x1a\r.blog.Comment\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/posts/{postId}/commentsB#Z!soa-team-5/api-gateway/proto/blogb\x06proto3"
+	"\x10AddCommentToPost\x12\x1d.blog.AddCommentToPostRequest\x1a\r.blog.Comment\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/posts/{postId}/comments\x12U\n" +
+	"\rUpdateComment\x12\x1a.blog.UpdateCommentRequest\x1a\r.blog.Comment\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\x1a\x0e/comments/{id}\x12`\n" +
+	"\rDeleteComment\x12\x1a.blog.DeleteCommentRequest\x1a\x1b.blog.DeleteCommentResponse\"\x16\x82\xd3\xe4\x93\x02\x10*\x0e/comments/{id}B#Z!soa-team-5/api-gateway/proto/blogb\x06proto3"
 
 var (
 	file_blog_blog_proto_rawDescOnce sync.Once
@@ -926,45 +2265,88 @@ func file_blog_blog_proto_rawDescGZIP() []byte {
 	return file_blog_blog_proto_rawDescData
 }
 
-var file_blog_blog_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_blog_blog_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_blog_blog_proto_goTypes = []any{
 	(*CreatePostRequest)(nil),          // 0: blog.CreatePostRequest
 	(*GetPostsRequest)(nil),            // 1: blog.GetPostsRequest
 	(*GetPostsResponse)(nil),           // 2: blog.GetPostsResponse
-	(*GetPostByIDRequest)(nil),         // 3: blog.GetPostByIDRequest
-	(*UploadImageRequest)(nil),         // 4: blog.UploadImageRequest
-	(*UploadImageResponse)(nil),        // 5: blog.UploadImageResponse
-	(*ToggleLikeRequest)(nil),          // 6: blog.ToggleLikeRequest
-	(*ToggleLikeResponse)(nil),         // 7: blog.ToggleLikeResponse
-	(*GetCommentsForPostRequest)(nil),  // 8: blog.GetCommentsForPostRequest
-	(*GetCommentsForPostResponse)(nil), // 9: blog.GetCommentsForPostResponse
-	(*AddCommentToPostRequest)(nil),    // 10: blog.AddCommentToPostRequest
-	(*Comment)(nil),                    // 11: blog.Comment
-	(*Like)(nil),                       // 12: blog.Like
-	(*Post)(nil),                       // 13: blog.Post
+	(*GetFeedRequest)(nil),             // 3: blog.GetFeedRequest
+	(*GetFeedResponse)(nil),            // 4: blog.GetFeedResponse
+	(*GetPostsForTourRequest)(nil),     // 5: blog.GetPostsForTourRequest
+	(*GetPostsForTourResponse)(nil),    // 6: blog.GetPostsForTourResponse
+	(*GetPostByIDRequest)(nil),         // 7: blog.GetPostByIDRequest
+	(*UpdatePostRequest)(nil),          // 8: blog.UpdatePostRequest
+	(*DeletePostRequest)(nil),          // 9: blog.DeletePostRequest
+	(*DeletePostResponse)(nil),         // 10: blog.DeletePostResponse
+	(*PublishPostRequest)(nil),         // 11: blog.PublishPostRequest
+	(*GetPostHistoryRequest)(nil),      // 12: blog.GetPostHistoryRequest
+	(*GetPostHistoryResponse)(nil),     // 13: blog.GetPostHistoryResponse
+	(*GetMyDraftsRequest)(nil),         // 14: blog.GetMyDraftsRequest
+	(*PostRevision)(nil),               // 15: blog.PostRevision
+	(*UploadImageRequest)(nil),         // 16: blog.UploadImageRequest
+	(*UploadImageResponse)(nil),        // 17: blog.UploadImageResponse
+	(*ToggleLikeRequest)(nil),          // 18: blog.ToggleLikeRequest
+	(*ToggleLikeResponse)(nil),         // 19: blog.ToggleLikeResponse
+	(*GetLikesForPostRequest)(nil),     // 20: blog.GetLikesForPostRequest
+	(*GetLikesForPostResponse)(nil),    // 21: blog.GetLikesForPostResponse
+	(*GetCommentsForPostRequest)(nil),  // 22: blog.GetCommentsForPostRequest
+	(*GetCommentsForPostResponse)(nil), // 23: blog.GetCommentsForPostResponse
+	(*UpdateCommentRequest)(nil),       // 24: blog.UpdateCommentRequest
+	(*DeleteCommentRequest)(nil),       // 25: blog.DeleteCommentRequest
+	(*DeleteCommentResponse)(nil),      // 26: blog.DeleteCommentResponse
+	(*AddCommentToPostRequest)(nil),    // 27: blog.AddCommentToPostRequest
+	(*Comment)(nil),                    // 28: blog.Comment
+	(*Like)(nil),                       // 29: blog.Like
+	(*Post)(nil),                       // 30: blog.Post
+	(*TourSummary)(nil),                // 31: blog.TourSummary
 }
 var file_blog_blog_proto_depIdxs = []int32{
-	13, // 0: blog.GetPostsResponse.posts:type_name -> blog.Post
-	11, // 1: blog.GetCommentsForPostResponse.comments:type_name -> blog.Comment
-	0,  // 2: blog.BlogService.CreatePost:input_type -> blog.CreatePostRequest
-	1,  // 3: blog.BlogService.GetPosts:input_type -> blog.GetPostsRequest
-	3,  // 4: blog.BlogService.GetPostByID:input_type -> blog.GetPostByIDRequest
-	4,  // 5: blog.BlogService.UploadImage:input_type -> blog.UploadImageRequest
-	6,  // 6: blog.BlogService.ToggleLike:input_type -> blog.ToggleLikeRequest
-	8,  // 7: blog.BlogService.GetCommentsForPost:input_type -> blog.GetCommentsForPostRequest
-	10, // 8: blog.BlogService.AddCommentToPost:input_type -> blog.AddCommentToPostRequest
-	13, // 9: blog.BlogService.CreatePost:output_type -> blog.Post
-	2,  // 10: blog.BlogService.GetPosts:output_type -> blog.GetPostsResponse
-	13, // 11: blog.BlogService.GetPostByID:output_type -> blog.Post
-	5,  // 12: blog.BlogService.UploadImage:output_type -> blog.UploadImageResponse
-	7,  // 13: blog.BlogService.ToggleLike:output_type -> blog.ToggleLikeResponse
-	9,  // 14: blog.BlogService.GetCommentsForPost:output_type -> blog.GetCommentsForPostResponse
-	11, // 15: blog.BlogService.AddCommentToPost:output_type -> blog.Comment
-	9,  // [9:16] is the sub-list for method output_type
-	2,  // [2:9] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	30, // 0: blog.GetPostsResponse.posts:type_name -> blog.Post
+	30, // 1: blog.GetFeedResponse.posts:type_name -> blog.Post
+	30, // 2: blog.GetPostsForTourResponse.posts:type_name -> blog.Post
+	15, // 3: blog.GetPostHistoryResponse.revisions:type_name -> blog.PostRevision
+	29, // 4: blog.GetLikesForPostResponse.likes:type_name -> blog.Like
+	28, // 5: blog.GetCommentsForPostResponse.comments:type_name -> blog.Comment
+	31, // 6: blog.Post.tours:type_name -> blog.TourSummary
+	0,  // 7: blog.BlogService.CreatePost:input_type -> blog.CreatePostRequest
+	1,  // 8: blog.BlogService.GetPosts:input_type -> blog.GetPostsRequest
+	3,  // 9: blog.BlogService.GetFeed:input_type -> blog.GetFeedRequest
+	7,  // 10: blog.BlogService.GetPostByID:input_type -> blog.GetPostByIDRequest
+	8,  // 11: blog.BlogService.UpdatePost:input_type -> blog.UpdatePostRequest
+	9,  // 12: blog.BlogService.DeletePost:input_type -> blog.DeletePostRequest
+	11, // 13: blog.BlogService.PublishPost:input_type -> blog.PublishPostRequest
+	12, // 14: blog.BlogService.GetPostHistory:input_type -> blog.GetPostHistoryRequest
+	14, // 15: blog.BlogService.GetMyDrafts:input_type -> blog.GetMyDraftsRequest
+	5,  // 16: blog.BlogService.GetPostsForTour:input_type -> blog.GetPostsForTourRequest
+	16, // 17: blog.BlogService.UploadImage:input_type -> blog.UploadImageRequest
+	18, // 18: blog.BlogService.ToggleLike:input_type -> blog.ToggleLikeRequest
+	20, // 19: blog.BlogService.GetLikesForPost:input_type -> blog.GetLikesForPostRequest
+	22, // 20: blog.BlogService.GetCommentsForPost:input_type -> blog.GetCommentsForPostRequest
+	27, // 21: blog.BlogService.AddCommentToPost:input_type -> blog.AddCommentToPostRequest
+	24, // 22: blog.BlogService.UpdateComment:input_type -> blog.UpdateCommentRequest
+	25, // 23: blog.BlogService.DeleteComment:input_type -> blog.DeleteCommentRequest
+	30, // 24: blog.BlogService.CreatePost:output_type -> blog.Post
+	2,  // 25: blog.BlogService.GetPosts:output_type -> blog.GetPostsResponse
+	4,  // 26: blog.BlogService.GetFeed:output_type -> blog.GetFeedResponse
+	30, // 27: blog.BlogService.GetPostByID:output_type -> blog.Post
+	30, // 28: blog.BlogService.UpdatePost:output_type -> blog.Post
+	10, // 29: blog.BlogService.DeletePost:output_type -> blog.DeletePostResponse
+	30, // 30: blog.BlogService.PublishPost:output_type -> blog.Post
+	13, // 31: blog.BlogService.GetPostHistory:output_type -> blog.GetPostHistoryResponse
+	2,  // 32: blog.BlogService.GetMyDrafts:output_type -> blog.GetPostsResponse
+	6,  // 33: blog.BlogService.GetPostsForTour:output_type -> blog.GetPostsForTourResponse
+	17, // 34: blog.BlogService.UploadImage:output_type -> blog.UploadImageResponse
+	19, // 35: blog.BlogService.ToggleLike:output_type -> blog.ToggleLikeResponse
+	21, // 36: blog.BlogService.GetLikesForPost:output_type -> blog.GetLikesForPostResponse
+	23, // 37: blog.BlogService.GetCommentsForPost:output_type -> blog.GetCommentsForPostResponse
+	28, // 38: blog.BlogService.AddCommentToPost:output_type -> blog.Comment
+	28, // 39: blog.BlogService.UpdateComment:output_type -> blog.Comment
+	26, // 40: blog.BlogService.DeleteComment:output_type -> blog.DeleteCommentResponse
+	24, // [24:41] is the sub-list for method output_type
+	7,  // [7:24] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_blog_blog_proto_init() }
@@ -978,7 +2360,7 @@ func file_blog_blog_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_blog_blog_proto_rawDesc), len(file_blog_blog_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -21,11 +21,21 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	BlogService_CreatePost_FullMethodName         = "/blog.BlogService/CreatePost"
 	BlogService_GetPosts_FullMethodName           = "/blog.BlogService/GetPosts"
+	BlogService_GetFeed_FullMethodName            = "/blog.BlogService/GetFeed"
 	BlogService_GetPostByID_FullMethodName        = "/blog.BlogService/GetPostByID"
+	BlogService_UpdatePost_FullMethodName         = "/blog.BlogService/UpdatePost"
+	BlogService_DeletePost_FullMethodName         = "/blog.BlogService/DeletePost"
+	BlogService_PublishPost_FullMethodName        = "/blog.BlogService/PublishPost"
+	BlogService_GetPostHistory_FullMethodName     = "/blog.BlogService/GetPostHistory"
+	BlogService_GetMyDrafts_FullMethodName        = "/blog.BlogService/GetMyDrafts"
+	BlogService_GetPostsForTour_FullMethodName    = "/blog.BlogService/GetPostsForTour"
 	BlogService_UploadImage_FullMethodName        = "/blog.BlogService/UploadImage"
 	BlogService_ToggleLike_FullMethodName         = "/blog.BlogService/ToggleLike"
+	BlogService_GetLikesForPost_FullMethodName    = "/blog.BlogService/GetLikesForPost"
 	BlogService_GetCommentsForPost_FullMethodName = "/blog.BlogService/GetCommentsForPost"
 	BlogService_AddCommentToPost_FullMethodName   = "/blog.BlogService/AddCommentToPost"
+	BlogService_UpdateComment_FullMethodName      = "/blog.BlogService/UpdateComment"
+	BlogService_DeleteComment_FullMethodName      = "/blog.BlogService/DeleteComment"
 )
 
 // BlogServiceClient is the client API for BlogService service.
@@ -34,11 +44,21 @@ const (
 type BlogServiceClient interface {
 	CreatePost(ctx context.Context, in *CreatePostRequest, opts ...grpc.CallOption) (*Post, error)
 	GetPosts(ctx context.Context, in *GetPostsRequest, opts ...grpc.CallOption) (*GetPostsResponse, error)
+	GetFeed(ctx context.Context, in *GetFeedRequest, opts ...grpc.CallOption) (*GetFeedResponse, error)
 	GetPostByID(ctx context.Context, in *GetPostByIDRequest, opts ...grpc.CallOption) (*Post, error)
+	UpdatePost(ctx context.Context, in *UpdatePostRequest, opts ...grpc.CallOption) (*Post, error)
+	DeletePost(ctx context.Context, in *DeletePostRequest, opts ...grpc.CallOption) (*DeletePostResponse, error)
+	PublishPost(ctx context.Context, in *PublishPostRequest, opts ...grpc.CallOption) (*Post, error)
+	GetPostHistory(ctx context.Context, in *GetPostHistoryRequest, opts ...grpc.CallOption) (*GetPostHistoryResponse, error)
+	GetMyDrafts(ctx context.Context, in *GetMyDraftsRequest, opts ...grpc.CallOption) (*GetPostsResponse, error)
+	GetPostsForTour(ctx context.Context, in *GetPostsForTourRequest, opts ...grpc.CallOption) (*GetPostsForTourResponse, error)
 	UploadImage(ctx context.Context, in *UploadImageRequest, opts ...grpc.CallOption) (*UploadImageResponse, error)
 	ToggleLike(ctx context.Context, in *ToggleLikeRequest, opts ...grpc.CallOption) (*ToggleLikeResponse, error)
+	GetLikesForPost(ctx context.Context, in *GetLikesForPostRequest, opts ...grpc.CallOption) (*GetLikesForPostResponse, error)
 	GetCommentsForPost(ctx context.Context, in *GetCommentsForPostRequest, opts ...grpc.CallOption) (*GetCommentsForPostResponse, error)
 	AddCommentToPost(ctx context.Context, in *AddCommentToPostRequest, opts ...grpc.CallOption) (*Comment, error)
+	UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*Comment, error)
+	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error)
 }
 
 type blogServiceClient struct {
@@ -69,6 +89,16 @@ func (c *blogServiceClient) GetPosts(ctx context.Context, in *GetPostsRequest, o
 	return out, nil
 }
 
+func (c *blogServiceClient) GetFeed(ctx context.Context, in *GetFeedRequest, opts ...grpc.CallOption) (*GetFeedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFeedResponse)
+	err := c.cc.Invoke(ctx, BlogService_GetFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *blogServiceClient) GetPostByID(ctx context.Context, in *GetPostByIDRequest, opts ...grpc.CallOption) (*Post, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Post)
@@ -79,6 +109,66 @@ func (c *blogServiceClient) GetPostByID(ctx context.Context, in *GetPostByIDRequ
 	return out, nil
 }
 
+func (c *blogServiceClient) UpdatePost(ctx context.Context, in *UpdatePostRequest, opts ...grpc.CallOption) (*Post, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Post)
+	err := c.cc.Invoke(ctx, BlogService_UpdatePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) DeletePost(ctx context.Context, in *DeletePostRequest, opts ...grpc.CallOption) (*DeletePostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePostResponse)
+	err := c.cc.Invoke(ctx, BlogService_DeletePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) PublishPost(ctx context.Context, in *PublishPostRequest, opts ...grpc.CallOption) (*Post, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Post)
+	err := c.cc.Invoke(ctx, BlogService_PublishPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) GetPostHistory(ctx context.Context, in *GetPostHistoryRequest, opts ...grpc.CallOption) (*GetPostHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPostHistoryResponse)
+	err := c.cc.Invoke(ctx, BlogService_GetPostHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) GetMyDrafts(ctx context.Context, in *GetMyDraftsRequest, opts ...grpc.CallOption) (*GetPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPostsResponse)
+	err := c.cc.Invoke(ctx, BlogService_GetMyDrafts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) GetPostsForTour(ctx context.Context, in *GetPostsForTourRequest, opts ...grpc.CallOption) (*GetPostsForTourResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPostsForTourResponse)
+	err := c.cc.Invoke(ctx, BlogService_GetPostsForTour_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *blogServiceClient) UploadImage(ctx context.Context, in *UploadImageRequest, opts ...grpc.CallOption) (*UploadImageResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UploadImageResponse)
@@ -99,6 +189,16 @@ func (c *blogServiceClient) ToggleLike(ctx context.Context, in *ToggleLikeReques
 	return out, nil
 }
 
+func (c *blogServiceClient) GetLikesForPost(ctx context.Context, in *GetLikesForPostRequest, opts ...grpc.CallOption) (*GetLikesForPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLikesForPostResponse)
+	err := c.cc.Invoke(ctx, BlogService_GetLikesForPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *blogServiceClient) GetCommentsForPost(ctx context.Context, in *GetCommentsForPostRequest, opts ...grpc.CallOption) (*GetCommentsForPostResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetCommentsForPostResponse)
@@ -119,17 +219,47 @@ func (c *blogServiceClient) AddCommentToPost(ctx context.Context, in *AddComment
 	return out, nil
 }
 
+func (c *blogServiceClient) UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*Comment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Comment)
+	err := c.cc.Invoke(ctx, BlogService_UpdateComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCommentResponse)
+	err := c.cc.Invoke(ctx, BlogService_DeleteComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BlogServiceServer is the server API for BlogService service.
 // All implementations must embed UnimplementedBlogServiceServer
 // for forward compatibility.
 type BlogServiceServer interface {
 	CreatePost(context.Context, *CreatePostRequest) (*Post, error)
 	GetPosts(context.Context, *GetPostsRequest) (*GetPostsResponse, error)
+	GetFeed(context.Context, *GetFeedRequest) (*GetFeedResponse, error)
 	GetPostByID(context.Context, *GetPostByIDRequest) (*Post, error)
+	UpdatePost(context.Context, *UpdatePostRequest) (*Post, error)
+	DeletePost(context.Context, *DeletePostRequest) (*DeletePostResponse, error)
+	PublishPost(context.Context, *PublishPostRequest) (*Post, error)
+	GetPostHistory(context.Context, *GetPostHistoryRequest) (*GetPostHistoryResponse, error)
+	GetMyDrafts(context.Context, *GetMyDraftsRequest) (*GetPostsResponse, error)
+	GetPostsForTour(context.Context, *GetPostsForTourRequest) (*GetPostsForTourResponse, error)
 	UploadImage(context.Context, *UploadImageRequest) (*UploadImageResponse, error)
 	ToggleLike(context.Context, *ToggleLikeRequest) (*ToggleLikeResponse, error)
+	GetLikesForPost(context.Context, *GetLikesForPostRequest) (*GetLikesForPostResponse, error)
 	GetCommentsForPost(context.Context, *GetCommentsForPostRequest) (*GetCommentsForPostResponse, error)
 	AddCommentToPost(context.Context, *AddCommentToPostRequest) (*Comment, error)
+	UpdateComment(context.Context, *UpdateCommentRequest) (*Comment, error)
+	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error)
 	mustEmbedUnimplementedBlogServiceServer()
 }
 
@@ -146,21 +276,51 @@ func (UnimplementedBlogServiceServer) CreatePost(context.Context, *CreatePostReq
 func (UnimplementedBlogServiceServer) GetPosts(context.Context, *GetPostsRequest) (*GetPostsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPosts not implemented")
 }
+func (UnimplementedBlogServiceServer) GetFeed(context.Context, *GetFeedRequest) (*GetFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeed not implemented")
+}
 func (UnimplementedBlogServiceServer) GetPostByID(context.Context, *GetPostByIDRequest) (*Post, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPostByID not implemented")
 }
+func (UnimplementedBlogServiceServer) UpdatePost(context.Context, *UpdatePostRequest) (*Post, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePost not implemented")
+}
+func (UnimplementedBlogServiceServer) DeletePost(context.Context, *DeletePostRequest) (*DeletePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePost not implemented")
+}
+func (UnimplementedBlogServiceServer) PublishPost(context.Context, *PublishPostRequest) (*Post, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishPost not implemented")
+}
+func (UnimplementedBlogServiceServer) GetPostHistory(context.Context, *GetPostHistoryRequest) (*GetPostHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostHistory not implemented")
+}
+func (UnimplementedBlogServiceServer) GetMyDrafts(context.Context, *GetMyDraftsRequest) (*GetPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMyDrafts not implemented")
+}
+func (UnimplementedBlogServiceServer) GetPostsForTour(context.Context, *GetPostsForTourRequest) (*GetPostsForTourResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostsForTour not implemented")
+}
 func (UnimplementedBlogServiceServer) UploadImage(context.Context, *UploadImageRequest) (*UploadImageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UploadImage not implemented")
 }
 func (UnimplementedBlogServiceServer) ToggleLike(context.Context, *ToggleLikeRequest) (*ToggleLikeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ToggleLike not implemented")
 }
+func (UnimplementedBlogServiceServer) GetLikesForPost(context.Context, *GetLikesForPostRequest) (*GetLikesForPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLikesForPost not implemented")
+}
 func (UnimplementedBlogServiceServer) GetCommentsForPost(context.Context, *GetCommentsForPostRequest) (*GetCommentsForPostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCommentsForPost not implemented")
 }
 func (UnimplementedBlogServiceServer) AddCommentToPost(context.Context, *AddCommentToPostRequest) (*Comment, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddCommentToPost not implemented")
 }
+func (UnimplementedBlogServiceServer) UpdateComment(context.Context, *UpdateCommentRequest) (*Comment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateComment not implemented")
+}
+func (UnimplementedBlogServiceServer) DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
 func (UnimplementedBlogServiceServer) mustEmbedUnimplementedBlogServiceServer() {}
 func (UnimplementedBlogServiceServer) testEmbeddedByValue()                     {}
 
@@ -218,6 +378,24 @@ func _BlogService_GetPosts_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BlogService_GetFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).GetFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_GetFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).GetFeed(ctx, req.(*GetFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BlogService_GetPostByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetPostByIDRequest)
 	if err := dec(in); err != nil {
@@ -236,6 +414,114 @@ func _BlogService_GetPostByID_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BlogService_UpdatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).UpdatePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_UpdatePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).UpdatePost(ctx, req.(*UpdatePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_DeletePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).DeletePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_DeletePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).DeletePost(ctx, req.(*DeletePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_PublishPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).PublishPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_PublishPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).PublishPost(ctx, req.(*PublishPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_GetPostHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).GetPostHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_GetPostHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).GetPostHistory(ctx, req.(*GetPostHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_GetMyDrafts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyDraftsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).GetMyDrafts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_GetMyDrafts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).GetMyDrafts(ctx, req.(*GetMyDraftsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_GetPostsForTour_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostsForTourRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).GetPostsForTour(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_GetPostsForTour_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).GetPostsForTour(ctx, req.(*GetPostsForTourRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BlogService_UploadImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UploadImageRequest)
 	if err := dec(in); err != nil {
@@ -272,6 +558,24 @@ func _BlogService_ToggleLike_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BlogService_GetLikesForPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLikesForPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).GetLikesForPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_GetLikesForPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).GetLikesForPost(ctx, req.(*GetLikesForPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BlogService_GetCommentsForPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCommentsForPostRequest)
 	if err := dec(in); err != nil {
@@ -308,6 +612,42 @@ func _BlogService_AddCommentToPost_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BlogService_UpdateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).UpdateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_UpdateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).UpdateComment(ctx, req.(*UpdateCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_DeleteComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BlogService_ServiceDesc is the grpc.ServiceDesc for BlogService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -323,10 +663,38 @@ var BlogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPosts",
 			Handler:    _BlogService_GetPosts_Handler,
 		},
+		{
+			MethodName: "GetFeed",
+			Handler:    _BlogService_GetFeed_Handler,
+		},
 		{
 			MethodName: "GetPostByID",
 			Handler:    _BlogService_GetPostByID_Handler,
 		},
+		{
+			MethodName: "UpdatePost",
+			Handler:    _BlogService_UpdatePost_Handler,
+		},
+		{
+			MethodName: "DeletePost",
+			Handler:    _BlogService_DeletePost_Handler,
+		},
+		{
+			MethodName: "PublishPost",
+			Handler:    _BlogService_PublishPost_Handler,
+		},
+		{
+			MethodName: "GetPostHistory",
+			Handler:    _BlogService_GetPostHistory_Handler,
+		},
+		{
+			MethodName: "GetMyDrafts",
+			Handler:    _BlogService_GetMyDrafts_Handler,
+		},
+		{
+			MethodName: "GetPostsForTour",
+			Handler:    _BlogService_GetPostsForTour_Handler,
+		},
 		{
 			MethodName: "UploadImage",
 			Handler:    _BlogService_UploadImage_Handler,
@@ -335,6 +703,10 @@ var BlogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ToggleLike",
 			Handler:    _BlogService_ToggleLike_Handler,
 		},
+		{
+			MethodName: "GetLikesForPost",
+			Handler:    _BlogService_GetLikesForPost_Handler,
+		},
 		{
 			MethodName: "GetCommentsForPost",
 			Handler:    _BlogService_GetCommentsForPost_Handler,
@@ -343,6 +715,14 @@ var BlogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddCommentToPost",
 			Handler:    _BlogService_AddCommentToPost_Handler,
 		},
+		{
+			MethodName: "UpdateComment",
+			Handler:    _BlogService_UpdateComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _BlogService_DeleteComment_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "blog/blog.proto",
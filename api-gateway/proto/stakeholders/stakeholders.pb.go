@@ -343,6 +343,463 @@ func (x *LoginResponse) GetAccessToken() string {
 	return ""
 }
 
+type VerifyEmailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmailRequest) Reset() {
+	*x = VerifyEmailRequest{}
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailRequest) ProtoMessage() {}
+
+func (x *VerifyEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailRequest.ProtoReflect.Descriptor instead.
+func (*VerifyEmailRequest) Descriptor() ([]byte, []int) {
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *VerifyEmailRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type VerifyEmailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmailResponse) Reset() {
+	*x = VerifyEmailResponse{}
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailResponse) ProtoMessage() {}
+
+func (x *VerifyEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailResponse.ProtoReflect.Descriptor instead.
+func (*VerifyEmailResponse) Descriptor() ([]byte, []int) {
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *VerifyEmailResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ResendVerificationEmailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendVerificationEmailRequest) Reset() {
+	*x = ResendVerificationEmailRequest{}
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendVerificationEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendVerificationEmailRequest) ProtoMessage() {}
+
+func (x *ResendVerificationEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendVerificationEmailRequest.ProtoReflect.Descriptor instead.
+func (*ResendVerificationEmailRequest) Descriptor() ([]byte, []int) {
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ResendVerificationEmailRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type ResendVerificationEmailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendVerificationEmailResponse) Reset() {
+	*x = ResendVerificationEmailResponse{}
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendVerificationEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendVerificationEmailResponse) ProtoMessage() {}
+
+func (x *ResendVerificationEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendVerificationEmailResponse.ProtoReflect.Descriptor instead.
+func (*ResendVerificationEmailResponse) Descriptor() ([]byte, []int) {
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ResendVerificationEmailResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type RequestPasswordResetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetRequest) Reset() {
+	*x = RequestPasswordResetRequest{}
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetRequest) ProtoMessage() {}
+
+func (x *RequestPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RequestPasswordResetRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type RequestPasswordResetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetResponse) Reset() {
+	*x = RequestPasswordResetResponse{}
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetResponse) ProtoMessage() {}
+
+func (x *RequestPasswordResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetResponse.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetResponse) Descriptor() ([]byte, []int) {
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RequestPasswordResetResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ResetPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,2,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordRequest) ProtoMessage() {}
+
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ResetPasswordRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ResetPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordResponse) Reset() {
+	*x = ResetPasswordResponse{}
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordResponse) ProtoMessage() {}
+
+func (x *ResetPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ResetPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ResetPasswordResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// Posle promene lozinke stari tokeni vise ne vaze, pa odgovor sadrzi novi.
+type ChangePasswordRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CurrentPassword string                 `protobuf:"bytes,1,opt,name=currentPassword,proto3" json:"currentPassword,omitempty"`
+	NewPassword     string                 `protobuf:"bytes,2,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordRequest) ProtoMessage() {}
+
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ChangePasswordRequest) GetCurrentPassword() string {
+	if x != nil {
+		return x.CurrentPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ChangePasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordResponse) Reset() {
+	*x = ChangePasswordResponse{}
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordResponse) ProtoMessage() {}
+
+func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
+func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ChangePasswordResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
 type GetAllUsersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -351,7 +808,7 @@ type GetAllUsersRequest struct {
 
 func (x *GetAllUsersRequest) Reset() {
 	*x = GetAllUsersRequest{}
-	mi := &file_stakeholders_stakeholders_proto_msgTypes[6]
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -363,7 +820,7 @@ func (x *GetAllUsersRequest) String() string {
 func (*GetAllUsersRequest) ProtoMessage() {}
 
 func (x *GetAllUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stakeholders_stakeholders_proto_msgTypes[6]
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -376,7 +833,7 @@ func (x *GetAllUsersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllUsersRequest.ProtoReflect.Descriptor instead.
 func (*GetAllUsersRequest) Descriptor() ([]byte, []int) {
-	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{6}
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{16}
 }
 
 type GetAllUsersResponse struct {
@@ -388,7 +845,7 @@ type GetAllUsersResponse struct {
 
 func (x *GetAllUsersResponse) Reset() {
 	*x = GetAllUsersResponse{}
-	mi := &file_stakeholders_stakeholders_proto_msgTypes[7]
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -400,7 +857,7 @@ func (x *GetAllUsersResponse) String() string {
 func (*GetAllUsersResponse) ProtoMessage() {}
 
 func (x *GetAllUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stakeholders_stakeholders_proto_msgTypes[7]
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -413,7 +870,7 @@ func (x *GetAllUsersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllUsersResponse.ProtoReflect.Descriptor instead.
 func (*GetAllUsersResponse) Descriptor() ([]byte, []int) {
-	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{7}
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetAllUsersResponse) GetUsers() []*User {
@@ -433,7 +890,7 @@ type BlockUserRequest struct {
 
 func (x *BlockUserRequest) Reset() {
 	*x = BlockUserRequest{}
-	mi := &file_stakeholders_stakeholders_proto_msgTypes[8]
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -445,7 +902,7 @@ func (x *BlockUserRequest) String() string {
 func (*BlockUserRequest) ProtoMessage() {}
 
 func (x *BlockUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stakeholders_stakeholders_proto_msgTypes[8]
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -458,7 +915,7 @@ func (x *BlockUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlockUserRequest.ProtoReflect.Descriptor instead.
 func (*BlockUserRequest) Descriptor() ([]byte, []int) {
-	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{8}
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *BlockUserRequest) GetUserId() string {
@@ -484,7 +941,7 @@ type BlockUserResponse struct {
 
 func (x *BlockUserResponse) Reset() {
 	*x = BlockUserResponse{}
-	mi := &file_stakeholders_stakeholders_proto_msgTypes[9]
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -496,7 +953,7 @@ func (x *BlockUserResponse) String() string {
 func (*BlockUserResponse) ProtoMessage() {}
 
 func (x *BlockUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stakeholders_stakeholders_proto_msgTypes[9]
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -509,7 +966,7 @@ func (x *BlockUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlockUserResponse.ProtoReflect.Descriptor instead.
 func (*BlockUserResponse) Descriptor() ([]byte, []int) {
-	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{9}
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *BlockUserResponse) GetStatus() string {
@@ -528,7 +985,7 @@ type GetProfileByUsernameRequest struct {
 
 func (x *GetProfileByUsernameRequest) Reset() {
 	*x = GetProfileByUsernameRequest{}
-	mi := &file_stakeholders_stakeholders_proto_msgTypes[10]
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -540,7 +997,7 @@ func (x *GetProfileByUsernameRequest) String() string {
 func (*GetProfileByUsernameRequest) ProtoMessage() {}
 
 func (x *GetProfileByUsernameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stakeholders_stakeholders_proto_msgTypes[10]
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -553,7 +1010,7 @@ func (x *GetProfileByUsernameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProfileByUsernameRequest.ProtoReflect.Descriptor instead.
 func (*GetProfileByUsernameRequest) Descriptor() ([]byte, []int) {
-	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{10}
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetProfileByUsernameRequest) GetUsername() string {
@@ -571,7 +1028,7 @@ type GetProfileRequest struct {
 
 func (x *GetProfileRequest) Reset() {
 	*x = GetProfileRequest{}
-	mi := &file_stakeholders_stakeholders_proto_msgTypes[11]
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -583,7 +1040,7 @@ func (x *GetProfileRequest) String() string {
 func (*GetProfileRequest) ProtoMessage() {}
 
 func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stakeholders_stakeholders_proto_msgTypes[11]
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -596,7 +1053,7 @@ func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProfileRequest.ProtoReflect.Descriptor instead.
 func (*GetProfileRequest) Descriptor() ([]byte, []int) {
-	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{11}
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{21}
 }
 
 type UpdateProfileRequest struct {
@@ -608,7 +1065,7 @@ type UpdateProfileRequest struct {
 
 func (x *UpdateProfileRequest) Reset() {
 	*x = UpdateProfileRequest{}
-	mi := &file_stakeholders_stakeholders_proto_msgTypes[12]
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -620,7 +1077,7 @@ func (x *UpdateProfileRequest) String() string {
 func (*UpdateProfileRequest) ProtoMessage() {}
 
 func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stakeholders_stakeholders_proto_msgTypes[12]
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -633,7 +1090,7 @@ func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
-	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{12}
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *UpdateProfileRequest) GetProfile() *UserProfile {
@@ -644,15 +1101,16 @@ func (x *UpdateProfileRequest) GetProfile() *UserProfile {
 }
 
 type UpdateProfileResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Status                 string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ProfilePictureVariants map[string]string      `protobuf:"bytes,2,rep,name=profilePictureVariants,proto3" json:"profilePictureVariants,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *UpdateProfileResponse) Reset() {
 	*x = UpdateProfileResponse{}
-	mi := &file_stakeholders_stakeholders_proto_msgTypes[13]
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -664,7 +1122,7 @@ func (x *UpdateProfileResponse) String() string {
 func (*UpdateProfileResponse) ProtoMessage() {}
 
 func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stakeholders_stakeholders_proto_msgTypes[13]
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -677,7 +1135,7 @@ func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProfileResponse.ProtoReflect.Descriptor instead.
 func (*UpdateProfileResponse) Descriptor() ([]byte, []int) {
-	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{13}
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *UpdateProfileResponse) GetStatus() string {
@@ -687,6 +1145,13 @@ func (x *UpdateProfileResponse) GetStatus() string {
 	return ""
 }
 
+func (x *UpdateProfileResponse) GetProfilePictureVariants() map[string]string {
+	if x != nil {
+		return x.ProfilePictureVariants
+	}
+	return nil
+}
+
 type UserProfileResponse struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Username       string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
@@ -701,7 +1166,7 @@ type UserProfileResponse struct {
 
 func (x *UserProfileResponse) Reset() {
 	*x = UserProfileResponse{}
-	mi := &file_stakeholders_stakeholders_proto_msgTypes[14]
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -713,7 +1178,7 @@ func (x *UserProfileResponse) String() string {
 func (*UserProfileResponse) ProtoMessage() {}
 
 func (x *UserProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stakeholders_stakeholders_proto_msgTypes[14]
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -726,7 +1191,7 @@ func (x *UserProfileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserProfileResponse.ProtoReflect.Descriptor instead.
 func (*UserProfileResponse) Descriptor() ([]byte, []int) {
-	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{14}
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *UserProfileResponse) GetUsername() string {
@@ -781,7 +1246,7 @@ type PositionRequest struct {
 
 func (x *PositionRequest) Reset() {
 	*x = PositionRequest{}
-	mi := &file_stakeholders_stakeholders_proto_msgTypes[15]
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -793,7 +1258,7 @@ func (x *PositionRequest) String() string {
 func (*PositionRequest) ProtoMessage() {}
 
 func (x *PositionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stakeholders_stakeholders_proto_msgTypes[15]
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -806,7 +1271,7 @@ func (x *PositionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PositionRequest.ProtoReflect.Descriptor instead.
 func (*PositionRequest) Descriptor() ([]byte, []int) {
-	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{15}
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *PositionRequest) GetLat() float64 {
@@ -833,7 +1298,7 @@ type PositionResponse struct {
 
 func (x *PositionResponse) Reset() {
 	*x = PositionResponse{}
-	mi := &file_stakeholders_stakeholders_proto_msgTypes[16]
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -845,7 +1310,7 @@ func (x *PositionResponse) String() string {
 func (*PositionResponse) ProtoMessage() {}
 
 func (x *PositionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stakeholders_stakeholders_proto_msgTypes[16]
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -858,7 +1323,7 @@ func (x *PositionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PositionResponse.ProtoReflect.Descriptor instead.
 func (*PositionResponse) Descriptor() ([]byte, []int) {
-	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{16}
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *PositionResponse) GetLat() float64 {
@@ -888,7 +1353,7 @@ type UserProfile struct {
 
 func (x *UserProfile) Reset() {
 	*x = UserProfile{}
-	mi := &file_stakeholders_stakeholders_proto_msgTypes[17]
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -900,7 +1365,7 @@ func (x *UserProfile) String() string {
 func (*UserProfile) ProtoMessage() {}
 
 func (x *UserProfile) ProtoReflect() protoreflect.Message {
-	mi := &file_stakeholders_stakeholders_proto_msgTypes[17]
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -913,7 +1378,7 @@ func (x *UserProfile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserProfile.ProtoReflect.Descriptor instead.
 func (*UserProfile) Descriptor() ([]byte, []int) {
-	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{17}
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *UserProfile) GetFirstName() string {
@@ -965,7 +1430,7 @@ type User struct {
 
 func (x *User) Reset() {
 	*x = User{}
-	mi := &file_stakeholders_stakeholders_proto_msgTypes[18]
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -977,7 +1442,7 @@ func (x *User) String() string {
 func (*User) ProtoMessage() {}
 
 func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_stakeholders_stakeholders_proto_msgTypes[18]
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -990,7 +1455,7 @@ func (x *User) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use User.ProtoReflect.Descriptor instead.
 func (*User) Descriptor() ([]byte, []int) {
-	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{18}
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *User) GetId() string {
@@ -1058,6 +1523,28 @@ const file_stakeholders_stakeholders_proto_rawDesc = "" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\"1\n" +
 	"\rLoginResponse\x12 \n" +
+	"\vaccessToken\x18\x01 \x01(\tR\vaccessToken\"*\n" +
+	"\x12VerifyEmailRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"-\n" +
+	"\x13VerifyEmailResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"6\n" +
+	"\x1eResendVerificationEmailRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"9\n" +
+	"\x1fResendVerificationEmailResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"3\n" +
+	"\x1bRequestPasswordResetRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"6\n" +
+	"\x1cRequestPasswordResetResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"N\n" +
+	"\x14ResetPasswordRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12 \n" +
+	"\vnewPassword\x18\x02 \x01(\tR\vnewPassword\"/\n" +
+	"\x15ResetPasswordResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"c\n" +
+	"\x15ChangePasswordRequest\x12(\n" +
+	"\x0fcurrentPassword\x18\x01 \x01(\tR\x0fcurrentPassword\x12 \n" +
+	"\vnewPassword\x18\x02 \x01(\tR\vnewPassword\":\n" +
+	"\x16ChangePasswordResponse\x12 \n" +
 	"\vaccessToken\x18\x01 \x01(\tR\vaccessToken\"\x14\n" +
 	"\x12GetAllUsersRequest\"?\n" +
 	"\x13GetAllUsersResponse\x12(\n" +
@@ -1071,9 +1558,13 @@ const file_stakeholders_stakeholders_proto_rawDesc = "" +
 	"\busername\x18\x01 \x01(\tR\busername\"\x13\n" +
 	"\x11GetProfileRequest\"K\n" +
 	"\x14UpdateProfileRequest\x123\n" +
-	"\aprofile\x18\x01 \x01(\v2\x19.stakeholders.UserProfileR\aprofile\"/\n" +
+	"\aprofile\x18\x01 \x01(\v2\x19.stakeholders.UserProfileR\aprofile\"\xf3\x01\n" +
 	"\x15UpdateProfileResponse\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\tR\x06status\"\xc7\x01\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12w\n" +
+	"\x16profilePictureVariants\x18\x02 \x03(\v2?.stakeholders.UpdateProfileResponse.ProfilePictureVariantsEntryR\x16profilePictureVariants\x1aI\n" +
+	"\x1bProfilePictureVariantsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc7\x01\n" +
 	"\x13UserProfileResponse\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1c\n" +
 	"\tfirstName\x18\x02 \x01(\tR\tfirstName\x12\x1a\n" +
@@ -1099,7 +1590,7 @@ const file_stakeholders_stakeholders_proto_rawDesc = "" +
 	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1a\n" +
 	"\bpassword\x18\x04 \x01(\tR\bpassword\x12\x12\n" +
 	"\x04role\x18\x05 \x01(\tR\x04role\x12\x1c\n" +
-	"\tisBlocked\x18\x06 \x01(\bR\tisBlocked2\xd5\b\n" +
+	"\tisBlocked\x18\x06 \x01(\bR\tisBlocked2\x90\x0e\n" +
 	"\x13StakeholdersService\x12h\n" +
 	"\bRegister\x12\x1d.stakeholders.RegisterRequest\x1a\x1e.stakeholders.RegisterResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/auth/register\x12\\\n" +
 	"\x05Login\x12\x1a.stakeholders.LoginRequest\x1a\x1b.stakeholders.LoginResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/api/auth/login\x12l\n" +
@@ -1110,7 +1601,12 @@ const file_stakeholders_stakeholders_proto_rawDesc = "" +
 	"GetProfile\x12\x1f.stakeholders.GetProfileRequest\x1a!.stakeholders.UserProfileResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/user/profile\x12v\n" +
 	"\rUpdateProfile\x12\".stakeholders.UpdateProfileRequest\x1a#.stakeholders.UpdateProfileResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\x1a\x11/api/user/profile\x12f\n" +
 	"\vSetPosition\x12\x1d.stakeholders.PositionRequest\x1a\x16.google.protobuf.Empty\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/tourist/position\x12d\n" +
-	"\vGetPosition\x12\x16.google.protobuf.Empty\x1a\x1e.stakeholders.PositionResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/tourist/position\x12X\n" +
+	"\vGetPosition\x12\x16.google.protobuf.Empty\x1a\x1e.stakeholders.PositionResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/tourist/position\x12u\n" +
+	"\vVerifyEmail\x12 .stakeholders.VerifyEmailRequest\x1a!.stakeholders.VerifyEmailResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/auth/verify-email\x12\xa0\x01\n" +
+	"\x17ResendVerificationEmail\x12,.stakeholders.ResendVerificationEmailRequest\x1a-.stakeholders.ResendVerificationEmailResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/auth/verify-email/resend\x12\x92\x01\n" +
+	"\x14RequestPasswordReset\x12).stakeholders.RequestPasswordResetRequest\x1a*.stakeholders.RequestPasswordResetResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/auth/password-reset\x12\x85\x01\n" +
+	"\rResetPassword\x12\".stakeholders.ResetPasswordRequest\x1a#.stakeholders.ResetPasswordResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/auth/password-reset/confirm\x12\x81\x01\n" +
+	"\x0eChangePassword\x12#.stakeholders.ChangePasswordRequest\x1a$.stakeholders.ChangePasswordResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/auth/change-password\x12X\n" +
 	"\rValidateToken\x12\".stakeholders.ValidateTokenRequest\x1a#.stakeholders.ValidateTokenResponseB+Z)soa-team-5/api-gateway/proto/stakeholdersb\x06proto3"
 
 var (
@@ -1125,57 +1621,79 @@ func file_stakeholders_stakeholders_proto_rawDescGZIP() []byte {
 	return file_stakeholders_stakeholders_proto_rawDescData
 }
 
-var file_stakeholders_stakeholders_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_stakeholders_stakeholders_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_stakeholders_stakeholders_proto_goTypes = []any{
-	(*ValidateTokenRequest)(nil),        // 0: stakeholders.ValidateTokenRequest
-	(*ValidateTokenResponse)(nil),       // 1: stakeholders.ValidateTokenResponse
-	(*RegisterRequest)(nil),             // 2: stakeholders.RegisterRequest
-	(*RegisterResponse)(nil),            // 3: stakeholders.RegisterResponse
-	(*LoginRequest)(nil),                // 4: stakeholders.LoginRequest
-	(*LoginResponse)(nil),               // 5: stakeholders.LoginResponse
-	(*GetAllUsersRequest)(nil),          // 6: stakeholders.GetAllUsersRequest
-	(*GetAllUsersResponse)(nil),         // 7: stakeholders.GetAllUsersResponse
-	(*BlockUserRequest)(nil),            // 8: stakeholders.BlockUserRequest
-	(*BlockUserResponse)(nil),           // 9: stakeholders.BlockUserResponse
-	(*GetProfileByUsernameRequest)(nil), // 10: stakeholders.GetProfileByUsernameRequest
-	(*GetProfileRequest)(nil),           // 11: stakeholders.GetProfileRequest
-	(*UpdateProfileRequest)(nil),        // 12: stakeholders.UpdateProfileRequest
-	(*UpdateProfileResponse)(nil),       // 13: stakeholders.UpdateProfileResponse
-	(*UserProfileResponse)(nil),         // 14: stakeholders.UserProfileResponse
-	(*PositionRequest)(nil),             // 15: stakeholders.PositionRequest
-	(*PositionResponse)(nil),            // 16: stakeholders.PositionResponse
-	(*UserProfile)(nil),                 // 17: stakeholders.UserProfile
-	(*User)(nil),                        // 18: stakeholders.User
-	(*emptypb.Empty)(nil),               // 19: google.protobuf.Empty
+	(*ValidateTokenRequest)(nil),            // 0: stakeholders.ValidateTokenRequest
+	(*ValidateTokenResponse)(nil),           // 1: stakeholders.ValidateTokenResponse
+	(*RegisterRequest)(nil),                 // 2: stakeholders.RegisterRequest
+	(*RegisterResponse)(nil),                // 3: stakeholders.RegisterResponse
+	(*LoginRequest)(nil),                    // 4: stakeholders.LoginRequest
+	(*LoginResponse)(nil),                   // 5: stakeholders.LoginResponse
+	(*VerifyEmailRequest)(nil),              // 6: stakeholders.VerifyEmailRequest
+	(*VerifyEmailResponse)(nil),             // 7: stakeholders.VerifyEmailResponse
+	(*ResendVerificationEmailRequest)(nil),  // 8: stakeholders.ResendVerificationEmailRequest
+	(*ResendVerificationEmailResponse)(nil), // 9: stakeholders.ResendVerificationEmailResponse
+	(*RequestPasswordResetRequest)(nil),     // 10: stakeholders.RequestPasswordResetRequest
+	(*RequestPasswordResetResponse)(nil),    // 11: stakeholders.RequestPasswordResetResponse
+	(*ResetPasswordRequest)(nil),            // 12: stakeholders.ResetPasswordRequest
+	(*ResetPasswordResponse)(nil),           // 13: stakeholders.ResetPasswordResponse
+	(*ChangePasswordRequest)(nil),           // 14: stakeholders.ChangePasswordRequest
+	(*ChangePasswordResponse)(nil),          // 15: stakeholders.ChangePasswordResponse
+	(*GetAllUsersRequest)(nil),              // 16: stakeholders.GetAllUsersRequest
+	(*GetAllUsersResponse)(nil),             // 17: stakeholders.GetAllUsersResponse
+	(*BlockUserRequest)(nil),                // 18: stakeholders.BlockUserRequest
+	(*BlockUserResponse)(nil),               // 19: stakeholders.BlockUserResponse
+	(*GetProfileByUsernameRequest)(nil),     // 20: stakeholders.GetProfileByUsernameRequest
+	(*GetProfileRequest)(nil),               // 21: stakeholders.GetProfileRequest
+	(*UpdateProfileRequest)(nil),            // 22: stakeholders.UpdateProfileRequest
+	(*UpdateProfileResponse)(nil),           // 23: stakeholders.UpdateProfileResponse
+	(*UserProfileResponse)(nil),             // 24: stakeholders.UserProfileResponse
+	(*PositionRequest)(nil),                 // 25: stakeholders.PositionRequest
+	(*PositionResponse)(nil),                // 26: stakeholders.PositionResponse
+	(*UserProfile)(nil),                     // 27: stakeholders.UserProfile
+	(*User)(nil),                            // 28: stakeholders.User
+	nil,                                     // 29: stakeholders.UpdateProfileResponse.ProfilePictureVariantsEntry
+	(*emptypb.Empty)(nil),                   // 30: google.protobuf.Empty
 }
 var file_stakeholders_stakeholders_proto_depIdxs = []int32{
-	18, // 0: stakeholders.GetAllUsersResponse.users:type_name -> stakeholders.User
-	17, // 1: stakeholders.UpdateProfileRequest.profile:type_name -> stakeholders.UserProfile
-	2,  // 2: stakeholders.StakeholdersService.Register:input_type -> stakeholders.RegisterRequest
-	4,  // 3: stakeholders.StakeholdersService.Login:input_type -> stakeholders.LoginRequest
-	6,  // 4: stakeholders.StakeholdersService.GetAllUsers:input_type -> stakeholders.GetAllUsersRequest
-	8,  // 5: stakeholders.StakeholdersService.BlockUser:input_type -> stakeholders.BlockUserRequest
-	10, // 6: stakeholders.StakeholdersService.GetProfileByUsername:input_type -> stakeholders.GetProfileByUsernameRequest
-	11, // 7: stakeholders.StakeholdersService.GetProfile:input_type -> stakeholders.GetProfileRequest
-	12, // 8: stakeholders.StakeholdersService.UpdateProfile:input_type -> stakeholders.UpdateProfileRequest
-	15, // 9: stakeholders.StakeholdersService.SetPosition:input_type -> stakeholders.PositionRequest
-	19, // 10: stakeholders.StakeholdersService.GetPosition:input_type -> google.protobuf.Empty
-	0,  // 11: stakeholders.StakeholdersService.ValidateToken:input_type -> stakeholders.ValidateTokenRequest
-	3,  // 12: stakeholders.StakeholdersService.Register:output_type -> stakeholders.RegisterResponse
-	5,  // 13: stakeholders.StakeholdersService.Login:output_type -> stakeholders.LoginResponse
-	7,  // 14: stakeholders.StakeholdersService.GetAllUsers:output_type -> stakeholders.GetAllUsersResponse
-	9,  // 15: stakeholders.StakeholdersService.BlockUser:output_type -> stakeholders.BlockUserResponse
-	14, // 16: stakeholders.StakeholdersService.GetProfileByUsername:output_type -> stakeholders.UserProfileResponse
-	14, // 17: stakeholders.StakeholdersService.GetProfile:output_type -> stakeholders.UserProfileResponse
-	13, // 18: stakeholders.StakeholdersService.UpdateProfile:output_type -> stakeholders.UpdateProfileResponse
-	19, // 19: stakeholders.StakeholdersService.SetPosition:output_type -> google.protobuf.Empty
-	16, // 20: stakeholders.StakeholdersService.GetPosition:output_type -> stakeholders.PositionResponse
-	1,  // 21: stakeholders.StakeholdersService.ValidateToken:output_type -> stakeholders.ValidateTokenResponse
-	12, // [12:22] is the sub-list for method output_type
-	2,  // [2:12] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	28, // 0: stakeholders.GetAllUsersResponse.users:type_name -> stakeholders.User
+	27, // 1: stakeholders.UpdateProfileRequest.profile:type_name -> stakeholders.UserProfile
+	29, // 2: stakeholders.UpdateProfileResponse.profilePictureVariants:type_name -> stakeholders.UpdateProfileResponse.ProfilePictureVariantsEntry
+	2,  // 3: stakeholders.StakeholdersService.Register:input_type -> stakeholders.RegisterRequest
+	4,  // 4: stakeholders.StakeholdersService.Login:input_type -> stakeholders.LoginRequest
+	16, // 5: stakeholders.StakeholdersService.GetAllUsers:input_type -> stakeholders.GetAllUsersRequest
+	18, // 6: stakeholders.StakeholdersService.BlockUser:input_type -> stakeholders.BlockUserRequest
+	20, // 7: stakeholders.StakeholdersService.GetProfileByUsername:input_type -> stakeholders.GetProfileByUsernameRequest
+	21, // 8: stakeholders.StakeholdersService.GetProfile:input_type -> stakeholders.GetProfileRequest
+	22, // 9: stakeholders.StakeholdersService.UpdateProfile:input_type -> stakeholders.UpdateProfileRequest
+	25, // 10: stakeholders.StakeholdersService.SetPosition:input_type -> stakeholders.PositionRequest
+	30, // 11: stakeholders.StakeholdersService.GetPosition:input_type -> google.protobuf.Empty
+	6,  // 12: stakeholders.StakeholdersService.VerifyEmail:input_type -> stakeholders.VerifyEmailRequest
+	8,  // 13: stakeholders.StakeholdersService.ResendVerificationEmail:input_type -> stakeholders.ResendVerificationEmailRequest
+	10, // 14: stakeholders.StakeholdersService.RequestPasswordReset:input_type -> stakeholders.RequestPasswordResetRequest
+	12, // 15: stakeholders.StakeholdersService.ResetPassword:input_type -> stakeholders.ResetPasswordRequest
+	14, // 16: stakeholders.StakeholdersService.ChangePassword:input_type -> stakeholders.ChangePasswordRequest
+	0,  // 17: stakeholders.StakeholdersService.ValidateToken:input_type -> stakeholders.ValidateTokenRequest
+	3,  // 18: stakeholders.StakeholdersService.Register:output_type -> stakeholders.RegisterResponse
+	5,  // 19: stakeholders.StakeholdersService.Login:output_type -> stakeholders.LoginResponse
+	17, // 20: stakeholders.StakeholdersService.GetAllUsers:output_type -> stakeholders.GetAllUsersResponse
+	19, // 21: stakeholders.StakeholdersService.BlockUser:output_type -> stakeholders.BlockUserResponse
+	24, // 22: stakeholders.StakeholdersService.GetProfileByUsername:output_type -> stakeholders.UserProfileResponse
+	24, // 23: stakeholders.StakeholdersService.GetProfile:output_type -> stakeholders.UserProfileResponse
+	23, // 24: stakeholders.StakeholdersService.UpdateProfile:output_type -> stakeholders.UpdateProfileResponse
+	30, // 25: stakeholders.StakeholdersService.SetPosition:output_type -> google.protobuf.Empty
+	26, // 26: stakeholders.StakeholdersService.GetPosition:output_type -> stakeholders.PositionResponse
+	7,  // 27: stakeholders.StakeholdersService.VerifyEmail:output_type -> stakeholders.VerifyEmailResponse
+	9,  // 28: stakeholders.StakeholdersService.ResendVerificationEmail:output_type -> stakeholders.ResendVerificationEmailResponse
+	11, // 29: stakeholders.StakeholdersService.RequestPasswordReset:output_type -> stakeholders.RequestPasswordResetResponse
+	13, // 30: stakeholders.StakeholdersService.ResetPassword:output_type -> stakeholders.ResetPasswordResponse
+	15, // 31: stakeholders.StakeholdersService.ChangePassword:output_type -> stakeholders.ChangePasswordResponse
+	1,  // 32: stakeholders.StakeholdersService.ValidateToken:output_type -> stakeholders.ValidateTokenResponse
+	18, // [18:33] is the sub-list for method output_type
+	3,  // [3:18] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_stakeholders_stakeholders_proto_init() }
@@ -1189,7 +1707,7 @@ func file_stakeholders_stakeholders_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_stakeholders_stakeholders_proto_rawDesc), len(file_stakeholders_stakeholders_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   19,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,16 +20,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	StakeholdersService_Register_FullMethodName             = "/stakeholders.StakeholdersService/Register"
-	StakeholdersService_Login_FullMethodName                = "/stakeholders.StakeholdersService/Login"
-	StakeholdersService_GetAllUsers_FullMethodName          = "/stakeholders.StakeholdersService/GetAllUsers"
-	StakeholdersService_BlockUser_FullMethodName            = "/stakeholders.StakeholdersService/BlockUser"
-	StakeholdersService_GetProfileByUsername_FullMethodName = "/stakeholders.StakeholdersService/GetProfileByUsername"
-	StakeholdersService_GetProfile_FullMethodName           = "/stakeholders.StakeholdersService/GetProfile"
-	StakeholdersService_UpdateProfile_FullMethodName        = "/stakeholders.StakeholdersService/UpdateProfile"
-	StakeholdersService_SetPosition_FullMethodName          = "/stakeholders.StakeholdersService/SetPosition"
-	StakeholdersService_GetPosition_FullMethodName          = "/stakeholders.StakeholdersService/GetPosition"
-	StakeholdersService_ValidateToken_FullMethodName        = "/stakeholders.StakeholdersService/ValidateToken"
+	StakeholdersService_Register_FullMethodName                = "/stakeholders.StakeholdersService/Register"
+	StakeholdersService_Login_FullMethodName                   = "/stakeholders.StakeholdersService/Login"
+	StakeholdersService_GetAllUsers_FullMethodName             = "/stakeholders.StakeholdersService/GetAllUsers"
+	StakeholdersService_BlockUser_FullMethodName               = "/stakeholders.StakeholdersService/BlockUser"
+	StakeholdersService_GetProfileByUsername_FullMethodName    = "/stakeholders.StakeholdersService/GetProfileByUsername"
+	StakeholdersService_GetProfile_FullMethodName              = "/stakeholders.StakeholdersService/GetProfile"
+	StakeholdersService_UpdateProfile_FullMethodName           = "/stakeholders.StakeholdersService/UpdateProfile"
+	StakeholdersService_SetPosition_FullMethodName             = "/stakeholders.StakeholdersService/SetPosition"
+	StakeholdersService_GetPosition_FullMethodName             = "/stakeholders.StakeholdersService/GetPosition"
+	StakeholdersService_VerifyEmail_FullMethodName             = "/stakeholders.StakeholdersService/VerifyEmail"
+	StakeholdersService_ResendVerificationEmail_FullMethodName = "/stakeholders.StakeholdersService/ResendVerificationEmail"
+	StakeholdersService_RequestPasswordReset_FullMethodName    = "/stakeholders.StakeholdersService/RequestPasswordReset"
+	StakeholdersService_ResetPassword_FullMethodName           = "/stakeholders.StakeholdersService/ResetPassword"
+	StakeholdersService_ChangePassword_FullMethodName          = "/stakeholders.StakeholdersService/ChangePassword"
+	StakeholdersService_ValidateToken_FullMethodName           = "/stakeholders.StakeholdersService/ValidateToken"
 )
 
 // StakeholdersServiceClient is the client API for StakeholdersService service.
@@ -45,6 +50,13 @@ type StakeholdersServiceClient interface {
 	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error)
 	SetPosition(ctx context.Context, in *PositionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetPosition(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PositionResponse, error)
+	// Javne rute za potvrdu email adrese i zaboravljenu lozinku. Odgovor na
+	// zahteve sa email adresom je isti bez obzira na to da li nalog postoji.
+	VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error)
+	ResendVerificationEmail(ctx context.Context, in *ResendVerificationEmailRequest, opts ...grpc.CallOption) (*ResendVerificationEmailResponse, error)
+	RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetResponse, error)
+	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error)
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
 	ValidateToken(ctx context.Context, in *ValidateTokenRequest, opts ...grpc.CallOption) (*ValidateTokenResponse, error)
 }
 
@@ -146,6 +158,56 @@ func (c *stakeholdersServiceClient) GetPosition(ctx context.Context, in *emptypb
 	return out, nil
 }
 
+func (c *stakeholdersServiceClient) VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyEmailResponse)
+	err := c.cc.Invoke(ctx, StakeholdersService_VerifyEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stakeholdersServiceClient) ResendVerificationEmail(ctx context.Context, in *ResendVerificationEmailRequest, opts ...grpc.CallOption) (*ResendVerificationEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResendVerificationEmailResponse)
+	err := c.cc.Invoke(ctx, StakeholdersService_ResendVerificationEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stakeholdersServiceClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestPasswordResetResponse)
+	err := c.cc.Invoke(ctx, StakeholdersService_RequestPasswordReset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stakeholdersServiceClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetPasswordResponse)
+	err := c.cc.Invoke(ctx, StakeholdersService_ResetPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stakeholdersServiceClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangePasswordResponse)
+	err := c.cc.Invoke(ctx, StakeholdersService_ChangePassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *stakeholdersServiceClient) ValidateToken(ctx context.Context, in *ValidateTokenRequest, opts ...grpc.CallOption) (*ValidateTokenResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ValidateTokenResponse)
@@ -169,6 +231,13 @@ type StakeholdersServiceServer interface {
 	UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error)
 	SetPosition(context.Context, *PositionRequest) (*emptypb.Empty, error)
 	GetPosition(context.Context, *emptypb.Empty) (*PositionResponse, error)
+	// Javne rute za potvrdu email adrese i zaboravljenu lozinku. Odgovor na
+	// zahteve sa email adresom je isti bez obzira na to da li nalog postoji.
+	VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error)
+	ResendVerificationEmail(context.Context, *ResendVerificationEmailRequest) (*ResendVerificationEmailResponse, error)
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetResponse, error)
+	ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error)
+	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
 	ValidateToken(context.Context, *ValidateTokenRequest) (*ValidateTokenResponse, error)
 	mustEmbedUnimplementedStakeholdersServiceServer()
 }
@@ -207,6 +276,21 @@ func (UnimplementedStakeholdersServiceServer) SetPosition(context.Context, *Posi
 func (UnimplementedStakeholdersServiceServer) GetPosition(context.Context, *emptypb.Empty) (*PositionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPosition not implemented")
 }
+func (UnimplementedStakeholdersServiceServer) VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyEmail not implemented")
+}
+func (UnimplementedStakeholdersServiceServer) ResendVerificationEmail(context.Context, *ResendVerificationEmailRequest) (*ResendVerificationEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResendVerificationEmail not implemented")
+}
+func (UnimplementedStakeholdersServiceServer) RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (UnimplementedStakeholdersServiceServer) ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (UnimplementedStakeholdersServiceServer) ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
 func (UnimplementedStakeholdersServiceServer) ValidateToken(context.Context, *ValidateTokenRequest) (*ValidateTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidateToken not implemented")
 }
@@ -393,6 +477,96 @@ func _StakeholdersService_GetPosition_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StakeholdersService_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StakeholdersServiceServer).VerifyEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StakeholdersService_VerifyEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StakeholdersServiceServer).VerifyEmail(ctx, req.(*VerifyEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StakeholdersService_ResendVerificationEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResendVerificationEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StakeholdersServiceServer).ResendVerificationEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StakeholdersService_ResendVerificationEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StakeholdersServiceServer).ResendVerificationEmail(ctx, req.(*ResendVerificationEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StakeholdersService_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StakeholdersServiceServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StakeholdersService_RequestPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StakeholdersServiceServer).RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StakeholdersService_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StakeholdersServiceServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StakeholdersService_ResetPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StakeholdersServiceServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StakeholdersService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StakeholdersServiceServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StakeholdersService_ChangePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StakeholdersServiceServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StakeholdersService_ValidateToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ValidateTokenRequest)
 	if err := dec(in); err != nil {
@@ -454,6 +628,26 @@ var StakeholdersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPosition",
 			Handler:    _StakeholdersService_GetPosition_Handler,
 		},
+		{
+			MethodName: "VerifyEmail",
+			Handler:    _StakeholdersService_VerifyEmail_Handler,
+		},
+		{
+			MethodName: "ResendVerificationEmail",
+			Handler:    _StakeholdersService_ResendVerificationEmail_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _StakeholdersService_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _StakeholdersService_ResetPassword_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _StakeholdersService_ChangePassword_Handler,
+		},
 		{
 			MethodName: "ValidateToken",
 			Handler:    _StakeholdersService_ValidateToken_Handler,
@@ -35,14 +35,23 @@ func AuthMetadata(ctx context.Context) (metadata.MD, error) {
 	), nil
 }
 
-func JWTMiddleware(next http.Handler, stakeholdersClient stakeproto.StakeholdersServiceClient) http.Handler {
-	publicPaths := map[string]bool{
-		"/api/auth/login":    true,
-		"/api/auth/register": true,
-	}
+// Rute kojima se pristupa bez tokena
+var publicPaths = map[string]bool{
+	"/api/auth/login":                  true,
+	"/api/auth/register":               true,
+	"/api/auth/verify-email":           true,
+	"/api/auth/verify-email/resend":    true,
+	"/api/auth/password-reset":         true,
+	"/api/auth/password-reset/confirm": true,
+}
 
+func IsPublicPath(path string) bool {
+	return publicPaths[path]
+}
+
+func JWTMiddleware(next http.Handler, stakeholdersClient stakeproto.StakeholdersServiceClient) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if publicPaths[r.URL.Path] {
+		if IsPublicPath(r.URL.Path) {
 			next.ServeHTTP(w, r)
 			return
 		}
@@ -0,0 +1,74 @@
+package utils
+
+import (
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"shared/storage"
+)
+
+const signedURLExpiry = 15 * time.Minute
+
+// UploadsHandler servira uploadovane fajlove svih servisa iz zajednickog
+// storage-a. Kada je signed ukljucen, klijent se preusmerava na potpisani URL
+// (npr. direktno na S3/MinIO). Fajlovi koji nisu u storage-u (stari uploadi
+// sa diska servisa) prosledjuju se na fallback handler.
+func UploadsHandler(store storage.Storage, signed bool, fallback http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		key, ok := storage.KeyFromURL(r.URL.Path)
+		if !ok || !strings.Contains(key, "/") {
+			fallback.ServeHTTP(w, r)
+			return
+		}
+
+		if signed {
+			url, err := store.SignedURL(r.Context(), key, signedURLExpiry)
+			if err != nil {
+				log.Printf("Failed to sign upload url for %s: %v", key, err)
+				http.Error(w, "Failed to read file", http.StatusInternalServerError)
+				return
+			}
+			if url != r.URL.Path {
+				http.Redirect(w, r, url, http.StatusFound)
+				return
+			}
+		}
+
+		obj, info, err := store.Get(r.Context(), key)
+		if err != nil {
+			if errors.Is(err, storage.ErrNotFound) {
+				fallback.ServeHTTP(w, r)
+				return
+			}
+			log.Printf("Failed to read upload %s: %v", key, err)
+			http.Error(w, "Failed to read file", http.StatusInternalServerError)
+			return
+		}
+		defer obj.Close()
+
+		if info.ContentType != "" {
+			w.Header().Set("Content-Type", info.ContentType)
+		}
+		w.Header().Set("Content-Length", strconv.FormatInt(info.Size, 10))
+		w.Header().Set("Last-Modified", info.LastModified.UTC().Format(http.TimeFormat))
+		// Kljucevi su jedinstveni (uuid) pa se sadrzaj nikad ne menja
+		w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+
+		if r.Method == http.MethodHead {
+			return
+		}
+		if _, err := io.Copy(w, obj); err != nil {
+			log.Printf("Failed to stream upload %s: %v", key, err)
+		}
+	})
+}
@@ -5,9 +5,13 @@ import (
 	"log"
 	"time"
 
+	"github.com/lib/pq"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 
+	"shared/outbox"
+	"shared/uploads"
+	"soa/blog-service/markdown"
 	"soa/blog-service/models"
 )
 
@@ -26,11 +30,39 @@ func InitDB(connStr string) {
 
 	fmt.Println("Uspješno povezano sa PostgreSQL bazom podataka koristeći GORM!")
 
-	err = GORM_DB.AutoMigrate(&models.Post{}, &models.Comment{}, &models.Like{})
+	// Kljucevi varijanti su ranije cuvani kao text[]; zajednicki model ih cuva
+	// kao jsonb, isto kao tours-service
+	err = GORM_DB.Exec(`
+		DO $$
+		BEGIN
+			IF EXISTS (
+				SELECT 1 FROM information_schema.columns
+				WHERE table_schema = current_schema() AND table_name = 'uploads'
+				AND column_name = 'keys' AND data_type = 'ARRAY'
+			) THEN
+				ALTER TABLE uploads ALTER COLUMN keys TYPE jsonb USING to_jsonb(keys);
+			END IF;
+		END $$;
+	`).Error
+	if err != nil {
+		log.Fatalf("Greška pri konverziji kolone 'uploads.keys' u jsonb: %v", err)
+	}
+
+	err = GORM_DB.AutoMigrate(&models.Post{}, &models.Comment{}, &models.Like{}, &uploads.Upload{}, &models.PostRevision{}, &models.TimelineEntry{}, &models.TimelineState{}, &models.HeavyAuthor{}, &outbox.Event{})
 	if err != nil {
 		log.Fatalf("Greška pri automatskoj migraciji šeme baze podataka: %v", err)
 	}
-	fmt.Println("Migracija tabela 'posts', 'comments', 'likes' uspješno završena (GORM AutoMigrate).")
+	fmt.Println("Migracija tabela 'posts', 'comments', 'likes', 'uploads', 'post_revisions', 'timeline_entries' uspješno završena (GORM AutoMigrate).")
+
+	// Stari ToggleLike nije bio transakcioni, pa su moguci dupli lajkovi i
+	// brojaci koji ne odgovaraju stvarnom broju lajkova
+	err = GORM_DB.Exec(`
+		DELETE FROM likes a USING likes b
+		WHERE a.post_id = b.post_id AND a.user_id = b.user_id AND a.ctid > b.ctid;
+	`).Error
+	if err != nil {
+		log.Printf("Upozorenje: Greška pri uklanjanju duplih lajkova: %v", err)
+	}
 
 	err = GORM_DB.Exec(`
 		CREATE UNIQUE INDEX IF NOT EXISTS unique_like_per_user_post
@@ -42,6 +74,33 @@ func InitDB(connStr string) {
 		fmt.Println("Jedinstveni indeks 'unique_like_per_user_post' na tabeli 'likes' je osiguran.")
 	}
 
+	err = GORM_DB.Exec(`
+		UPDATE posts p SET likes_count = l.cnt
+		FROM (SELECT p2.id, COUNT(l2.id) AS cnt FROM posts p2 LEFT JOIN likes l2 ON l2.post_id = p2.id GROUP BY p2.id) l
+		WHERE p.id = l.id AND p.likes_count <> l.cnt;
+	`).Error
+	if err != nil {
+		log.Printf("Upozorenje: Greška pri usklađivanju broja lajkova: %v", err)
+	}
+
+	err = GORM_DB.Exec(`UPDATE posts SET published_at = created_at WHERE status = 'published' AND published_at IS NULL;`).Error
+	if err != nil {
+		log.Printf("Upozorenje: Greška pri postavljanju vremena objave: %v", err)
+	}
+
+	// Pre uvodjenja timeline-a nijedan post nije rasporedjen; timeline-ovi se
+	// grade iz tabele posts pri prvom citanju, pa stare postove ne treba
+	// ponovo rasporedjivati
+	err = GORM_DB.Exec(`
+		UPDATE posts SET fanned_out_at = now()
+		WHERE fanned_out_at IS NULL AND status = 'published' AND NOT EXISTS (SELECT 1 FROM timeline_states);
+	`).Error
+	if err != nil {
+		log.Printf("Upozorenje: Greška pri označavanju rasporedjenih postova: %v", err)
+	}
+
+	backfillRenderedMarkdown()
+
 	sqlDB, err := GORM_DB.DB()
 	if err != nil {
 		log.Fatalf("Greška pri dobijanju underlying *sql.DB iz GORM-a: %v", err)
@@ -51,6 +110,50 @@ func InitDB(connStr string) {
 	sqlDB.SetConnMaxLifetime(5 * time.Minute)
 }
 
+// backfillRenderedMarkdown renderuje postove i komentare sacuvane pre nego sto
+// je servis poceo da cuva HTML verziju teksta.
+func backfillRenderedMarkdown() {
+	var posts []models.Post
+	if err := GORM_DB.Where("description_html = '' AND description <> ''").Find(&posts).Error; err != nil {
+		log.Printf("Upozorenje: Greška pri dohvatanju postova za renderovanje: %v", err)
+		return
+	}
+	for _, post := range posts {
+		rendered, err := markdown.Render(post.Description)
+		if err != nil {
+			log.Printf("Upozorenje: Greška pri renderovanju posta %s: %v", post.ID, err)
+			continue
+		}
+		GORM_DB.Model(&post).UpdateColumns(map[string]interface{}{
+			"description_html": rendered.HTML,
+			"mentions":         pq.StringArray(rendered.Mentions),
+			"hashtags":         pq.StringArray(rendered.Hashtags),
+		})
+	}
+
+	var comments []models.Comment
+	if err := GORM_DB.Where("text_html = '' AND text <> ''").Find(&comments).Error; err != nil {
+		log.Printf("Upozorenje: Greška pri dohvatanju komentara za renderovanje: %v", err)
+		return
+	}
+	for _, comment := range comments {
+		rendered, err := markdown.Render(comment.Text)
+		if err != nil {
+			log.Printf("Upozorenje: Greška pri renderovanju komentara %s: %v", comment.ID, err)
+			continue
+		}
+		GORM_DB.Model(&comment).UpdateColumns(map[string]interface{}{
+			"text_html": rendered.HTML,
+			"mentions":  pq.StringArray(rendered.Mentions),
+			"hashtags":  pq.StringArray(rendered.Hashtags),
+		})
+	}
+
+	if len(posts)+len(comments) > 0 {
+		fmt.Printf("Renderovano %d postova i %d komentara.\n", len(posts), len(comments))
+	}
+}
+
 func CloseDB() {
 	if GORM_DB != nil {
 		sqlDB, err := GORM_DB.DB()
@@ -0,0 +1,151 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"soa/blog-service/database"
+	"soa/blog-service/markdown"
+	"soa/blog-service/models"
+	blogproto "soa/blog-service/proto/blog"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (s *BlogServer) UpdateComment(ctx context.Context, req *blogproto.UpdateCommentRequest) (*blogproto.Comment, error) {
+	_, userId, _, err := GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "Nevalidan token: %v", err)
+	}
+
+	comment, err := loadComment(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.Deleted {
+		return nil, status.Errorf(codes.NotFound, "Komentar nije pronađen.")
+	}
+	if comment.UserID != userId {
+		return nil, status.Errorf(codes.PermissionDenied, "Samo autor može menjati komentar.")
+	}
+	if req.GetText() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Tekst komentara je obavezan.")
+	}
+
+	rendered, err := markdown.Render(req.GetText())
+	if err != nil {
+		log.Printf("Greška pri renderovanju komentara %s: %v", comment.ID, err)
+		return nil, status.Errorf(codes.Internal, "Greška servera pri izmeni komentara.")
+	}
+
+	comment.Text = req.GetText()
+	comment.TextHTML = rendered.HTML
+	comment.Mentions = rendered.Mentions
+	comment.Hashtags = rendered.Hashtags
+	comment.UpdatedAt = time.Now()
+	if err := database.GORM_DB.Save(comment).Error; err != nil {
+		log.Printf("Greška pri izmeni komentara %s: %v", comment.ID, err)
+		return nil, status.Errorf(codes.Internal, "Greška servera pri izmeni komentara.")
+	}
+
+	return convertCommentToProto(comment), nil
+}
+
+// DeleteComment dozvoljava brisanje autoru komentara i autoru posta, koji
+// moderira diskusiju ispod svog posta.
+func (s *BlogServer) DeleteComment(ctx context.Context, req *blogproto.DeleteCommentRequest) (*blogproto.DeleteCommentResponse, error) {
+	_, userId, _, err := GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "Nevalidan token: %v", err)
+	}
+
+	comment, err := loadComment(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if comment.Deleted {
+		return nil, status.Errorf(codes.NotFound, "Komentar nije pronađen.")
+	}
+
+	if comment.UserID != userId {
+		var post models.Post
+		if err := database.GORM_DB.Unscoped().Select("id", "user_id").First(&post, "id = ?", comment.PostID).Error; err != nil || post.UserID != userId {
+			return nil, status.Errorf(codes.PermissionDenied, "Samo autor komentara ili posta može obrisati komentar.")
+		}
+	}
+
+	if err := database.GORM_DB.Transaction(func(tx *gorm.DB) error {
+		// Ponovno citanje pod lock-om, da istovremeno dodat odgovor ne bi
+		// ostao bez roditelja
+		var locked models.Comment
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&locked, "id = ?", comment.ID).Error; err != nil {
+			return err
+		}
+		return deleteComment(tx, &locked)
+	}); err != nil {
+		log.Printf("Greška pri brisanju komentara %s: %v", comment.ID, err)
+		return nil, status.Errorf(codes.Internal, "Greška servera pri brisanju komentara.")
+	}
+
+	return &blogproto.DeleteCommentResponse{Status: "Komentar obrisan"}, nil
+}
+
+// deleteComment brise komentar bez odgovora, a komentar sa odgovorima
+// pretvara u prazan cvor. Roditelj koji je vec obrisan i ostane bez odgovora
+// se takodje uklanja.
+func deleteComment(tx *gorm.DB, comment *models.Comment) error {
+	if comment.ReplyCount > 0 {
+		return tx.Model(comment).Updates(map[string]interface{}{
+			"deleted":    true,
+			"text":       "",
+			"text_html":  "",
+			"mentions":   nil,
+			"hashtags":   nil,
+			"updated_at": time.Now(),
+		}).Error
+	}
+
+	if err := tx.Delete(comment).Error; err != nil {
+		return err
+	}
+	if comment.ParentID == nil {
+		return nil
+	}
+
+	var parent models.Comment
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&parent, "id = ?", *comment.ParentID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+	parent.ReplyCount--
+	if parent.Deleted && parent.ReplyCount <= 0 {
+		return deleteComment(tx, &parent)
+	}
+	return tx.Model(&parent).Update("reply_count", gorm.Expr("GREATEST(reply_count - 1, 0)")).Error
+}
+
+func loadComment(id string) (*models.Comment, error) {
+	commentID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Neispravan format ID-a komentara.")
+	}
+
+	var comment models.Comment
+	if err := database.GORM_DB.First(&comment, "id = ?", commentID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "Komentar nije pronađen.")
+		}
+		log.Printf("Greška pri dohvatanju komentara %s: %v", commentID, err)
+		return nil, status.Errorf(codes.Internal, "Greška servera pri dohvatanju komentara.")
+	}
+	return &comment, nil
+}
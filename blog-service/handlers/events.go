@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"time"
+
+	"shared/outbox"
+	"soa/blog-service/models"
+
+	"gorm.io/gorm"
+)
+
+const (
+	SubjectPostCreated   = "blog_post_created"
+	SubjectPostPublished = "blog_post_published"
+	SubjectCommentAdded  = "blog_comment_added"
+	SubjectPostLiked     = "blog_post_liked"
+)
+
+// PostEvent se objavljuje kada se post kreira (i kao draft) i kada se draft
+// objavi.
+type PostEvent struct {
+	PostID      string                `json:"postId"`
+	AuthorID    string                `json:"authorId"`
+	Username    string                `json:"username"`
+	Title       string                `json:"title"`
+	Status      models.PostStatus     `json:"status"`
+	Visibility  models.PostVisibility `json:"visibility"`
+	TourIDs     []string              `json:"tourIds,omitempty"`
+	Mentions    []string              `json:"mentions,omitempty"`
+	Hashtags    []string              `json:"hashtags,omitempty"`
+	PublishedAt *time.Time            `json:"publishedAt,omitempty"`
+}
+
+// CommentEvent se objavljuje za svaki novi komentar i odgovor. ParentAuthor
+// je prazan za komentare prvog nivoa.
+type CommentEvent struct {
+	CommentID      string                `json:"commentId"`
+	PostID         string                `json:"postId"`
+	PostAuthorID   string                `json:"postAuthorId"`
+	PostAuthor     string                `json:"postAuthor"`
+	PostVisibility models.PostVisibility `json:"postVisibility"`
+	ParentID       string                `json:"parentId,omitempty"`
+	ParentAuthorID string                `json:"parentAuthorId,omitempty"`
+	ParentAuthor   string                `json:"parentAuthor,omitempty"`
+	UserID         string                `json:"userId"`
+	Username       string                `json:"username"`
+	Mentions       []string              `json:"mentions,omitempty"`
+}
+
+// LikeEvent se objavljuje kada korisnik lajkuje post. Uklanjanje lajka se ne
+// objavljuje.
+type LikeEvent struct {
+	PostID       string `json:"postId"`
+	PostAuthorID string `json:"postAuthorId"`
+	PostAuthor   string `json:"postAuthor"`
+	PostTitle    string `json:"postTitle"`
+	UserID       string `json:"userId"`
+	Username     string `json:"username"`
+}
+
+func addPostEvent(tx *gorm.DB, subject string, post *models.Post) error {
+	return outbox.Add(tx, subject, PostEvent{
+		PostID:      post.ID.String(),
+		AuthorID:    post.UserID,
+		Username:    post.Username,
+		Title:       post.Title,
+		Status:      post.Status,
+		Visibility:  post.Visibility,
+		TourIDs:     post.TourIDs,
+		Mentions:    post.Mentions,
+		Hashtags:    post.Hashtags,
+		PublishedAt: post.PublishedAt,
+	})
+}
+
+func addCommentEvent(tx *gorm.DB, comment *models.Comment, post *models.Post, parent *models.Comment) error {
+	event := CommentEvent{
+		CommentID:      comment.ID.String(),
+		PostID:         comment.PostID.String(),
+		PostAuthorID:   post.UserID,
+		PostAuthor:     post.Username,
+		PostVisibility: post.Visibility,
+		UserID:         comment.UserID,
+		Username:       comment.Username,
+		Mentions:       comment.Mentions,
+	}
+	if parent != nil {
+		event.ParentID = parent.ID.String()
+		event.ParentAuthorID = parent.UserID
+		event.ParentAuthor = parent.Username
+	}
+	return outbox.Add(tx, SubjectCommentAdded, event)
+}
+
+func addLikeEvent(tx *gorm.DB, post *models.Post, like *models.Like) error {
+	return outbox.Add(tx, SubjectPostLiked, LikeEvent{
+		PostID:       post.ID.String(),
+		PostAuthorID: post.UserID,
+		PostAuthor:   post.Username,
+		PostTitle:    post.Title,
+		UserID:       like.UserID,
+		Username:     like.Username,
+	})
+}
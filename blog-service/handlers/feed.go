@@ -0,0 +1,312 @@
+package handlers
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"soa/blog-service/database"
+	"soa/blog-service/models"
+	blogproto "soa/blog-service/proto/blog"
+	followerproto "soa/blog-service/proto/follower"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	FeedModeRecent = "recent"
+	FeedModeRanked = "ranked"
+
+	followerTimeout = 2 * time.Second
+
+	// Rangirani feed bira najbolje postove iz ogranicenog skupa skorasnjih
+	// postova, a ne iz cele istorije
+	rankedWindow     = 30 * 24 * time.Hour
+	rankedCandidates = 500
+	affinityWindow   = 90 * 24 * time.Hour
+
+	recencyWeight   = 3.0
+	recencyHalfLife = 24.0 // sati
+	likeWeight      = 1.0
+	commentWeight   = 1.5
+	affinityWeight  = 2.0
+
+	followingCacheSize   = 10000
+	followingCacheMaxAge = 24 * time.Hour
+)
+
+// followingCache pamti poslednji uspesno dohvacen spisak pracenih korisnika,
+// da bi feed mogao da radi i dok follower-service nije dostupan. Spisak
+// stariji od followingCacheMaxAge se ne koristi.
+var followingCache = newLRUCache[string, []string](followingCacheSize, followingCacheMaxAge)
+
+func (s *BlogServer) GetFeed(ctx context.Context, req *blogproto.GetFeedRequest) (*blogproto.GetFeedResponse, error) {
+	currentUsername, userId, _, err := GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "Nevalidan token: %v", err)
+	}
+
+	mode := req.GetMode()
+	if mode == "" {
+		mode = FeedModeRecent
+	}
+	if mode != FeedModeRecent && mode != FeedModeRanked {
+		return nil, status.Errorf(codes.InvalidArgument, "Nepoznat način prikaza feed-a: %s.", mode)
+	}
+
+	authors, degraded := feedAuthors(ctx, currentUsername)
+	if !degraded {
+		if err := ensureTimeline(currentUsername, authors); err != nil {
+			log.Printf("Greška pri izgradnji timeline-a za %s: %v", currentUsername, err)
+		}
+	}
+	hidden, restrictionsDegraded, err := hiddenAuthors(ctx, currentUsername)
+	if err != nil {
+		return nil, err
+	}
+	degraded = degraded || restrictionsDegraded
+	scope, err := feedScope(currentUsername, authors, hidden)
+	if err != nil {
+		log.Printf("Greška pri sastavljanju feed-a za %s: %v", currentUsername, err)
+		return nil, status.Errorf(codes.Internal, "Greška servera pri dohvatanju feed-a.")
+	}
+	limit := pageLimit(req.GetLimit())
+
+	var posts []models.Post
+	var nextCursor string
+	if mode == FeedModeRanked {
+		posts, nextCursor, err = rankedFeed(scope, userId, req.GetCursor(), limit)
+	} else {
+		posts, nextCursor, err = recentFeed(scope, req.GetCursor(), limit)
+	}
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			return nil, st.Err()
+		}
+		log.Printf("Greška pri sastavljanju feed-a za %s: %v", currentUsername, err)
+		return nil, status.Errorf(codes.Internal, "Greška servera pri dohvatanju feed-a.")
+	}
+
+	return &blogproto.GetFeedResponse{Posts: postsToProto(ctx, posts), NextCursor: nextCursor, Degraded: degraded}, nil
+}
+
+// feedAuthors vraca korisnike cije postove feed prikazuje. Ako follower-service
+// ne odgovori, koristi se poslednji poznati spisak, a ako ni njega nema, feed
+// sadrzi samo postove trenutnog korisnika.
+func feedAuthors(ctx context.Context, username string) ([]string, bool) {
+	callCtx, cancel := context.WithTimeout(ctx, followerTimeout)
+	defer cancel()
+
+	resp, err := followerClient.GetFollowing(callCtx, &followerproto.GetFollowingRequest{Username: username})
+	if err == nil {
+		following := append([]string(nil), resp.GetFollowing()...)
+		followingCache.Set(username, following)
+		return append(following, username), false
+	}
+
+	log.Printf("Follower-service nije dostupan, feed za %s se sastavlja bez svezeg spiska pracenih: %v", username, err)
+	following, _, _ := followingCache.Get(username)
+	return append(append([]string(nil), following...), username), true
+}
+
+func recentFeed(scope func(*gorm.DB) *gorm.DB, cursorStr string, limit int) ([]models.Post, string, error) {
+	query := database.GORM_DB.Scopes(scope)
+	if cursorStr != "" {
+		c, err := decodeCursor(cursorStr)
+		if err != nil {
+			return nil, "", status.Errorf(codes.InvalidArgument, "Neispravan kursor.")
+		}
+		query = query.Where("(published_at, id) < (?, ?)", c.CreatedAt, c.ID)
+	}
+
+	var posts []models.Post
+	if err := query.Order("published_at DESC, id DESC").Limit(limit + 1).Find(&posts).Error; err != nil {
+		return nil, "", err
+	}
+
+	var nextCursor string
+	if len(posts) > limit {
+		posts = posts[:limit]
+		last := posts[limit-1]
+		nextCursor = encodeCursor(cursor{CreatedAt: *last.PublishedAt, ID: last.ID})
+	}
+	return posts, nextCursor, nil
+}
+
+// rankedCursor pamti trenutak u kome je rangiranje pocelo, pa se sve stranice
+// racunaju u odnosu na isto vreme, i skor i ID poslednjeg posta prethodne
+// stranice. Sledeca stranica pocinje od prvog posta iza te pozicije, pa se
+// postovi ne ponavljaju niti preskacu kada se skup kandidata promeni izmedju
+// zahteva.
+type rankedCursor struct {
+	AsOf  time.Time
+	Score float64
+	ID    uuid.UUID
+}
+
+func encodeRankedCursor(c rankedCursor) string {
+	raw := fmt.Sprintf("%s|%d|%s|%s", FeedModeRanked, c.AsOf.UnixNano(), strconv.FormatFloat(c.Score, 'g', -1, 64), c.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeRankedCursor(s string) (rankedCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return rankedCursor{}, err
+	}
+	parts := strings.Split(string(raw), "|")
+	if len(parts) != 4 || parts[0] != FeedModeRanked {
+		return rankedCursor{}, fmt.Errorf("invalid ranked cursor")
+	}
+	asOf, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return rankedCursor{}, err
+	}
+	score, err := strconv.ParseFloat(parts[2], 64)
+	if err != nil || math.IsNaN(score) {
+		return rankedCursor{}, fmt.Errorf("invalid ranked cursor score")
+	}
+	id, err := uuid.Parse(parts[3])
+	if err != nil {
+		return rankedCursor{}, err
+	}
+	return rankedCursor{AsOf: time.Unix(0, asOf), Score: score, ID: id}, nil
+}
+
+type scoredPost struct {
+	post  models.Post
+	score float64
+}
+
+// rankedBefore javlja da li post a dolazi pre posta b u rangiranom feed-u:
+// veci skor prvi, a pri istom skoru manji ID.
+func rankedBefore(a, b scoredPost) bool {
+	if a.score != b.score {
+		return a.score > b.score
+	}
+	return a.post.ID.String() < b.post.ID.String()
+}
+
+func rankedFeed(scope func(*gorm.DB) *gorm.DB, userId, cursorStr string, limit int) ([]models.Post, string, error) {
+	asOf := time.Now()
+	var after *rankedCursor
+	if cursorStr != "" {
+		c, err := decodeRankedCursor(cursorStr)
+		if err != nil {
+			return nil, "", status.Errorf(codes.InvalidArgument, "Neispravan kursor.")
+		}
+		asOf, after = c.AsOf, &c
+	}
+
+	var candidates []models.Post
+	err := database.GORM_DB.Scopes(scope).
+		Where("published_at BETWEEN ? AND ?", asOf.Add(-rankedWindow), asOf).
+		Order("published_at DESC").
+		Limit(rankedCandidates).
+		Find(&candidates).Error
+	if err != nil {
+		return nil, "", err
+	}
+	if len(candidates) == 0 {
+		return []models.Post{}, "", nil
+	}
+
+	commentCounts, err := commentCountsFor(candidates)
+	if err != nil {
+		return nil, "", err
+	}
+	affinity, err := authorAffinity(userId, asOf)
+	if err != nil {
+		return nil, "", err
+	}
+
+	scored := make([]scoredPost, 0, len(candidates))
+	for _, post := range candidates {
+		ageHours := asOf.Sub(*post.PublishedAt).Hours()
+		sp := scoredPost{post: post, score: recencyWeight*math.Exp(-ageHours*math.Ln2/recencyHalfLife) +
+			likeWeight*math.Log1p(float64(post.LikesCount)) +
+			commentWeight*math.Log1p(float64(commentCounts[post.ID])) +
+			affinityWeight*math.Log1p(float64(affinity[post.Username]))}
+		if after != nil && !rankedBefore(scoredPost{post: models.Post{ID: after.ID}, score: after.Score}, sp) {
+			continue
+		}
+		scored = append(scored, sp)
+	}
+	sort.Slice(scored, func(i, j int) bool { return rankedBefore(scored[i], scored[j]) })
+
+	end := min(limit, len(scored))
+	posts := make([]models.Post, 0, end)
+	for _, sp := range scored[:end] {
+		posts = append(posts, sp.post)
+	}
+
+	var nextCursor string
+	if end < len(scored) {
+		last := scored[end-1]
+		nextCursor = encodeRankedCursor(rankedCursor{AsOf: asOf, Score: last.score, ID: last.post.ID})
+	}
+	return posts, nextCursor, nil
+}
+
+func commentCountsFor(posts []models.Post) (map[uuid.UUID]int, error) {
+	ids := make([]uuid.UUID, len(posts))
+	for i, post := range posts {
+		ids[i] = post.ID
+	}
+
+	var rows []struct {
+		PostID uuid.UUID
+		Count  int
+	}
+	err := database.GORM_DB.Model(&models.Comment{}).
+		Select("post_id, COUNT(*) AS count").
+		Where("post_id IN ? AND deleted = ?", ids, false).
+		Group("post_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[uuid.UUID]int, len(rows))
+	for _, row := range rows {
+		counts[row.PostID] = row.Count
+	}
+	return counts, nil
+}
+
+// authorAffinity broji koliko je korisnik skoro lajkovao i komentarisao
+// postove svakog autora.
+func authorAffinity(userId string, asOf time.Time) (map[string]int, error) {
+	since := asOf.Add(-affinityWindow)
+
+	var rows []struct {
+		Username string
+		Count    int
+	}
+	err := database.GORM_DB.Raw(`
+		SELECT p.username, COUNT(*) AS count FROM (
+			SELECT post_id FROM likes WHERE user_id = ? AND created_at >= ?
+			UNION ALL
+			SELECT post_id FROM comments WHERE user_id = ? AND created_at >= ? AND deleted = false
+		) i
+		JOIN posts p ON p.id = i.post_id
+		GROUP BY p.username`, userId, since, userId, since).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	affinity := make(map[string]int, len(rows))
+	for _, row := range rows {
+		affinity[row.Username] = row.Count
+	}
+	return affinity, nil
+}
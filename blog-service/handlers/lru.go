@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// lruCache je ogranicen kes sa vremenom isteka. Kada se napuni, izbacuje se
+// najduze nekorisceni zapis, a zapisi stariji od maxAge se vise ne vracaju.
+// Get vraca i vreme upisa, pa pozivalac sam odlucuje kada je zapis zastareo
+// ali i dalje upotrebljiv dok udaljeni servis ne odgovara.
+type lruCache[K comparable, V any] struct {
+	mu         sync.Mutex
+	maxEntries int
+	maxAge     time.Duration
+	order      *list.List // najskorije korisceni zapisi su na pocetku
+	entries    map[K]*list.Element
+}
+
+type lruEntry[K comparable, V any] struct {
+	key      K
+	value    V
+	storedAt time.Time
+}
+
+func newLRUCache[K comparable, V any](maxEntries int, maxAge time.Duration) *lruCache[K, V] {
+	return &lruCache[K, V]{
+		maxEntries: maxEntries,
+		maxAge:     maxAge,
+		order:      list.New(),
+		entries:    make(map[K]*list.Element),
+	}
+}
+
+func (c *lruCache[K, V]) Get(key K) (V, time.Time, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		var zero V
+		return zero, time.Time{}, false
+	}
+	entry := elem.Value.(*lruEntry[K, V])
+	if time.Since(entry.storedAt) > c.maxAge {
+		c.order.Remove(elem)
+		delete(c.entries, key)
+		var zero V
+		return zero, time.Time{}, false
+	}
+	c.order.MoveToFront(elem)
+	return entry.value, entry.storedAt, true
+}
+
+func (c *lruCache[K, V]) Set(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		elem.Value = &lruEntry[K, V]{key: key, value: value, storedAt: time.Now()}
+		c.order.MoveToFront(elem)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&lruEntry[K, V]{key: key, value: value, storedAt: time.Now()})
+	for c.order.Len() > c.maxEntries {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*lruEntry[K, V]).key)
+	}
+}
@@ -2,29 +2,38 @@ package handlers
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
 	"log"
 	"net/http"
-	"os"
-	"path/filepath"
 	"time"
 
+	"shared/imaging"
+	"shared/storage"
+	"shared/uploads"
 	"soa/blog-service/database"
+	"soa/blog-service/markdown"
 	"soa/blog-service/models"
 	blogproto "soa/blog-service/proto/blog"
 	followerproto "soa/blog-service/proto/follower"
+	"soa/blog-service/utils"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 const (
 	MaxUploadSize = 5 * 1024 * 1024
-	UploadDir     = "./static/uploads"
+)
+
+var (
+	errParentDeleted    = errors.New("parent comment deleted")
+	errPostNotPublished = errors.New("post not published")
 )
 
 var followerClient followerproto.FollowerServiceClient
@@ -33,6 +42,12 @@ func InitFollowerClient(c followerproto.FollowerServiceClient) {
 	followerClient = c
 }
 
+var fileStorage storage.Storage
+
+func InitStorage(s storage.Storage) {
+	fileStorage = s
+}
+
 type BlogServer struct {
 	blogproto.UnimplementedBlogServiceServer
 }
@@ -51,22 +66,67 @@ func (s *BlogServer) CreatePost(ctx context.Context, req *blogproto.CreatePostRe
 		return nil, status.Errorf(codes.InvalidArgument, "Naslov i opis su obavezni.")
 	}
 
-	newPost := models.Post{
-		UserID:      userId,
-		Username:    currentUsername,
-		Title:       req.GetTitle(),
-		Description: req.GetDescription(),
-		CreatedAt:   time.Now(),
-		ImageURLs:   req.GetImageUrls(),
+	rendered, err := markdown.Render(req.GetDescription())
+	if err != nil {
+		log.Printf("Greška pri renderovanju opisa posta: %v", err)
+		return nil, status.Errorf(codes.Internal, "Greška servera pri kreiranju posta.")
 	}
 
-	result := database.GORM_DB.Create(&newPost)
-	if result.Error != nil {
-		log.Printf("Greška pri čuvanju posta u bazu: %v", result.Error)
+	visibility, err := parseVisibility(req.GetVisibility(), models.VisibilityPublic)
+	if err != nil {
+		return nil, err
+	}
+
+	tourIDs, err := validateTourIDs(ctx, req.GetTourIds())
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	newPost := models.Post{
+		UserID:          userId,
+		Username:        currentUsername,
+		Title:           req.GetTitle(),
+		Description:     req.GetDescription(),
+		DescriptionHTML: rendered.HTML,
+		Mentions:        rendered.Mentions,
+		Hashtags:        rendered.Hashtags,
+		CreatedAt:       now,
+		ImageURLs:       req.GetImageUrls(),
+		TourIDs:         tourIDs,
+		Status:          models.PostPublished,
+		Visibility:      visibility,
+		PublishedAt:     &now,
+	}
+	if req.GetDraft() {
+		newPost.Status = models.PostDraft
+		newPost.PublishedAt = nil
+	}
+
+	err = database.GORM_DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&newPost).Error; err != nil {
+			return err
+		}
+		for _, imageURL := range newPost.ImageURLs {
+			if err := uploads.Attach(tx, imageURL, models.UploadRefPost, newPost.ID.String(), userId); err != nil {
+				return err
+			}
+		}
+		return addPostEvent(tx, SubjectPostCreated, &newPost)
+	})
+	if errors.Is(err, uploads.ErrNotOwner) {
+		return nil, status.Errorf(codes.PermissionDenied, "Slika ne pripada korisniku ili je već iskorišćena u drugom postu.")
+	}
+	if err != nil {
+		log.Printf("Greška pri čuvanju posta u bazu: %v", err)
 		return nil, status.Errorf(codes.Internal, "Greška servera pri kreiranju posta.")
 	}
 
-	protoPost := convertPostToProto(&newPost)
+	if newPost.Status == models.PostPublished {
+		go fanOutPost(newPost)
+	}
+
+	protoPost := postToProto(ctx, &newPost)
 	fmt.Printf("Novi post kreiran: %+v\n", protoPost)
 	return protoPost, nil
 }
@@ -77,8 +137,15 @@ func HandleImageUpload(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := r.ParseMultipartForm(MaxUploadSize)
+	// Upload se vezuje za korisnika iz tokena, pa kasnije samo on moze da
+	// ga prikaci za svoj post (uploads.Attach proverava vlasnika)
+	ownerID, err := uploadOwnerID(r)
 	if err != nil {
+		http.Error(w, "Nevalidan token.", http.StatusUnauthorized)
+		return
+	}
+
+	if err := r.ParseMultipartForm(MaxUploadSize); err != nil {
 		http.Error(w, "Fajl je prevelik. Maksimalna veličina je 5MB.", http.StatusBadRequest)
 		return
 	}
@@ -90,37 +157,62 @@ func HandleImageUpload(w http.ResponseWriter, r *http.Request) {
 	}
 	defer file.Close()
 
-	if _, err := os.Stat(UploadDir); os.IsNotExist(err) {
-		if err := os.MkdirAll(UploadDir, 0755); err != nil {
-			log.Printf("Greška pri kreiranju direktorijuma %s: %v", UploadDir, err)
-			http.Error(w, "Greška servera pri kreiranju foldera za upload.", http.StatusInternalServerError)
-			return
-		}
+	if fileHeader.Size > MaxUploadSize {
+		http.Error(w, "Fajl je prevelik. Maksimalna veličina je 5MB.", http.StatusBadRequest)
+		return
 	}
 
-	fileExtension := filepath.Ext(fileHeader.Filename)
-	newFileName := uuid.New().String() + fileExtension
-	filePath := filepath.Join(UploadDir, newFileName)
+	data, err := imaging.ReadUpload(file)
+	if err != nil {
+		http.Error(w, "Fajl je prevelik. Maksimalna veličina je 5MB.", http.StatusBadRequest)
+		return
+	}
 
-	dst, err := os.Create(filePath)
+	variants, err := imaging.SaveVariants(r.Context(), fileStorage, "blog/"+uuid.New().String(), data)
 	if err != nil {
-		log.Printf("Greška pri kreiranju fajla na disku: %v", err)
+		if errors.Is(err, imaging.ErrInvalidImage) {
+			http.Error(w, "Fajl nije ispravna slika (dozvoljeni su jpeg, png, gif i webp).", http.StatusBadRequest)
+			return
+		}
+		log.Printf("Greška pri čuvanju fajla u storage: %v", err)
 		http.Error(w, "Greška servera pri čuvanju fajla.", http.StatusInternalServerError)
 		return
 	}
-	defer dst.Close()
 
-	_, err = io.Copy(dst, file)
-	if err != nil {
-		log.Printf("Greška pri kopiranju fajla na disk: %v", err)
+	if err := uploads.Register(database.GORM_DB, ownerID, variants); err != nil {
+		log.Printf("Greška pri evidentiranju uploada: %v", err)
+		for _, url := range variants {
+			if key, ok := storage.KeyFromURL(url); ok {
+				fileStorage.Delete(r.Context(), key)
+			}
+		}
 		http.Error(w, "Greška servera pri čuvanju fajla.", http.StatusInternalServerError)
 		return
 	}
 
-	imageURL := fmt.Sprintf("/uploads/%s", newFileName)
+	imageURL := variants["original"]
 	log.Printf("Slika uspešno uploadovana: %s", imageURL)
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	w.Write([]byte(fmt.Sprintf(`{"imageUrl": "%s"}`, imageURL)))
+	json.NewEncoder(w).Encode(map[string]any{
+		"imageUrl": imageURL,
+		"variants": variants,
+	})
+}
+
+// uploadOwnerID vraca ID korisnika iz tokena HTTP zahteva. To je isti userId
+// koji gateway prosledjuje u gRPC metadata, pa se poklapa sa vlasnikom koji
+// uploads.Attach ocekuje pri kreiranju i izmeni posta.
+func uploadOwnerID(r *http.Request) (string, error) {
+	claims, err := utils.GetClaimsFromRequest(r)
+	if err != nil {
+		return "", err
+	}
+	ownerID, _ := claims["userId"].(string)
+	if ownerID == "" {
+		return "", errors.New("userId claim missing")
+	}
+	return ownerID, nil
 }
 
 func (s *BlogServer) GetPosts(ctx context.Context, req *blogproto.GetPostsRequest) (*blogproto.GetPostsResponse, error) {
@@ -129,25 +221,23 @@ func (s *BlogServer) GetPosts(ctx context.Context, req *blogproto.GetPostsReques
 		return nil, status.Errorf(codes.Unauthenticated, "Nevalidan token: %v", err)
 	}
 
-	followerResp, err := followerClient.GetFollowing(ctx, &followerproto.GetFollowingRequest{Username: currentUsername})
+	following, _ := feedAuthors(ctx, currentUsername)
+	hidden, _, err := hiddenAuthors(ctx, currentUsername)
 	if err != nil {
-		log.Printf("Greška pri dohvatanju pracenih korisnika: %v", err)
-		return nil, status.Errorf(codes.Internal, "Greška pri dohvatanju pracenih korisnika.")
+		return nil, err
 	}
 
-	following := append(followerResp.Following, currentUsername)
-
 	var posts []models.Post
-	result := database.GORM_DB.Where("username IN ?", following).Order("created_at DESC").Find(&posts)
+	result := database.GORM_DB.Where("username IN ? AND status = ?", following, models.PostPublished).
+		Scopes(visibleTo(currentUsername, following), excludeAuthors(hidden)).
+		Order("created_at DESC").
+		Find(&posts)
 	if result.Error != nil {
 		log.Printf("Greška pri dohvatanju postova iz baze: %v", result.Error)
 		return nil, status.Errorf(codes.Internal, "Greška servera pri dohvatanju postova.")
 	}
 
-	protoPosts := make([]*blogproto.Post, len(posts))
-	for i, post := range posts {
-		protoPosts[i] = convertPostToProto(&post)
-	}
+	protoPosts := postsToProto(ctx, posts)
 
 	fmt.Printf("Dohvaćeno %d postova.\n", len(protoPosts))
 	return &blogproto.GetPostsResponse{Posts: protoPosts}, nil
@@ -169,72 +259,142 @@ func (s *BlogServer) GetPostByID(ctx context.Context, req *blogproto.GetPostByID
 		return nil, status.Errorf(codes.Internal, "Greška servera pri dohvatanju posta.")
 	}
 
-	protoPost := convertPostToProto(&post)
+	if err := checkPostAccess(ctx, &post); err != nil {
+		return nil, err
+	}
+
+	protoPost := postToProto(ctx, &post)
 	fmt.Printf("Dohvaćen post sa ID: %s.\n", postID.String())
 	return protoPost, nil
 }
 
+// ToggleLike dodaje ili uklanja lajk trenutnog korisnika. Red posta se
+// zakljucava tokom transakcije, a likes_count se racuna iz tabele likes, pa
+// istovremeni zahtevi ne mogu da razdese brojac.
 func (s *BlogServer) ToggleLike(ctx context.Context, req *blogproto.ToggleLikeRequest) (*blogproto.ToggleLikeResponse, error) {
-	fmt.Printf("ToggleLike - Primljen zahtev. PostID: %s, UserID: %s\n", req.GetPostId(), req.GetUserId())
+	currentUsername, userID, _, err := GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "Nevalidan token: %v", err)
+	}
 
 	postID, err := uuid.Parse(req.GetPostId())
 	if err != nil {
-		fmt.Printf("ToggleLike - Greška pri parsiranju PostID-a: %v\n", err)
 		return nil, status.Errorf(codes.InvalidArgument, "Neispravan format ID-a posta.")
 	}
-	fmt.Printf("ToggleLike - PostID uspešno parsiran: %s\n", postID.String())
-
-	userID := req.GetUserId()
-	fmt.Printf("ToggleLike - UserID je string: %s\n", userID)
+	if _, err := loadVisiblePost(ctx, postID); err != nil {
+		return nil, err
+	}
 
-	var existingLike models.Like
-	result := database.GORM_DB.Where("post_id = ? AND user_id = ?", postID, userID).First(&existingLike)
+	var likesCount int64
+	var liked bool
+	err = database.GORM_DB.Transaction(func(tx *gorm.DB) error {
+		var post models.Post
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id", "status", "user_id", "username", "title").First(&post, "id = ?", postID).Error; err != nil {
+			return err
+		}
+		if post.Status != models.PostPublished {
+			return errPostNotPublished
+		}
 
-	var likesCount int32
-	var responseStatus string
+		result := tx.Where("post_id = ? AND user_id = ?", postID, userID).Delete(&models.Like{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			like := models.Like{PostID: postID, UserID: userID, Username: currentUsername}
+			result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&like)
+			if result.Error != nil {
+				return result.Error
+			}
+			liked = true
+			if result.RowsAffected > 0 {
+				if err := addLikeEvent(tx, &post, &like); err != nil {
+					return err
+				}
+			}
+		}
 
-	switch result.Error {
-	case nil:
-		deleteResult := database.GORM_DB.Delete(&existingLike)
-		if deleteResult.Error != nil {
-			log.Printf("Greška pri brisanju lajka: %v", deleteResult.Error)
-			return nil, status.Errorf(codes.Internal, "Greška servera pri uklanjanju lajka.")
+		if err := tx.Model(&models.Like{}).Where("post_id = ?", postID).Count(&likesCount).Error; err != nil {
+			return err
 		}
-		database.GORM_DB.Model(&models.Post{}).Where("id = ?", postID).Update("likes_count", gorm.Expr("likes_count - ?", 1))
-
-		var updatedPost models.Post
-		database.GORM_DB.Select("likes_count").First(&updatedPost, postID)
-		likesCount = int32(updatedPost.LikesCount)
-		responseStatus = "Lajk uklonjen"
-	case gorm.ErrRecordNotFound:
-		newLike := models.Like{
-			PostID: postID,
-			UserID: userID,
+		return tx.Model(&models.Post{}).Where("id = ?", postID).Update("likes_count", likesCount).Error
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "Post nije pronađen.")
 		}
-		createResult := database.GORM_DB.Create(&newLike)
-		if createResult.Error != nil {
-			log.Printf("Greška pri dodavanju lajka: %v", createResult.Error)
-			return nil, status.Errorf(codes.Internal, "Greška servera pri dodavanju lajka.")
+		if errors.Is(err, errPostNotPublished) {
+			return nil, status.Errorf(codes.FailedPrecondition, "Post još nije objavljen.")
 		}
-		database.GORM_DB.Model(&models.Post{}).Where("id = ?", postID).Update("likes_count", gorm.Expr("likes_count + ?", 1))
+		log.Printf("Greška pri obradi lajka za post %s: %v", postID, err)
+		return nil, status.Errorf(codes.Internal, "Greška servera pri obradi lajka.")
+	}
 
-		var updatedPost models.Post
-		database.GORM_DB.Select("likes_count").First(&updatedPost, postID)
-		likesCount = int32(updatedPost.LikesCount)
+	responseStatus := "Lajk uklonjen"
+	if liked {
 		responseStatus = "Lajk dodat"
-	default:
-		log.Printf("Greška pri proveri postojećeg lajka: %v", result.Error)
-		return nil, status.Errorf(codes.Internal, "Greška servera pri obradi lajka.")
 	}
 
 	fmt.Printf("Status lajka: %s, lajkova: %d\n", responseStatus, likesCount)
-	return &blogproto.ToggleLikeResponse{Status: responseStatus, LikesCount: likesCount}, nil
+	return &blogproto.ToggleLikeResponse{Status: responseStatus, LikesCount: int32(likesCount), Liked: liked}, nil
+}
+
+func (s *BlogServer) GetLikesForPost(ctx context.Context, req *blogproto.GetLikesForPostRequest) (*blogproto.GetLikesForPostResponse, error) {
+	postID, err := uuid.Parse(req.GetPostId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Neispravan format ID-a posta.")
+	}
+
+	post, err := loadVisiblePost(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	query := database.GORM_DB.Where("post_id = ?", postID)
+	if req.GetCursor() != "" {
+		c, err := decodeCursor(req.GetCursor())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Neispravan kursor.")
+		}
+		query = query.Where("(created_at, id) > (?, ?)", c.CreatedAt, c.ID)
+	}
+
+	limit := pageLimit(req.GetLimit())
+	var likes []models.Like
+	if err := query.Order("created_at asc, id asc").Limit(limit + 1).Find(&likes).Error; err != nil {
+		log.Printf("Greška pri dohvatanju lajkova za post %s: %v", postID, err)
+		return nil, status.Errorf(codes.Internal, "Greška servera pri dohvatanju lajkova.")
+	}
+
+	var nextCursor string
+	if len(likes) > limit {
+		likes = likes[:limit]
+		last := likes[limit-1]
+		nextCursor = encodeCursor(cursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+
+	protoLikes := make([]*blogproto.Like, len(likes))
+	for i, like := range likes {
+		protoLikes[i] = &blogproto.Like{
+			Id:        like.ID.String(),
+			PostId:    like.PostID.String(),
+			UserId:    like.UserID,
+			Username:  like.Username,
+			CreatedAt: like.CreatedAt.Format(time.RFC3339),
+		}
+	}
+
+	return &blogproto.GetLikesForPostResponse{
+		Likes:      protoLikes,
+		NextCursor: nextCursor,
+		LikesCount: int32(post.LikesCount),
+	}, nil
 }
 
 func (s *BlogServer) AddCommentToPost(ctx context.Context, req *blogproto.AddCommentToPostRequest) (*blogproto.Comment, error) {
 	fmt.Printf("AddCommentToPost - Primljen zahtev. PostID: %s, UserID: %s\n", req.GetPostId(), req.GetUserId())
 
-	currentUsername, _, _, err := GetClaimsFromContext(ctx)
+	currentUsername, currentUserId, _, err := GetClaimsFromContext(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "Nevalidan token: %v", err)
 	}
@@ -246,9 +406,12 @@ func (s *BlogServer) AddCommentToPost(ctx context.Context, req *blogproto.AddCom
 	}
 	fmt.Printf("AddCommentToPost - PostID uspešno parsiran: %s\n", postID.String())
 
-	var post models.Post
-	if err := database.GORM_DB.First(&post, "id = ?", postID).Error; err != nil {
-		return nil, status.Errorf(codes.NotFound, "Post nije pronađen.")
+	post, err := loadVisiblePost(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+	if post.Status != models.PostPublished {
+		return nil, status.Errorf(codes.FailedPrecondition, "Post još nije objavljen.")
 	}
 
 	followerResp, err := followerClient.GetFollowing(ctx, &followerproto.GetFollowingRequest{Username: currentUsername})
@@ -270,26 +433,85 @@ func (s *BlogServer) AddCommentToPost(ctx context.Context, req *blogproto.AddCom
 	if !isAllowed {
 		return nil, status.Errorf(codes.PermissionDenied, "Ne možete komentarisati postove korisnika koje ne pratite.")
 	}
+	if err := checkCommentAllowed(ctx, post.Username, currentUsername); err != nil {
+		return nil, err
+	}
 
-	parsedUserID := req.GetUserId()
+	// Autor komentara se uzima iz tokena da bi provera autorstva pri izmeni
+	// i brisanju imala smisla
+	parsedUserID := currentUserId
 	fmt.Printf("AddCommentToPost - UserID je string: %s\n", parsedUserID)
 
+	var parent *models.Comment
+	if req.GetParentId() != "" {
+		parentID, err := uuid.Parse(req.GetParentId())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Neispravan format ID-a komentara.")
+		}
+		parent = &models.Comment{}
+		if err := database.GORM_DB.First(parent, "id = ? AND post_id = ?", parentID, postID).Error; err != nil {
+			return nil, status.Errorf(codes.NotFound, "Komentar na koji odgovarate nije pronađen.")
+		}
+		if parent.Deleted {
+			return nil, status.Errorf(codes.FailedPrecondition, "Ne možete odgovoriti na obrisan komentar.")
+		}
+		if parent.Depth >= models.MaxCommentDepth {
+			return nil, status.Errorf(codes.FailedPrecondition, "Dostignuta je maksimalna dubina odgovora.")
+		}
+	}
+
+	if req.GetText() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Tekst komentara je obavezan.")
+	}
+
+	rendered, err := markdown.Render(req.GetText())
+	if err != nil {
+		log.Printf("Greška pri renderovanju komentara: %v", err)
+		return nil, status.Errorf(codes.Internal, "Greška servera pri kreiranju komentara.")
+	}
+
 	newComment := models.Comment{
 		PostID:    postID,
 		UserID:    parsedUserID,
 		Username:  currentUsername,
 		Text:      req.GetText(),
+		TextHTML:  rendered.HTML,
+		Mentions:  rendered.Mentions,
+		Hashtags:  rendered.Hashtags,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
-
-	if newComment.Text == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "Tekst komentara je obavezan.")
+	if parent != nil {
+		newComment.ParentID = &parent.ID
+		newComment.Depth = parent.Depth + 1
 	}
 
-	result := database.GORM_DB.Create(&newComment)
-	if result.Error != nil {
-		log.Printf("Greška pri čuvanju komentara u bazu: %v", result.Error)
+	err = database.GORM_DB.Transaction(func(tx *gorm.DB) error {
+		if parent != nil {
+			// Roditelj se zakljucava da ga paralelno brisanje ne bi uklonilo
+			var locked models.Comment
+			if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&locked, "id = ?", parent.ID).Error; err != nil {
+				return err
+			}
+			if locked.Deleted {
+				return errParentDeleted
+			}
+		}
+		if err := tx.Create(&newComment).Error; err != nil {
+			return err
+		}
+		if parent != nil {
+			if err := tx.Model(&models.Comment{}).Where("id = ?", parent.ID).Update("reply_count", gorm.Expr("reply_count + 1")).Error; err != nil {
+				return err
+			}
+		}
+		return addCommentEvent(tx, &newComment, post, parent)
+	})
+	if errors.Is(err, errParentDeleted) || errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.FailedPrecondition, "Ne možete odgovoriti na obrisan komentar.")
+	}
+	if err != nil {
+		log.Printf("Greška pri čuvanju komentara u bazu: %v", err)
 		return nil, status.Errorf(codes.Internal, "Greška servera pri kreiranju komentara.")
 	}
 
@@ -308,45 +530,117 @@ func (s *BlogServer) GetCommentsForPost(ctx context.Context, req *blogproto.GetC
 	}
 	fmt.Printf("GetCommentsForPost - PostID uspešno parsiran: %s\n", postID.String())
 
+	if _, err := loadVisiblePost(ctx, postID); err != nil {
+		return nil, err
+	}
+
+	query := database.GORM_DB.Where("post_id = ?", postID)
+	if req.GetParentId() != "" {
+		parentID, err := uuid.Parse(req.GetParentId())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Neispravan format ID-a komentara.")
+		}
+		query = query.Where("parent_id = ?", parentID)
+	} else {
+		query = query.Where("parent_id IS NULL")
+	}
+
+	if req.GetCursor() != "" {
+		c, err := decodeCursor(req.GetCursor())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Neispravan kursor.")
+		}
+		query = query.Where("(created_at, id) > (?, ?)", c.CreatedAt, c.ID)
+	}
+
+	limit := pageLimit(req.GetLimit())
 	var comments []models.Comment
-	result := database.GORM_DB.Where("post_id = ?", postID).Order("created_at asc").Find(&comments)
+	result := query.Order("created_at asc, id asc").Limit(limit + 1).Find(&comments)
 	if result.Error != nil {
 		log.Printf("Greška pri dohvatanju komentara za post %s: %v", postID.String(), result.Error)
 		return nil, status.Errorf(codes.Internal, "Greška servera pri dohvatanju komentara.")
 	}
 
+	var nextCursor string
+	if len(comments) > limit {
+		comments = comments[:limit]
+		last := comments[limit-1]
+		nextCursor = encodeCursor(cursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+
 	protoComments := make([]*blogproto.Comment, len(comments))
 	for i, comment := range comments {
 		protoComments[i] = convertCommentToProto(&comment)
 	}
 
 	fmt.Printf("Dohvaćeno %d komentara za post %s.\n", len(protoComments), postID.String())
-	return &blogproto.GetCommentsForPostResponse{Comments: protoComments}, nil
+	return &blogproto.GetCommentsForPostResponse{Comments: protoComments, NextCursor: nextCursor}, nil
+}
+
+// loadVisiblePost ucitava post i proverava da ga trenutni korisnik sme videti.
+func loadVisiblePost(ctx context.Context, postID uuid.UUID) (*models.Post, error) {
+	var post models.Post
+	if err := database.GORM_DB.First(&post, "id = ?", postID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "Post nije pronađen.")
+		}
+		log.Printf("Greška pri dohvatanju posta po ID-ju: %v", err)
+		return nil, status.Errorf(codes.Internal, "Greška servera pri dohvatanju posta.")
+	}
+	if err := checkPostAccess(ctx, &post); err != nil {
+		return nil, err
+	}
+	return &post, nil
 }
 
 func convertPostToProto(post *models.Post) *blogproto.Post {
 	return &blogproto.Post{
-		Id:          post.ID.String(),
-		UserId:      post.UserID,
-		Username:    post.Username,
-		Title:       post.Title,
-		Description: post.Description,
-		CreatedAt:   post.CreatedAt.Format(time.RFC3339),
-		ImageUrls:   post.ImageURLs,
-		LikesCount:  int32(post.LikesCount),
+		Id:              post.ID.String(),
+		UserId:          post.UserID,
+		Username:        post.Username,
+		Title:           post.Title,
+		Description:     post.Description,
+		CreatedAt:       post.CreatedAt.Format(time.RFC3339),
+		ImageUrls:       post.ImageURLs,
+		LikesCount:      int32(post.LikesCount),
+		Status:          string(post.Status),
+		UpdatedAt:       formatOptionalTime(post.UpdatedAt),
+		PublishedAt:     formatOptionalTime(post.PublishedAt),
+		DescriptionHtml: post.DescriptionHTML,
+		Mentions:        post.Mentions,
+		Hashtags:        post.Hashtags,
+		TourIds:         post.TourIDs,
+		Visibility:      string(post.Visibility),
 	}
 }
 
-func convertCommentToProto(comment *models.Comment) *blogproto.Comment {
-	return &blogproto.Comment{
-		Id:        comment.ID.String(),
-		PostId:    comment.PostID.String(),
-		UserId:    comment.UserID,
-		Username:  comment.Username,
-		Text:      comment.Text,
-		CreatedAt: comment.CreatedAt.Format(time.RFC3339),
-		UpdatedAt: comment.UpdatedAt.Format(time.RFC3339),
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
 	}
+	return t.Format(time.RFC3339)
+}
+
+func convertCommentToProto(comment *models.Comment) *blogproto.Comment {
+	protoComment := &blogproto.Comment{
+		Id:         comment.ID.String(),
+		PostId:     comment.PostID.String(),
+		UserId:     comment.UserID,
+		Username:   comment.Username,
+		Text:       comment.Text,
+		CreatedAt:  comment.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:  comment.UpdatedAt.Format(time.RFC3339),
+		TextHtml:   comment.TextHTML,
+		Mentions:   comment.Mentions,
+		Hashtags:   comment.Hashtags,
+		Depth:      int32(comment.Depth),
+		ReplyCount: int32(comment.ReplyCount),
+		Deleted:    comment.Deleted,
+	}
+	if comment.ParentID != nil {
+		protoComment.ParentId = comment.ParentID.String()
+	}
+	return protoComment
 }
 
 func GetClaimsFromContext(ctx context.Context) (string, string, string, error) {
@@ -0,0 +1,246 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log"
+	"slices"
+	"time"
+
+	"shared/uploads"
+	"soa/blog-service/database"
+	"soa/blog-service/markdown"
+	"soa/blog-service/models"
+	blogproto "soa/blog-service/proto/blog"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func (s *BlogServer) UpdatePost(ctx context.Context, req *blogproto.UpdatePostRequest) (*blogproto.Post, error) {
+	post, err := loadOwnPost(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetTitle() == "" || req.GetDescription() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Naslov i opis su obavezni.")
+	}
+
+	visibility, err := parseVisibility(req.GetVisibility(), post.Visibility)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ture se proveravaju u tours-service samo kada se spisak menja, pa izmena
+	// ostalih polja radi i dok tours-service nije dostupan
+	tourIDs := []string(post.TourIDs)
+	if !slices.Equal(post.TourIDs, req.GetTourIds()) {
+		if tourIDs, err = validateTourIDs(ctx, req.GetTourIds()); err != nil {
+			return nil, err
+		}
+	}
+
+	imageURLs := req.GetImageUrls()
+	if post.Title == req.GetTitle() && post.Description == req.GetDescription() && slices.Equal(post.ImageURLs, imageURLs) {
+		// Vidljivost i ture ne menjaju sadrzaj, pa se za njih ne cuva revizija
+		if visibility != post.Visibility || !slices.Equal(post.TourIDs, tourIDs) {
+			err := database.GORM_DB.Model(post).Updates(map[string]any{
+				"visibility": visibility,
+				"tour_ids":   pq.StringArray(tourIDs),
+			}).Error
+			if err != nil {
+				log.Printf("Greška pri izmeni posta %s: %v", post.ID, err)
+				return nil, status.Errorf(codes.Internal, "Greška servera pri izmeni posta.")
+			}
+			post.Visibility = visibility
+			post.TourIDs = tourIDs
+		}
+		return postToProto(ctx, post), nil
+	}
+
+	rendered, err := markdown.Render(req.GetDescription())
+	if err != nil {
+		log.Printf("Greška pri renderovanju opisa posta %s: %v", post.ID, err)
+		return nil, status.Errorf(codes.Internal, "Greška servera pri izmeni posta.")
+	}
+
+	err = database.GORM_DB.Transaction(func(tx *gorm.DB) error {
+		revision := models.PostRevision{
+			PostID:      post.ID,
+			Title:       post.Title,
+			Description: post.Description,
+			ImageURLs:   post.ImageURLs,
+			EditedAt:    time.Now(),
+		}
+		if err := tx.Create(&revision).Error; err != nil {
+			return err
+		}
+
+		for _, imageURL := range post.ImageURLs {
+			if !slices.Contains(imageURLs, imageURL) {
+				if err := uploads.Release(tx, imageURL); err != nil {
+					return err
+				}
+			}
+		}
+		for _, imageURL := range imageURLs {
+			if err := uploads.Attach(tx, imageURL, models.UploadRefPost, post.ID.String(), post.UserID); err != nil {
+				return err
+			}
+		}
+
+		now := time.Now()
+		post.Title = req.GetTitle()
+		post.Description = req.GetDescription()
+		post.DescriptionHTML = rendered.HTML
+		post.Mentions = rendered.Mentions
+		post.Hashtags = rendered.Hashtags
+		post.ImageURLs = imageURLs
+		post.Visibility = visibility
+		post.TourIDs = tourIDs
+		post.UpdatedAt = &now
+		return tx.Save(post).Error
+	})
+	if errors.Is(err, uploads.ErrNotOwner) {
+		return nil, status.Errorf(codes.PermissionDenied, "Slika ne pripada korisniku ili je već iskorišćena u drugom postu.")
+	}
+	if err != nil {
+		log.Printf("Greška pri izmeni posta %s: %v", post.ID, err)
+		return nil, status.Errorf(codes.Internal, "Greška servera pri izmeni posta.")
+	}
+
+	return postToProto(ctx, post), nil
+}
+
+func (s *BlogServer) DeletePost(ctx context.Context, req *blogproto.DeletePostRequest) (*blogproto.DeletePostResponse, error) {
+	post, err := loadOwnPost(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = database.GORM_DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(post).Error; err != nil {
+			return err
+		}
+		if err := removeFromTimelines(tx, post.ID); err != nil {
+			return err
+		}
+		for _, imageURL := range post.ImageURLs {
+			if err := uploads.Release(tx, imageURL); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("Greška pri brisanju posta %s: %v", post.ID, err)
+		return nil, status.Errorf(codes.Internal, "Greška servera pri brisanju posta.")
+	}
+
+	return &blogproto.DeletePostResponse{Status: "Post obrisan"}, nil
+}
+
+// PublishPost objavljuje draft. Ponovno objavljivanje vec objavljenog posta
+// nema efekta.
+func (s *BlogServer) PublishPost(ctx context.Context, req *blogproto.PublishPostRequest) (*blogproto.Post, error) {
+	post, err := loadOwnPost(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if post.Status == models.PostPublished {
+		return postToProto(ctx, post), nil
+	}
+
+	now := time.Now()
+	post.Status = models.PostPublished
+	post.PublishedAt = &now
+	err = database.GORM_DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(post).Updates(map[string]interface{}{"status": post.Status, "published_at": now}).Error; err != nil {
+			return err
+		}
+		return addPostEvent(tx, SubjectPostPublished, post)
+	})
+	if err != nil {
+		log.Printf("Greška pri objavljivanju posta %s: %v", post.ID, err)
+		return nil, status.Errorf(codes.Internal, "Greška servera pri objavljivanju posta.")
+	}
+	go fanOutPost(*post)
+
+	return postToProto(ctx, post), nil
+}
+
+func (s *BlogServer) GetPostHistory(ctx context.Context, req *blogproto.GetPostHistoryRequest) (*blogproto.GetPostHistoryResponse, error) {
+	post, err := loadOwnPost(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	var revisions []models.PostRevision
+	if err := database.GORM_DB.Where("post_id = ?", post.ID).Order("edited_at DESC").Find(&revisions).Error; err != nil {
+		log.Printf("Greška pri dohvatanju istorije posta %s: %v", post.ID, err)
+		return nil, status.Errorf(codes.Internal, "Greška servera pri dohvatanju istorije posta.")
+	}
+
+	protoRevisions := make([]*blogproto.PostRevision, len(revisions))
+	for i, revision := range revisions {
+		protoRevisions[i] = &blogproto.PostRevision{
+			Id:          revision.ID.String(),
+			PostId:      revision.PostID.String(),
+			Title:       revision.Title,
+			Description: revision.Description,
+			ImageUrls:   revision.ImageURLs,
+			EditedAt:    revision.EditedAt.Format(time.RFC3339),
+		}
+	}
+
+	return &blogproto.GetPostHistoryResponse{Revisions: protoRevisions}, nil
+}
+
+func (s *BlogServer) GetMyDrafts(ctx context.Context, req *blogproto.GetMyDraftsRequest) (*blogproto.GetPostsResponse, error) {
+	_, userId, _, err := GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "Nevalidan token: %v", err)
+	}
+
+	var posts []models.Post
+	result := database.GORM_DB.Where("user_id = ? AND status = ?", userId, models.PostDraft).Order("created_at DESC").Find(&posts)
+	if result.Error != nil {
+		log.Printf("Greška pri dohvatanju draftova: %v", result.Error)
+		return nil, status.Errorf(codes.Internal, "Greška servera pri dohvatanju draftova.")
+	}
+
+	return &blogproto.GetPostsResponse{Posts: postsToProto(ctx, posts)}, nil
+}
+
+// loadOwnPost ucitava post i proverava da je trenutni korisnik njegov autor.
+func loadOwnPost(ctx context.Context, id string) (*models.Post, error) {
+	_, userId, _, err := GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "Nevalidan token: %v", err)
+	}
+
+	postID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Neispravan format ID-a posta.")
+	}
+
+	var post models.Post
+	if err := database.GORM_DB.First(&post, "id = ?", postID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "Post nije pronađen.")
+		}
+		log.Printf("Greška pri dohvatanju posta po ID-ju: %v", err)
+		return nil, status.Errorf(codes.Internal, "Greška servera pri dohvatanju posta.")
+	}
+
+	if post.UserID != userId {
+		return nil, status.Errorf(codes.PermissionDenied, "Samo autor može menjati ovaj post.")
+	}
+
+	return &post, nil
+}
@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"slices"
+
+	followerproto "soa/blog-service/proto/follower"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// restrictionsCache pamti poslednje uspesno dohvacene blokade i utisavanja
+// svakog korisnika, kao followingCache za pracene korisnike.
+var restrictionsCache = newLRUCache[string, *followerproto.GetRestrictionsResponse](followingCacheSize, followingCacheMaxAge)
+
+// getRestrictions vraca blokade i utisavanja korisnika. Ako follower-service
+// ne odgovori, vraca se poslednji poznati odgovor i degraded je true; greska
+// se vraca samo kada ni njega nema.
+func getRestrictions(ctx context.Context, username string) (*followerproto.GetRestrictionsResponse, bool, error) {
+	callCtx, cancel := context.WithTimeout(ctx, followerTimeout)
+	defer cancel()
+
+	r, err := followerClient.GetRestrictions(callCtx, &followerproto.GetRestrictionsRequest{Username: username})
+	if err == nil {
+		restrictionsCache.Set(username, r)
+		return r, false, nil
+	}
+	if cached, _, ok := restrictionsCache.Get(username); ok {
+		log.Printf("Follower-service nije dostupan, koriste se poslednje poznate blokade za %s: %v", username, err)
+		return cached, true, nil
+	}
+	return nil, false, err
+}
+
+// isBlockedWith proverava da li je korisnik blokirao other ili obrnuto.
+func isBlockedWith(r *followerproto.GetRestrictionsResponse, other string) bool {
+	return slices.Contains(r.GetBlocked(), other) || slices.Contains(r.GetBlockedBy(), other)
+}
+
+// hiddenAuthors vraca autore cije postove korisnik ne treba da vidi u feed-u:
+// blokirane u oba smera i utisane. Dok follower-service nije dostupan koristi
+// se poslednji poznati spisak (degraded je true), a ako ga nema, vraca se
+// Unavailable, jer bi postovi blokiranih korisnika inace bili prikazani.
+func hiddenAuthors(ctx context.Context, username string) ([]string, bool, error) {
+	r, degraded, err := getRestrictions(ctx, username)
+	if err != nil {
+		log.Printf("Greška pri dohvatanju blokiranih i utišanih korisnika za %s: %v", username, err)
+		return nil, false, status.Errorf(codes.Unavailable, "Provera blokiranih korisnika trenutno nije moguća.")
+	}
+	return slices.Concat(r.GetBlocked(), r.GetBlockedBy(), r.GetMuted()), degraded, nil
+}
+
+func excludeAuthors(hidden []string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if len(hidden) == 0 {
+			return db
+		}
+		return db.Where("username NOT IN ?", hidden)
+	}
+}
+
+// checkCommentAllowed odbija komentar ako je autor posta blokirao ili utisao
+// komentatora, ili ako ga je komentator blokirao.
+func checkCommentAllowed(ctx context.Context, postAuthor, commenter string) error {
+	if postAuthor == commenter {
+		return nil
+	}
+	r, _, err := getRestrictions(ctx, postAuthor)
+	if err != nil {
+		log.Printf("Greška pri proveri blokiranja za %s: %v", postAuthor, err)
+		return status.Errorf(codes.Unavailable, "Provera dozvole za komentarisanje trenutno nije moguća.")
+	}
+	if isBlockedWith(r, commenter) || slices.Contains(r.GetMuted(), commenter) {
+		return status.Errorf(codes.PermissionDenied, "Ne možete komentarisati postove ovog korisnika.")
+	}
+	return nil
+}
@@ -0,0 +1,322 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"shared/events"
+	"soa/blog-service/database"
+	"soa/blog-service/models"
+	followerproto "soa/blog-service/proto/follower"
+
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	SubjectFollowed    = "follower_followed"
+	SubjectUnfollowed  = "follower_unfollowed"
+	SubjectUserRenamed = "follower_user_renamed"
+
+	defaultFanoutThreshold = 1000
+	fanoutBatchSize        = 500
+	fanoutTimeout          = 10 * time.Second
+	fanoutRetryInterval    = time.Minute
+	// Novi pratilac dobija u timeline ovoliko poslednjih postova autora
+	followBackfillPosts = 100
+)
+
+var fanoutThreshold = defaultFanoutThreshold
+
+// FollowEvent odgovara payload-u dogadjaja koji objavljuje follower-service.
+type FollowEvent struct {
+	Follower string `json:"follower"`
+	Followee string `json:"followee"`
+}
+
+// UserRenamedEvent objavljuje follower-service kada administrator promeni
+// korisnicko ime.
+type UserRenamedEvent struct {
+	OldUsername string `json:"oldUsername"`
+	NewUsername string `json:"newUsername"`
+}
+
+// InitTimeline cita FEED_FANOUT_THRESHOLD, pokrece ponovni fan-out postova
+// koji nisu uspeli da se rasporede i pretplacuje se na dogadjaje o pracenju i
+// promeni korisnickog imena.
+func InitTimeline(natsConn *nats.Conn) {
+	if value := os.Getenv("FEED_FANOUT_THRESHOLD"); value != "" {
+		if n, err := strconv.Atoi(value); err == nil && n > 0 {
+			fanoutThreshold = n
+		} else {
+			log.Printf("Neispravan FEED_FANOUT_THRESHOLD %q, koristi se %d", value, defaultFanoutThreshold)
+		}
+	}
+
+	go retryPendingFanouts()
+
+	// Replike dele trajni consumer, pa dogadjaj obradi samo jedna od njih, a
+	// neuspela obrada se ponavlja
+	events.Consume(context.Background(), natsConn, events.ConsumerConfig{
+		Durable: "blog-service",
+		Handlers: map[string]events.Handler{
+			SubjectFollowed:    handleFollowEvent(onFollowed),
+			SubjectUnfollowed:  handleFollowEvent(onUnfollowed),
+			SubjectUserRenamed: onUserRenamed,
+		},
+	})
+}
+
+func handleFollowEvent(handle func(FollowEvent) error) events.Handler {
+	return func(_ context.Context, envelope events.Envelope) error {
+		var event FollowEvent
+		if err := envelope.DecodePayload(&event); err != nil {
+			return err
+		}
+		return handle(event)
+	}
+}
+
+// onUserRenamed menja korisnicko ime autora i vlasnika timeline-a kada ga
+// administrator promeni u follower-service.
+func onUserRenamed(_ context.Context, envelope events.Envelope) error {
+	var event UserRenamedEvent
+	if err := envelope.DecodePayload(&event); err != nil {
+		return err
+	}
+	if event.OldUsername == "" || event.NewUsername == "" {
+		return events.Permanent(errors.New("missing old or new username"))
+	}
+
+	return database.GORM_DB.Transaction(func(tx *gorm.DB) error {
+		renames := []struct {
+			model  any
+			column string
+		}{
+			{&models.Post{}, "username"},
+			{&models.Comment{}, "username"},
+			{&models.Like{}, "username"},
+			{&models.TimelineEntry{}, "owner_username"},
+			{&models.TimelineEntry{}, "author_username"},
+			{&models.TimelineState{}, "username"},
+			{&models.HeavyAuthor{}, "username"},
+		}
+		for _, r := range renames {
+			err := tx.Model(r.model).Unscoped().Where(r.column+" = ?", event.OldUsername).
+				Update(r.column, event.NewUsername).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// onFollowed dopunjuje timeline novog pratioca poslednjim postovima autora.
+// Timeline koji jos nije izgradjen ce ih ionako dobiti pri prvom citanju.
+func onFollowed(event FollowEvent) error {
+	built, err := timelineBuilt(event.Follower)
+	if err != nil || !built {
+		return err
+	}
+	heavy, err := isHeavyAuthor(event.Followee)
+	if err != nil || heavy {
+		return err
+	}
+
+	var postIDs []uuid.UUID
+	err = database.GORM_DB.Model(&models.Post{}).
+		Where("username = ? AND status = ?", event.Followee, models.PostPublished).
+		Order("published_at DESC").
+		Limit(followBackfillPosts).
+		Pluck("id", &postIDs).Error
+	if err != nil || len(postIDs) == 0 {
+		return err
+	}
+
+	entries := make([]models.TimelineEntry, len(postIDs))
+	for i, id := range postIDs {
+		entries[i] = models.TimelineEntry{OwnerUsername: event.Follower, PostID: id, AuthorUsername: event.Followee}
+	}
+	return database.GORM_DB.Clauses(clause.OnConflict{DoNothing: true}).Create(&entries).Error
+}
+
+// onUnfollowed uklanja postove otpracenog autora iz timeline-a.
+func onUnfollowed(event FollowEvent) error {
+	return database.GORM_DB.
+		Where("owner_username = ? AND author_username = ?", event.Follower, event.Followee).
+		Delete(&models.TimelineEntry{}).Error
+}
+
+// fanOutPost upisuje objavljeni post u timeline autora i njegovih pratilaca.
+// Autor sa vise od fanoutThreshold pratilaca se oznacava kao HeavyAuthor i
+// njegovi postovi se citaju pri sastavljanju feed-a. Kada autor prestane da
+// bude HeavyAuthor, njegovi skorasnji postovi se upisuju pratiocima.
+func fanOutPost(post models.Post) {
+	ctx, cancel := context.WithTimeout(context.Background(), fanoutTimeout)
+	defer cancel()
+
+	resp, err := followerClient.GetFollowers(ctx, &followerproto.GetFollowersRequest{Username: post.Username})
+	if err != nil {
+		// Post ostaje bez fanned_out_at i ponovo se obradjuje u retryPendingFanouts
+		log.Printf("Fan-out posta %s nije uspeo, biće ponovljen: %v", post.ID, err)
+		return
+	}
+	followers := resp.GetFollowers()
+
+	err = database.GORM_DB.Transaction(func(tx *gorm.DB) error {
+		owners := []string{post.Username}
+		if len(followers) > fanoutThreshold {
+			heavy := models.HeavyAuthor{Username: post.Username, FollowerCount: len(followers), UpdatedAt: time.Now()}
+			if err := tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(&heavy).Error; err != nil {
+				return err
+			}
+		} else {
+			res := tx.Delete(&models.HeavyAuthor{}, "username = ?", post.Username)
+			if res.Error != nil {
+				return res.Error
+			}
+			if res.RowsAffected > 0 {
+				if err := backfillFormerHeavyAuthor(tx, post.Username, followers); err != nil {
+					return err
+				}
+			}
+			owners = append(owners, followers...)
+		}
+
+		entries := make([]models.TimelineEntry, len(owners))
+		for i, owner := range owners {
+			entries[i] = models.TimelineEntry{OwnerUsername: owner, PostID: post.ID, AuthorUsername: post.Username}
+		}
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(&entries, fanoutBatchSize).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.Post{}).Where("id = ?", post.ID).Update("fanned_out_at", time.Now()).Error
+	})
+	if err != nil {
+		log.Printf("Greška pri upisu posta %s u timeline: %v", post.ID, err)
+	}
+}
+
+// backfillFormerHeavyAuthor upisuje poslednje postove autora koji vise nije
+// HeavyAuthor u izgradjene timeline-ove njegovih pratilaca, kao onFollowed.
+// Ti postovi su se do tada citali pri sastavljanju feed-a, a nisu upisani u
+// timeline-ove.
+func backfillFormerHeavyAuthor(tx *gorm.DB, author string, followers []string) error {
+	for start := 0; start < len(followers); start += fanoutBatchSize {
+		batch := followers[start:min(start+fanoutBatchSize, len(followers))]
+		err := tx.Exec(`
+			INSERT INTO timeline_entries (owner_username, post_id, author_username, created_at)
+			SELECT s.username, p.id, p.username, now()
+			FROM timeline_states s
+			CROSS JOIN (
+				SELECT id, username FROM posts
+				WHERE username = ? AND status = ? AND deleted_at IS NULL
+				ORDER BY published_at DESC
+				LIMIT ?
+			) p
+			WHERE s.username IN ?
+			ON CONFLICT DO NOTHING`, author, models.PostPublished, followBackfillPosts, batch).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func retryPendingFanouts() {
+	ticker := time.NewTicker(fanoutRetryInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		var posts []models.Post
+		err := database.GORM_DB.
+			Where("status = ? AND fanned_out_at IS NULL AND published_at < ?", models.PostPublished, time.Now().Add(-fanoutRetryInterval)).
+			Order("published_at ASC").
+			Limit(100).
+			Find(&posts).Error
+		if err != nil {
+			log.Printf("Greška pri dohvatanju postova za fan-out: %v", err)
+			continue
+		}
+		for _, post := range posts {
+			fanOutPost(post)
+		}
+	}
+}
+
+func removeFromTimelines(tx *gorm.DB, postID uuid.UUID) error {
+	return tx.Where("post_id = ?", postID).Delete(&models.TimelineEntry{}).Error
+}
+
+func timelineBuilt(username string) (bool, error) {
+	var count int64
+	err := database.GORM_DB.Model(&models.TimelineState{}).Where("username = ?", username).Count(&count).Error
+	return count > 0, err
+}
+
+func isHeavyAuthor(username string) (bool, error) {
+	var count int64
+	err := database.GORM_DB.Model(&models.HeavyAuthor{}).Where("username = ?", username).Count(&count).Error
+	return count > 0, err
+}
+
+// ensureTimeline gradi timeline korisnika pri prvom citanju feed-a, od svih
+// objavljenih postova autora koje prati (authors ukljucuje i njega samog).
+func ensureTimeline(username string, authors []string) error {
+	built, err := timelineBuilt(username)
+	if err != nil || built {
+		return err
+	}
+
+	return database.GORM_DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec(`
+			INSERT INTO timeline_entries (owner_username, post_id, author_username, created_at)
+			SELECT ?, p.id, p.username, now() FROM posts p
+			WHERE p.username IN ? AND p.status = ? AND p.deleted_at IS NULL
+				AND p.username NOT IN (SELECT username FROM heavy_authors)
+			ON CONFLICT DO NOTHING`, username, authors, models.PostPublished).Error
+		if err != nil {
+			return err
+		}
+		state := models.TimelineState{Username: username, BuiltAt: time.Now()}
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&state).Error
+	})
+}
+
+// feedScope ogranicava postove na one iz timeline-a korisnika i postove
+// HeavyAuthor autora koje prati, bez tudjih privatnih postova i bez postova
+// blokiranih i utisanih autora (hidden). Dok timeline nije izgradjen (npr. kada
+// follower-service nije dostupan), feed se cita direktno po autorima.
+func feedScope(username string, authors, hidden []string) (func(*gorm.DB) *gorm.DB, error) {
+	built, err := timelineBuilt(username)
+	if err != nil {
+		return nil, err
+	}
+	var heavy []string
+	if built {
+		err := database.GORM_DB.Model(&models.HeavyAuthor{}).Where("username IN ?", authors).Pluck("username", &heavy).Error
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return func(db *gorm.DB) *gorm.DB {
+		db = visibleTo(username, authors)(db.Where("status = ?", models.PostPublished))
+		db = excludeAuthors(hidden)(db)
+		if !built {
+			return db.Where("username IN ?", authors)
+		}
+
+		timeline := database.GORM_DB.Model(&models.TimelineEntry{}).Select("post_id").Where("owner_username = ?", username)
+		if len(heavy) == 0 {
+			return db.Where("id IN (?)", timeline)
+		}
+		return db.Where("(id IN (?) OR username IN ?)", timeline, heavy)
+	}, nil
+}
@@ -0,0 +1,185 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"slices"
+	"time"
+
+	"soa/blog-service/database"
+	"soa/blog-service/models"
+	blogproto "soa/blog-service/proto/blog"
+	"soa/blog-service/rest_clients"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxTourRefs  = 10
+	toursTimeout = 2 * time.Second
+	// Ocena ture se menja sporo, pa je sazetak dovoljno osvezavati povremeno
+	tourSummaryTTL = 5 * time.Minute
+	// Zastareli sazetak se koristi dok tours-service ne odgovara, ali najduze
+	// tourCacheMaxAge
+	tourCacheSize   = 5000
+	tourCacheMaxAge = 24 * time.Hour
+)
+
+var toursClient *rest_clients.ToursClient
+
+func InitToursClient(c *rest_clients.ToursClient) {
+	toursClient = c
+}
+
+// tourCache cuva sazetke tura da svaki prikaz postova ne bi zvao
+// tours-service. Nil sazetak znaci da tura nije dostupna.
+var tourCache = newLRUCache[string, *rest_clients.TourSummary](tourCacheSize, tourCacheMaxAge)
+
+func cacheTours(ids []string, summaries []rest_clients.TourSummary) {
+	for _, id := range ids {
+		if !slices.ContainsFunc(summaries, func(t rest_clients.TourSummary) bool { return t.ID == id }) {
+			tourCache.Set(id, nil)
+		}
+	}
+	for i := range summaries {
+		tourCache.Set(summaries[i].ID, &summaries[i])
+	}
+}
+
+// validateTourIDs proverava u tours-service da ture postoje i da su
+// objavljene, i vraca ID-jeve u kanonskom obliku bez duplikata.
+func validateTourIDs(ctx context.Context, rawIDs []string) ([]string, error) {
+	ids := make([]string, 0, len(rawIDs))
+	for _, raw := range rawIDs {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Neispravan format ID-a ture: %s.", raw)
+		}
+		if !slices.Contains(ids, id.String()) {
+			ids = append(ids, id.String())
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	if len(ids) > maxTourRefs {
+		return nil, status.Errorf(codes.InvalidArgument, "Post može da se odnosi na najviše %d tura.", maxTourRefs)
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, toursTimeout)
+	defer cancel()
+	summaries, err := toursClient.GetTourSummaries(callCtx, ids)
+	if err != nil {
+		log.Printf("Greška pri proveri tura %v: %v", ids, err)
+		return nil, status.Errorf(codes.Unavailable, "Servis za ture trenutno nije dostupan, pokušajte ponovo.")
+	}
+	cacheTours(ids, summaries)
+
+	for _, id := range ids {
+		if !slices.ContainsFunc(summaries, func(t rest_clients.TourSummary) bool { return t.ID == id }) {
+			return nil, status.Errorf(codes.InvalidArgument, "Tura %s ne postoji ili nije objavljena.", id)
+		}
+	}
+	return ids, nil
+}
+
+// attachTourSummaries dodaje sazetke tura u postove jednim pozivom ka
+// tours-service za sve ture koje nisu u kesu.
+func attachTourSummaries(ctx context.Context, posts []*blogproto.Post) {
+	var missing []string
+	for _, post := range posts {
+		for _, id := range post.TourIds {
+			_, fetchedAt, ok := tourCache.Get(id)
+			if (!ok || time.Since(fetchedAt) > tourSummaryTTL) && !slices.Contains(missing, id) {
+				missing = append(missing, id)
+			}
+		}
+	}
+
+	if len(missing) > 0 {
+		callCtx, cancel := context.WithTimeout(ctx, toursTimeout)
+		summaries, err := toursClient.GetTourSummaries(callCtx, missing)
+		cancel()
+		if err != nil {
+			log.Printf("Tours-service nije dostupan, koriste se poslednji poznati sažeci tura: %v", err)
+		} else {
+			cacheTours(missing, summaries)
+		}
+	}
+
+	for _, post := range posts {
+		post.Tours = nil
+		for _, id := range post.TourIds {
+			if summary, _, ok := tourCache.Get(id); ok && summary != nil {
+				post.Tours = append(post.Tours, &blogproto.TourSummary{
+					Id:            summary.ID,
+					Name:          summary.Name,
+					Difficulty:    summary.Difficulty,
+					AverageRating: summary.AverageRating,
+					RatingCount:   int32(summary.RatingCount),
+				})
+			}
+		}
+	}
+}
+
+// postsToProto pretvara postove u proto poruke zajedno sa sazecima tura.
+func postsToProto(ctx context.Context, posts []models.Post) []*blogproto.Post {
+	protoPosts := make([]*blogproto.Post, len(posts))
+	for i := range posts {
+		protoPosts[i] = convertPostToProto(&posts[i])
+	}
+	attachTourSummaries(ctx, protoPosts)
+	return protoPosts
+}
+
+func postToProto(ctx context.Context, post *models.Post) *blogproto.Post {
+	protoPost := convertPostToProto(post)
+	attachTourSummaries(ctx, []*blogproto.Post{protoPost})
+	return protoPost
+}
+
+func (s *BlogServer) GetPostsForTour(ctx context.Context, req *blogproto.GetPostsForTourRequest) (*blogproto.GetPostsForTourResponse, error) {
+	tourID, err := uuid.Parse(req.GetTourId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Neispravan format ID-a ture.")
+	}
+	limit := pageLimit(req.GetLimit())
+
+	viewer, _, _, err := GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "Nevalidan token: %v", err)
+	}
+	following, _ := feedAuthors(ctx, viewer)
+	hidden, _, err := hiddenAuthors(ctx, viewer)
+	if err != nil {
+		return nil, err
+	}
+
+	query := database.GORM_DB.Where("status = ? AND tour_ids @> ARRAY[?]::text[]", models.PostPublished, tourID.String()).
+		Scopes(visibleTo(viewer, following), excludeAuthors(hidden))
+	if req.GetCursor() != "" {
+		c, err := decodeCursor(req.GetCursor())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Neispravan kursor.")
+		}
+		query = query.Where("(published_at, id) < (?, ?)", c.CreatedAt, c.ID)
+	}
+
+	var posts []models.Post
+	if err := query.Order("published_at DESC, id DESC").Limit(limit + 1).Find(&posts).Error; err != nil {
+		log.Printf("Greška pri dohvatanju postova za turu %s: %v", tourID, err)
+		return nil, status.Errorf(codes.Internal, "Greška servera pri dohvatanju postova.")
+	}
+
+	var nextCursor string
+	if len(posts) > limit {
+		posts = posts[:limit]
+		last := posts[limit-1]
+		nextCursor = encodeCursor(cursor{CreatedAt: *last.PublishedAt, ID: last.ID})
+	}
+
+	return &blogproto.GetPostsForTourResponse{Posts: postsToProto(ctx, posts), NextCursor: nextCursor}, nil
+}
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"slices"
+
+	"soa/blog-service/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// parseVisibility vraca vidljivost iz zahteva, a za praznu vrednost fallback.
+func parseVisibility(value string, fallback models.PostVisibility) (models.PostVisibility, error) {
+	switch visibility := models.PostVisibility(value); visibility {
+	case "":
+		return fallback, nil
+	case models.VisibilityPublic, models.VisibilityFollowers, models.VisibilityPrivate:
+		return visibility, nil
+	default:
+		return "", status.Errorf(codes.InvalidArgument, "Nepoznata vidljivost posta: %s.", value)
+	}
+}
+
+// checkPostAccess proverava da li trenutni korisnik sme da vidi post. Autor
+// vidi sve svoje postove, a ostali samo objavljene postove u skladu sa
+// vidljivoscu, osim ako su blokirani u bilo kom smeru. Za nedostupan post se
+// vraca NotFound, da se ne bi otkrilo da post postoji, a ako blokiranje ne
+// moze da se proveri ni iz poslednjeg poznatog spiska, Unavailable.
+func checkPostAccess(ctx context.Context, post *models.Post) error {
+	username, userId, _, err := GetClaimsFromContext(ctx)
+	if err == nil && userId == post.UserID {
+		return nil
+	}
+
+	notFound := status.Errorf(codes.NotFound, "Post nije pronađen.")
+	if post.Status != models.PostPublished {
+		return notFound
+	}
+
+	if err == nil {
+		r, _, rErr := getRestrictions(ctx, username)
+		if rErr != nil {
+			log.Printf("Greška pri proveri blokiranja za %s: %v", username, rErr)
+			return status.Errorf(codes.Unavailable, "Provera blokiranih korisnika trenutno nije moguća.")
+		}
+		if isBlockedWith(r, post.Username) {
+			return notFound
+		}
+	}
+
+	switch post.Visibility {
+	case models.VisibilityPrivate:
+		return notFound
+	case models.VisibilityFollowers:
+		if err != nil {
+			return notFound
+		}
+		following, _ := feedAuthors(ctx, username)
+		if !slices.Contains(following, post.Username) {
+			return notFound
+		}
+	}
+	return nil
+}
+
+// visibleTo ogranicava upit na objavljene postove koje korisnik sme da vidi.
+// following su korisnici koje prati; postovi "followers" ostalih autora se
+// izostavljaju.
+func visibleTo(username string, following []string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if username == "" {
+			return db.Where("visibility = ?", models.VisibilityPublic)
+		}
+		if len(following) == 0 {
+			return db.Where("(visibility = ? OR username = ?)", models.VisibilityPublic, username)
+		}
+		return db.Where("(visibility = ? OR username = ? OR (visibility = ? AND username IN ?))",
+			models.VisibilityPublic, username, models.VisibilityFollowers, following)
+	}
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
@@ -10,8 +11,14 @@ import (
 	blogproto "soa/blog-service/proto/blog"
 	followerproto "soa/blog-service/proto/follower"
 
+	"shared/events"
+	"shared/outbox"
+	"shared/storage"
+	"shared/uploadgc"
+	"shared/uploads"
 	"soa/blog-service/database"
 	"soa/blog-service/handlers"
+	"soa/blog-service/rest_clients"
 
 	cors "github.com/gorilla/handlers"
 	"github.com/joho/godotenv"
@@ -51,6 +58,28 @@ func main() {
 	followerClient := followerproto.NewFollowerServiceClient(followerConn)
 	handlers.InitFollowerClient(followerClient)
 
+	toursServiceURL := "http://tours-service:8083"
+	//toursServiceURL := "http://localhost:8083"
+	handlers.InitToursClient(rest_clients.NewToursClient(toursServiceURL))
+
+	fileStorage, err := storage.NewFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to initialize file storage: %v", err)
+	}
+	handlers.InitStorage(fileStorage)
+	uploads.StartSweeper(context.Background(), "blog-service", database.GORM_DB, fileStorage, uploadgc.ConfigFromEnv())
+
+	// Veza se uspostavlja u pozadini, pa feed radi i dok NATS nije dostupan;
+	// dogadjaji tada cekaju u outbox-u, a timeline se azurira kada se veza
+	// uspostavi
+	natsConn, err := events.Connect(os.Getenv("NATS_URL"), "blog-service")
+	if err != nil {
+		log.Fatalf("Failed to configure NATS connection: %v", err)
+	}
+	defer natsConn.Close()
+	outbox.StartRelay(context.Background(), "blog-service", database.GORM_DB, natsConn, outbox.ConfigFromEnv())
+	handlers.InitTimeline(natsConn)
+
 	go func() {
 		httpPort := "8086"
 		httpMux := http.NewServeMux()
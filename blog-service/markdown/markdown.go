@@ -0,0 +1,106 @@
+package markdown
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/text"
+)
+
+// Rendered sadrzi HTML spreman za prikaz i podatke izvucene iz teksta za
+// kasnije indeksiranje.
+type Rendered struct {
+	HTML     string
+	Mentions []string
+	Hashtags []string
+}
+
+var (
+	// Sirov HTML iz markdown-a goldmark vec izostavlja, a bluemonday uklanja
+	// sve sto bi ipak proslo (skripte, on* atribute, javascript: linkove)
+	renderer = goldmark.New(goldmark.WithExtensions(extension.GFM))
+	policy   = newPolicy()
+
+	mentionPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_@])@([\p{L}\p{N}_.]*[\p{L}\p{N}_])`)
+	hashtagPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_#&])#([\p{L}\p{N}_]*\p{L}[\p{L}\p{N}_]*)`)
+)
+
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	p.RequireNoFollowOnLinks(true)
+	p.AddTargetBlankToFullyQualifiedLinks(true)
+	return p
+}
+
+// Render pretvara markdown u sanitizovan HTML i izvlaci pominjanja
+// (@username) i hashtagove. Tekst unutar koda se ne pretrazuje.
+func Render(source string) (Rendered, error) {
+	src := []byte(source)
+	doc := renderer.Parser().Parse(text.NewReader(src))
+
+	var buf bytes.Buffer
+	if err := renderer.Renderer().Render(&buf, src, doc); err != nil {
+		return Rendered{}, err
+	}
+
+	plain := plainText(doc, src)
+	return Rendered{
+		HTML:     policy.Sanitize(buf.String()),
+		Mentions: extract(mentionPattern, plain, false),
+		Hashtags: extract(hashtagPattern, plain, true),
+	}, nil
+}
+
+// plainText spaja tekst dokumenta, preskacuci kod i sirov HTML. Granice
+// blokova i preskocenih delova se zamenjuju razmakom da se reci ne bi spojile.
+func plainText(doc ast.Node, src []byte) string {
+	var sb strings.Builder
+	ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		switch node := n.(type) {
+		case *ast.CodeSpan, *ast.CodeBlock, *ast.FencedCodeBlock, *ast.RawHTML, *ast.HTMLBlock:
+			if entering {
+				sb.WriteByte(' ')
+			}
+			return ast.WalkSkipChildren, nil
+		case *ast.Text:
+			if entering {
+				sb.Write(node.Segment.Value(src))
+				if node.SoftLineBreak() || node.HardLineBreak() {
+					sb.WriteByte(' ')
+				}
+			}
+		case *ast.AutoLink:
+			if entering {
+				sb.WriteByte(' ')
+			}
+			return ast.WalkSkipChildren, nil
+		default:
+			if n.Type() == ast.TypeBlock {
+				sb.WriteByte(' ')
+			}
+		}
+		return ast.WalkContinue, nil
+	})
+	return sb.String()
+}
+
+func extract(pattern *regexp.Regexp, s string, lower bool) []string {
+	seen := make(map[string]bool)
+	result := []string{}
+	for _, match := range pattern.FindAllStringSubmatch(s, -1) {
+		value := match[1]
+		if lower {
+			value = strings.ToLower(value)
+		}
+		if !seen[value] {
+			seen[value] = true
+			result = append(result, value)
+		}
+	}
+	return result
+}
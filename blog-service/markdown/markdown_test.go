@@ -0,0 +1,134 @@
+package markdown
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestRenderSanitizesHTML(t *testing.T) {
+	tests := []struct {
+		name      string
+		source    string
+		forbidden []string
+		want      []string
+	}{
+		{
+			name:      "script block",
+			source:    "Pre\n\n<script>alert(1)</script>\n\nPosle",
+			forbidden: []string{"<script", "alert(1)"},
+			want:      []string{"Pre", "Posle"},
+		},
+		{
+			name:      "inline script",
+			source:    "tekst <script>alert(1)</script> tekst",
+			forbidden: []string{"<script"},
+			want:      []string{"tekst"},
+		},
+		{
+			name:      "iframe",
+			source:    `<iframe src="https://evil.example/"></iframe>`,
+			forbidden: []string{"<iframe", "evil.example"},
+		},
+		{
+			name:      "javascript link",
+			source:    "[klikni](javascript:alert(1))",
+			forbidden: []string{"javascript:"},
+			want:      []string{"klikni"},
+		},
+		{
+			name:      "javascript link with mixed case",
+			source:    "[klikni](JaVaScRiPt:alert(1))",
+			forbidden: []string{"javascript:"},
+			want:      []string{"klikni"},
+		},
+		{
+			name:      "event handler attribute",
+			source:    `<img src="x.png" onerror="alert(1)">`,
+			forbidden: []string{"onerror"},
+		},
+		{
+			name:   "regular link",
+			source: "[sajt](https://example.com)",
+			want:   []string{`href="https://example.com"`, `rel="nofollow noopener"`, `target="_blank"`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Render(tt.source)
+			if err != nil {
+				t.Fatalf("Render: %v", err)
+			}
+			html := strings.ToLower(got.HTML)
+			for _, forbidden := range tt.forbidden {
+				if strings.Contains(html, strings.ToLower(forbidden)) {
+					t.Errorf("html contains %q:\n%s", forbidden, got.HTML)
+				}
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(got.HTML, want) {
+					t.Errorf("html does not contain %q:\n%s", want, got.HTML)
+				}
+			}
+		})
+	}
+}
+
+func TestRenderMentions(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   []string
+	}{
+		{"start of text", "@ana zdravo", []string{"ana"}},
+		{"after punctuation", "(@ana), @marko!", []string{"ana", "marko"}},
+		{"trailing dot is not part of username", "Hvala @ana.", []string{"ana"}},
+		{"dot inside username", "@ana.petrovic je tu", []string{"ana.petrovic"}},
+		{"unicode username", "pozdrav @Đorđe", []string{"Đorđe"}},
+		{"email is not a mention", "pisi na ana@example.com", []string{}},
+		{"double at", "@@ana", []string{}},
+		{"duplicates", "@ana i opet @ana", []string{"ana"}},
+		{"inline code", "pogledaj `@ana` i @marko", []string{"marko"}},
+		{"fenced code", "```\n@ana\n```\n\n@marko", []string{"marko"}},
+		{"indented code", "    @ana\n\n@marko", []string{"marko"}},
+		{"emphasis", "*@ana*", []string{"ana"}},
+		{"across blocks", "kraj@\n\n@ana", []string{"ana"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Render(tt.source)
+			if err != nil {
+				t.Fatalf("Render: %v", err)
+			}
+			if !slices.Equal(got.Mentions, tt.want) {
+				t.Errorf("Mentions = %v, want %v", got.Mentions, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderHashtags(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   []string
+	}{
+		{"lowercased", "#Planine i #planine", []string{"planine"}},
+		{"digits only is not a tag", "#2024 #tura2024", []string{"tura2024"}},
+		{"inside word", "abc#tag", []string{}},
+		{"html entity", "&#39; tekst", []string{}},
+		{"heading", "# Naslov\n\n#tag", []string{"tag"}},
+		{"inline code", "`#tag` i #drugi", []string{"drugi"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Render(tt.source)
+			if err != nil {
+				t.Fatalf("Render: %v", err)
+			}
+			if !slices.Equal(got.Hashtags, tt.want) {
+				t.Errorf("Hashtags = %v, want %v", got.Hashtags, tt.want)
+			}
+		})
+	}
+}
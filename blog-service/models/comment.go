@@ -4,14 +4,29 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
+// MaxCommentDepth je najveca dubina odgovora; komentari prvog nivoa imaju
+// dubinu 0.
+const MaxCommentDepth = 4
+
+// Comment obrisan dok ima odgovore ostaje kao prazan cvor (Deleted) da bi
+// odgovori zadrzali svoje mesto u niti. Takav cvor se brise kada nestane i
+// poslednji odgovor.
 type Comment struct {
-	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
-	PostID    uuid.UUID `gorm:"type:uuid;not null;index" json:"postId"`
-	UserID    string    `gorm:"type:varchar(24);not null" json:"userId"`
-	Username  string    `gorm:"type:varchar(255);not null" json:"username"`
-	Text      string    `gorm:"type:text;not null" json:"text"`
-	CreatedAt time.Time `gorm:"default:now();not null" json:"createdAt"`
-	UpdatedAt time.Time `gorm:"default:now();not null" json:"updatedAt"`
+	ID         uuid.UUID      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	PostID     uuid.UUID      `gorm:"type:uuid;not null;index:idx_comment_thread" json:"postId"`
+	ParentID   *uuid.UUID     `gorm:"type:uuid;index:idx_comment_thread" json:"parentId"`
+	Depth      int            `gorm:"default:0;not null" json:"depth"`
+	ReplyCount int            `gorm:"default:0;not null" json:"replyCount"`
+	UserID     string         `gorm:"type:varchar(24);not null" json:"userId"`
+	Username   string         `gorm:"type:varchar(255);not null" json:"username"`
+	Text       string         `gorm:"type:text;not null" json:"text"`
+	TextHTML   string         `gorm:"type:text;not null;default:''" json:"textHtml"`
+	Mentions   pq.StringArray `gorm:"type:text[]" json:"mentions"`
+	Hashtags   pq.StringArray `gorm:"type:text[]" json:"hashtags"`
+	Deleted    bool           `gorm:"default:false;not null" json:"deleted"`
+	CreatedAt  time.Time      `gorm:"default:now();not null;index:idx_comment_thread" json:"createdAt"`
+	UpdatedAt  time.Time      `gorm:"default:now();not null" json:"updatedAt"`
 }
@@ -5,15 +5,46 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/lib/pq"
+	"gorm.io/gorm"
 )
 
+type PostStatus string
+
+const (
+	PostDraft     PostStatus = "draft"
+	PostPublished PostStatus = "published"
+)
+
+// PostVisibility odredjuje ko osim autora vidi objavljen post.
+type PostVisibility string
+
+const (
+	VisibilityPublic    PostVisibility = "public"
+	VisibilityFollowers PostVisibility = "followers"
+	VisibilityPrivate   PostVisibility = "private"
+)
+
+// Post se brise soft delete-om. Postovi kreirani pre uvodjenja draftova su
+// vec bili javni, pa je podrazumevani status published. Description je
+// markdown koji je autor napisao, a DescriptionHTML njegov sanitizovan HTML.
+// TourIDs su ture iz tours-service o kojima post govori.
 type Post struct {
-	ID          uuid.UUID      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
-	UserID      string         `gorm:"type:varchar(24);not null"`
-	Username    string         `gorm:"type:varchar(255);not null"`
-	Title       string         `gorm:"type:varchar(255);not null"`
-	Description string         `gorm:"type:text;not null"`
-	CreatedAt   time.Time      `gorm:"default:now();not null"`
-	ImageURLs   pq.StringArray `gorm:"type:text[]" json:"imageURLs"`
-	LikesCount  int            `gorm:"default:0;not null"`
+	ID              uuid.UUID      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	UserID          string         `gorm:"type:varchar(24);not null"`
+	Username        string         `gorm:"type:varchar(255);not null;index:idx_post_author_published"`
+	Title           string         `gorm:"type:varchar(255);not null"`
+	Description     string         `gorm:"type:text;not null"`
+	DescriptionHTML string         `gorm:"type:text;not null;default:''"`
+	Mentions        pq.StringArray `gorm:"type:text[];index:,type:gin"`
+	Hashtags        pq.StringArray `gorm:"type:text[];index:,type:gin"`
+	CreatedAt       time.Time      `gorm:"default:now();not null"`
+	ImageURLs       pq.StringArray `gorm:"type:text[]" json:"imageURLs"`
+	TourIDs         pq.StringArray `gorm:"type:text[];index:,type:gin"`
+	LikesCount      int            `gorm:"default:0;not null"`
+	Status          PostStatus     `gorm:"type:varchar(20);default:'published';not null;index"`
+	Visibility      PostVisibility `gorm:"type:varchar(20);default:'public';not null"`
+	UpdatedAt       *time.Time     `gorm:"autoUpdateTime:false"`
+	PublishedAt     *time.Time     `gorm:"index:idx_post_author_published"`
+	FannedOutAt     *time.Time
+	DeletedAt       gorm.DeletedAt `gorm:"index"`
 }
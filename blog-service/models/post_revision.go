@@ -0,0 +1,18 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// PostRevision cuva sadrzaj posta kakav je bio pre izmene.
+type PostRevision struct {
+	ID          uuid.UUID      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	PostID      uuid.UUID      `gorm:"type:uuid;not null;index"`
+	Title       string         `gorm:"type:varchar(255);not null"`
+	Description string         `gorm:"type:text;not null"`
+	ImageURLs   pq.StringArray `gorm:"type:text[]"`
+	EditedAt    time.Time      `gorm:"default:now();not null"`
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TimelineEntry je materijalizovan red u feed-u korisnika OwnerUsername.
+// Upisuje se pri objavljivanju posta (fan-out on write).
+type TimelineEntry struct {
+	OwnerUsername  string    `gorm:"type:varchar(255);primaryKey"`
+	PostID         uuid.UUID `gorm:"type:uuid;primaryKey;index"`
+	AuthorUsername string    `gorm:"type:varchar(255);not null;index"`
+	CreatedAt      time.Time `gorm:"default:now();not null"`
+}
+
+// TimelineState oznacava da je timeline korisnika izgradjen, pa se feed moze
+// citati iz timeline_entries.
+type TimelineState struct {
+	Username string    `gorm:"type:varchar(255);primaryKey"`
+	BuiltAt  time.Time `gorm:"not null"`
+}
+
+// HeavyAuthor je autor sa previse pratilaca za fan-out on write. Njegovi postovi
+// se ne upisuju u timeline-ove, vec se dodaju feed-u pri citanju.
+type HeavyAuthor struct {
+	Username      string    `gorm:"type:varchar(255);primaryKey"`
+	FollowerCount int       `gorm:"not null"`
+	UpdatedAt     time.Time `gorm:"not null"`
+}
@@ -0,0 +1,6 @@
+package models
+
+// Tipovi entiteta za koje se vezuju uploadi (shared/uploads). Slike se
+// uploaduju pre kreiranja posta, pa upload ostaje bez reference dok ga post ne
+// preuzme.
+const UploadRefPost = "post"
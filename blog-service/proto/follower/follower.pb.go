@@ -67,8 +67,10 @@ func (x *FollowRequest) GetTo() string {
 }
 
 type FollowResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Status string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// true kada je poslat zahtev privatnom nalogu koji jos nije odobren
+	Pending       bool `protobuf:"varint,2,opt,name=pending,proto3" json:"pending,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -110,6 +112,13 @@ func (x *FollowResponse) GetStatus() string {
 	return ""
 }
 
+func (x *FollowResponse) GetPending() bool {
+	if x != nil {
+		return x.Pending
+	}
+	return false
+}
+
 type UnfollowRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	To            string                 `protobuf:"bytes,1,opt,name=to,proto3" json:"to,omitempty"`
@@ -374,26 +383,27 @@ func (x *GetFollowersResponse) GetFollowers() []string {
 	return nil
 }
 
-type RecommendRequest struct {
+type GetFollowCountsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RecommendRequest) Reset() {
-	*x = RecommendRequest{}
+func (x *GetFollowCountsRequest) Reset() {
+	*x = GetFollowCountsRequest{}
 	mi := &file_follower_follower_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecommendRequest) String() string {
+func (x *GetFollowCountsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecommendRequest) ProtoMessage() {}
+func (*GetFollowCountsRequest) ProtoMessage() {}
 
-func (x *RecommendRequest) ProtoReflect() protoreflect.Message {
+func (x *GetFollowCountsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_follower_follower_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -405,32 +415,41 @@ func (x *RecommendRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecommendRequest.ProtoReflect.Descriptor instead.
-func (*RecommendRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetFollowCountsRequest.ProtoReflect.Descriptor instead.
+func (*GetFollowCountsRequest) Descriptor() ([]byte, []int) {
 	return file_follower_follower_proto_rawDescGZIP(), []int{8}
 }
 
-type RecommendResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	RecommendedUsers []*RecDTO              `protobuf:"bytes,1,rep,name=recommended_users,json=recommendedUsers,proto3" json:"recommended_users,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *GetFollowCountsRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
 }
 
-func (x *RecommendResponse) Reset() {
-	*x = RecommendResponse{}
+type GetFollowCountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Followers     int64                  `protobuf:"varint,2,opt,name=followers,proto3" json:"followers,omitempty"`
+	Following     int64                  `protobuf:"varint,3,opt,name=following,proto3" json:"following,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFollowCountsResponse) Reset() {
+	*x = GetFollowCountsResponse{}
 	mi := &file_follower_follower_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecommendResponse) String() string {
+func (x *GetFollowCountsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecommendResponse) ProtoMessage() {}
+func (*GetFollowCountsResponse) ProtoMessage() {}
 
-func (x *RecommendResponse) ProtoReflect() protoreflect.Message {
+func (x *GetFollowCountsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_follower_follower_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -442,40 +461,54 @@ func (x *RecommendResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecommendResponse.ProtoReflect.Descriptor instead.
-func (*RecommendResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetFollowCountsResponse.ProtoReflect.Descriptor instead.
+func (*GetFollowCountsResponse) Descriptor() ([]byte, []int) {
 	return file_follower_follower_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *RecommendResponse) GetRecommendedUsers() []*RecDTO {
+func (x *GetFollowCountsResponse) GetUsername() string {
 	if x != nil {
-		return x.RecommendedUsers
+		return x.Username
 	}
-	return nil
+	return ""
 }
 
-type RecDTO struct {
+func (x *GetFollowCountsResponse) GetFollowers() int64 {
+	if x != nil {
+		return x.Followers
+	}
+	return 0
+}
+
+func (x *GetFollowCountsResponse) GetFollowing() int64 {
+	if x != nil {
+		return x.Following
+	}
+	return 0
+}
+
+type IsFollowingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Mutuals       int64                  `protobuf:"varint,2,opt,name=mutuals,proto3" json:"mutuals,omitempty"`
+	Follower      string                 `protobuf:"bytes,1,opt,name=follower,proto3" json:"follower,omitempty"`
+	Followee      string                 `protobuf:"bytes,2,opt,name=followee,proto3" json:"followee,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RecDTO) Reset() {
-	*x = RecDTO{}
+func (x *IsFollowingRequest) Reset() {
+	*x = IsFollowingRequest{}
 	mi := &file_follower_follower_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecDTO) String() string {
+func (x *IsFollowingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecDTO) ProtoMessage() {}
+func (*IsFollowingRequest) ProtoMessage() {}
 
-func (x *RecDTO) ProtoReflect() protoreflect.Message {
+func (x *IsFollowingRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_follower_follower_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -487,58 +520,2364 @@ func (x *RecDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecDTO.ProtoReflect.Descriptor instead.
-func (*RecDTO) Descriptor() ([]byte, []int) {
+// Deprecated: Use IsFollowingRequest.ProtoReflect.Descriptor instead.
+func (*IsFollowingRequest) Descriptor() ([]byte, []int) {
 	return file_follower_follower_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *RecDTO) GetUsername() string {
+func (x *IsFollowingRequest) GetFollower() string {
+	if x != nil {
+		return x.Follower
+	}
+	return ""
+}
+
+func (x *IsFollowingRequest) GetFollowee() string {
+	if x != nil {
+		return x.Followee
+	}
+	return ""
+}
+
+type IsFollowingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Following     bool                   `protobuf:"varint,1,opt,name=following,proto3" json:"following,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IsFollowingResponse) Reset() {
+	*x = IsFollowingResponse{}
+	mi := &file_follower_follower_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsFollowingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsFollowingResponse) ProtoMessage() {}
+
+func (x *IsFollowingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsFollowingResponse.ProtoReflect.Descriptor instead.
+func (*IsFollowingResponse) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *IsFollowingResponse) GetFollowing() bool {
+	if x != nil {
+		return x.Following
+	}
+	return false
+}
+
+type GetRelationshipStatusRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// najvise 100 korisnika
+	Usernames     []string `protobuf:"bytes,1,rep,name=usernames,proto3" json:"usernames,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRelationshipStatusRequest) Reset() {
+	*x = GetRelationshipStatusRequest{}
+	mi := &file_follower_follower_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelationshipStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelationshipStatusRequest) ProtoMessage() {}
+
+func (x *GetRelationshipStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelationshipStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetRelationshipStatusRequest) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetRelationshipStatusRequest) GetUsernames() []string {
+	if x != nil {
+		return x.Usernames
+	}
+	return nil
+}
+
+type GetRelationshipStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statuses      []*RelationshipStatus  `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRelationshipStatusResponse) Reset() {
+	*x = GetRelationshipStatusResponse{}
+	mi := &file_follower_follower_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelationshipStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelationshipStatusResponse) ProtoMessage() {}
+
+func (x *GetRelationshipStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelationshipStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetRelationshipStatusResponse) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetRelationshipStatusResponse) GetStatuses() []*RelationshipStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type RelationshipStatus struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Username string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// trenutni korisnik prati username
+	Following bool `protobuf:"varint,2,opt,name=following,proto3" json:"following,omitempty"`
+	// username prati trenutnog korisnika
+	FollowedBy bool `protobuf:"varint,3,opt,name=followed_by,json=followedBy,proto3" json:"followed_by,omitempty"`
+	// trenutni korisnik je poslao zahtev koji jos nije odobren
+	Requested bool `protobuf:"varint,4,opt,name=requested,proto3" json:"requested,omitempty"`
+	// trenutni korisnik je blokirao username
+	Blocked       bool `protobuf:"varint,5,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RelationshipStatus) Reset() {
+	*x = RelationshipStatus{}
+	mi := &file_follower_follower_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelationshipStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationshipStatus) ProtoMessage() {}
+
+func (x *RelationshipStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationshipStatus.ProtoReflect.Descriptor instead.
+func (*RelationshipStatus) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RelationshipStatus) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-func (x *RecDTO) GetMutuals() int64 {
+func (x *RelationshipStatus) GetFollowing() bool {
 	if x != nil {
-		return x.Mutuals
+		return x.Following
 	}
-	return 0
+	return false
 }
 
-var File_follower_follower_proto protoreflect.FileDescriptor
+func (x *RelationshipStatus) GetFollowedBy() bool {
+	if x != nil {
+		return x.FollowedBy
+	}
+	return false
+}
 
-const file_follower_follower_proto_rawDesc = "" +
-	"\n" +
-	"\x17follower/follower.proto\x12\bfollower\x1a\x1cgoogle/api/annotations.proto\x1a\x15google/api/http.proto\"\x1f\n" +
-	"\rFollowRequest\x12\x0e\n" +
-	"\x02to\x18\x01 \x01(\tR\x02to\"(\n" +
-	"\x0eFollowResponse\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\tR\x06status\"!\n" +
-	"\x0fUnfollowRequest\x12\x0e\n" +
-	"\x02to\x18\x01 \x01(\tR\x02to\"*\n" +
-	"\x10UnfollowResponse\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\tR\x06status\"1\n" +
-	"\x13GetFollowingRequest\x12\x1a\n" +
-	"\busername\x18\x01 \x01(\tR\busername\"4\n" +
-	"\x14GetFollowingResponse\x12\x1c\n" +
-	"\tfollowing\x18\x01 \x03(\tR\tfollowing\"1\n" +
-	"\x13GetFollowersRequest\x12\x1a\n" +
-	"\busername\x18\x01 \x01(\tR\busername\"4\n" +
-	"\x14GetFollowersResponse\x12\x1c\n" +
-	"\tfollowers\x18\x01 \x03(\tR\tfollowers\"\x12\n" +
-	"\x10RecommendRequest\"R\n" +
-	"\x11RecommendResponse\x12=\n" +
-	"\x11recommended_users\x18\x01 \x03(\v2\x10.follower.RecDTOR\x10recommendedUsers\">\n" +
-	"\x06RecDTO\x12\x1a\n" +
-	"\busername\x18\x01 \x01(\tR\busername\x12\x18\n" +
-	"\amutuals\x18\x02 \x01(\x03R\amutuals2\x85\x04\n" +
-	"\x0fFollowerService\x12S\n" +
-	"\x06Follow\x12\x17.follower.FollowRequest\x1a\x18.follower.FollowResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/api/follow\x12[\n" +
-	"\bUnfollow\x12\x19.follower.UnfollowRequest\x1a\x1a.follower.UnfollowResponse\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/api/follow/{to}\x12p\n" +
-	"\fGetFollowing\x12\x1d.follower.GetFollowingRequest\x1a\x1e.follower.GetFollowingResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/following/{username}\x12p\n" +
-	"\fGetFollowers\x12\x1d.follower.GetFollowersRequest\x1a\x1e.follower.GetFollowersResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/followers/{username}\x12\\\n" +
-	"\tRecommend\x12\x1a.follower.RecommendRequest\x1a\x1b.follower.RecommendResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/api/recommendB'Z%soa-team-5/api-gateway/proto/followerb\x06proto3"
+func (x *RelationshipStatus) GetRequested() bool {
+	if x != nil {
+		return x.Requested
+	}
+	return false
+}
+
+func (x *RelationshipStatus) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+type GetMutualFollowersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Other         string                 `protobuf:"bytes,2,opt,name=other,proto3" json:"other,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMutualFollowersRequest) Reset() {
+	*x = GetMutualFollowersRequest{}
+	mi := &file_follower_follower_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMutualFollowersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMutualFollowersRequest) ProtoMessage() {}
+
+func (x *GetMutualFollowersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMutualFollowersRequest.ProtoReflect.Descriptor instead.
+func (*GetMutualFollowersRequest) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetMutualFollowersRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GetMutualFollowersRequest) GetOther() string {
+	if x != nil {
+		return x.Other
+	}
+	return ""
+}
+
+type GetMutualFollowersResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MutualFollowers []string               `protobuf:"bytes,1,rep,name=mutual_followers,json=mutualFollowers,proto3" json:"mutual_followers,omitempty"`
+	Count           int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetMutualFollowersResponse) Reset() {
+	*x = GetMutualFollowersResponse{}
+	mi := &file_follower_follower_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMutualFollowersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMutualFollowersResponse) ProtoMessage() {}
+
+func (x *GetMutualFollowersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMutualFollowersResponse.ProtoReflect.Descriptor instead.
+func (*GetMutualFollowersResponse) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetMutualFollowersResponse) GetMutualFollowers() []string {
+	if x != nil {
+		return x.MutualFollowers
+	}
+	return nil
+}
+
+func (x *GetMutualFollowersResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type RecommendRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// podrazumevano 10, najvise 50
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// mutuals (podrazumevano), tours, nearby ili all
+	Strategy      string `protobuf:"bytes,2,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecommendRequest) Reset() {
+	*x = RecommendRequest{}
+	mi := &file_follower_follower_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendRequest) ProtoMessage() {}
+
+func (x *RecommendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendRequest.ProtoReflect.Descriptor instead.
+func (*RecommendRequest) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RecommendRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *RecommendRequest) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+type RecommendResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	RecommendedUsers []*RecDTO              `protobuf:"bytes,1,rep,name=recommended_users,json=recommendedUsers,proto3" json:"recommended_users,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RecommendResponse) Reset() {
+	*x = RecommendResponse{}
+	mi := &file_follower_follower_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendResponse) ProtoMessage() {}
+
+func (x *RecommendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendResponse.ProtoReflect.Descriptor instead.
+func (*RecommendResponse) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RecommendResponse) GetRecommendedUsers() []*RecDTO {
+	if x != nil {
+		return x.RecommendedUsers
+	}
+	return nil
+}
+
+type RecDTO struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Username string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Mutuals  int64                  `protobuf:"varint,2,opt,name=mutuals,proto3" json:"mutuals,omitempty"`
+	// zasto je korisnik preporucen, npr. "3 mutual follows"
+	Reasons       []string `protobuf:"bytes,3,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	Score         float64  `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecDTO) Reset() {
+	*x = RecDTO{}
+	mi := &file_follower_follower_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecDTO) ProtoMessage() {}
+
+func (x *RecDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecDTO.ProtoReflect.Descriptor instead.
+func (*RecDTO) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RecDTO) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *RecDTO) GetMutuals() int64 {
+	if x != nil {
+		return x.Mutuals
+	}
+	return 0
+}
+
+func (x *RecDTO) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *RecDTO) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type DismissRecommendationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DismissRecommendationRequest) Reset() {
+	*x = DismissRecommendationRequest{}
+	mi := &file_follower_follower_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DismissRecommendationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DismissRecommendationRequest) ProtoMessage() {}
+
+func (x *DismissRecommendationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DismissRecommendationRequest.ProtoReflect.Descriptor instead.
+func (*DismissRecommendationRequest) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DismissRecommendationRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type DismissRecommendationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DismissRecommendationResponse) Reset() {
+	*x = DismissRecommendationResponse{}
+	mi := &file_follower_follower_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DismissRecommendationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DismissRecommendationResponse) ProtoMessage() {}
+
+func (x *DismissRecommendationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DismissRecommendationResponse.ProtoReflect.Descriptor instead.
+func (*DismissRecommendationResponse) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DismissRecommendationResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type SetAccountPrivacyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Private       bool                   `protobuf:"varint,1,opt,name=private,proto3" json:"private,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAccountPrivacyRequest) Reset() {
+	*x = SetAccountPrivacyRequest{}
+	mi := &file_follower_follower_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAccountPrivacyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAccountPrivacyRequest) ProtoMessage() {}
+
+func (x *SetAccountPrivacyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAccountPrivacyRequest.ProtoReflect.Descriptor instead.
+func (*SetAccountPrivacyRequest) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetAccountPrivacyRequest) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+type SetAccountPrivacyResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Private bool                   `protobuf:"varint,1,opt,name=private,proto3" json:"private,omitempty"`
+	// broj zahteva koji su automatski odobreni prelaskom na javni nalog
+	ApprovedRequests int64 `protobuf:"varint,2,opt,name=approved_requests,json=approvedRequests,proto3" json:"approved_requests,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SetAccountPrivacyResponse) Reset() {
+	*x = SetAccountPrivacyResponse{}
+	mi := &file_follower_follower_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAccountPrivacyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAccountPrivacyResponse) ProtoMessage() {}
+
+func (x *SetAccountPrivacyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAccountPrivacyResponse.ProtoReflect.Descriptor instead.
+func (*SetAccountPrivacyResponse) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetAccountPrivacyResponse) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+func (x *SetAccountPrivacyResponse) GetApprovedRequests() int64 {
+	if x != nil {
+		return x.ApprovedRequests
+	}
+	return 0
+}
+
+type GetFollowRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFollowRequestsRequest) Reset() {
+	*x = GetFollowRequestsRequest{}
+	mi := &file_follower_follower_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowRequestsRequest) ProtoMessage() {}
+
+func (x *GetFollowRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowRequestsRequest.ProtoReflect.Descriptor instead.
+func (*GetFollowRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{24}
+}
+
+type GetFollowRequestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requests      []*FollowRequestDTO    `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFollowRequestsResponse) Reset() {
+	*x = GetFollowRequestsResponse{}
+	mi := &file_follower_follower_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowRequestsResponse) ProtoMessage() {}
+
+func (x *GetFollowRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowRequestsResponse.ProtoReflect.Descriptor instead.
+func (*GetFollowRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetFollowRequestsResponse) GetRequests() []*FollowRequestDTO {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type FollowRequestDTO struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	RequestedAt   string                 `protobuf:"bytes,2,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowRequestDTO) Reset() {
+	*x = FollowRequestDTO{}
+	mi := &file_follower_follower_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowRequestDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowRequestDTO) ProtoMessage() {}
+
+func (x *FollowRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowRequestDTO.ProtoReflect.Descriptor instead.
+func (*FollowRequestDTO) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *FollowRequestDTO) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *FollowRequestDTO) GetRequestedAt() string {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return ""
+}
+
+type ApproveFollowRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveFollowRequestRequest) Reset() {
+	*x = ApproveFollowRequestRequest{}
+	mi := &file_follower_follower_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveFollowRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveFollowRequestRequest) ProtoMessage() {}
+
+func (x *ApproveFollowRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveFollowRequestRequest.ProtoReflect.Descriptor instead.
+func (*ApproveFollowRequestRequest) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ApproveFollowRequestRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+type ApproveFollowRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveFollowRequestResponse) Reset() {
+	*x = ApproveFollowRequestResponse{}
+	mi := &file_follower_follower_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveFollowRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveFollowRequestResponse) ProtoMessage() {}
+
+func (x *ApproveFollowRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveFollowRequestResponse.ProtoReflect.Descriptor instead.
+func (*ApproveFollowRequestResponse) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ApproveFollowRequestResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type RejectFollowRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectFollowRequestRequest) Reset() {
+	*x = RejectFollowRequestRequest{}
+	mi := &file_follower_follower_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectFollowRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectFollowRequestRequest) ProtoMessage() {}
+
+func (x *RejectFollowRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectFollowRequestRequest.ProtoReflect.Descriptor instead.
+func (*RejectFollowRequestRequest) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RejectFollowRequestRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+type RejectFollowRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectFollowRequestResponse) Reset() {
+	*x = RejectFollowRequestResponse{}
+	mi := &file_follower_follower_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectFollowRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectFollowRequestResponse) ProtoMessage() {}
+
+func (x *RejectFollowRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectFollowRequestResponse.ProtoReflect.Descriptor instead.
+func (*RejectFollowRequestResponse) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RejectFollowRequestResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type BlockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockRequest) Reset() {
+	*x = BlockRequest{}
+	mi := &file_follower_follower_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockRequest) ProtoMessage() {}
+
+func (x *BlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockRequest.ProtoReflect.Descriptor instead.
+func (*BlockRequest) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *BlockRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type BlockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockResponse) Reset() {
+	*x = BlockResponse{}
+	mi := &file_follower_follower_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockResponse) ProtoMessage() {}
+
+func (x *BlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockResponse.ProtoReflect.Descriptor instead.
+func (*BlockResponse) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *BlockResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type UnblockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockRequest) Reset() {
+	*x = UnblockRequest{}
+	mi := &file_follower_follower_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockRequest) ProtoMessage() {}
+
+func (x *UnblockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockRequest.ProtoReflect.Descriptor instead.
+func (*UnblockRequest) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UnblockRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type UnblockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockResponse) Reset() {
+	*x = UnblockResponse{}
+	mi := &file_follower_follower_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockResponse) ProtoMessage() {}
+
+func (x *UnblockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockResponse.ProtoReflect.Descriptor instead.
+func (*UnblockResponse) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UnblockResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GetBlockedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlockedRequest) Reset() {
+	*x = GetBlockedRequest{}
+	mi := &file_follower_follower_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlockedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockedRequest) ProtoMessage() {}
+
+func (x *GetBlockedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockedRequest.ProtoReflect.Descriptor instead.
+func (*GetBlockedRequest) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{35}
+}
+
+type GetBlockedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Blocked       []string               `protobuf:"bytes,1,rep,name=blocked,proto3" json:"blocked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlockedResponse) Reset() {
+	*x = GetBlockedResponse{}
+	mi := &file_follower_follower_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlockedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockedResponse) ProtoMessage() {}
+
+func (x *GetBlockedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockedResponse.ProtoReflect.Descriptor instead.
+func (*GetBlockedResponse) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetBlockedResponse) GetBlocked() []string {
+	if x != nil {
+		return x.Blocked
+	}
+	return nil
+}
+
+type MuteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MuteRequest) Reset() {
+	*x = MuteRequest{}
+	mi := &file_follower_follower_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteRequest) ProtoMessage() {}
+
+func (x *MuteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteRequest.ProtoReflect.Descriptor instead.
+func (*MuteRequest) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MuteRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type MuteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MuteResponse) Reset() {
+	*x = MuteResponse{}
+	mi := &file_follower_follower_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteResponse) ProtoMessage() {}
+
+func (x *MuteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteResponse.ProtoReflect.Descriptor instead.
+func (*MuteResponse) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *MuteResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type UnmuteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmuteRequest) Reset() {
+	*x = UnmuteRequest{}
+	mi := &file_follower_follower_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmuteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmuteRequest) ProtoMessage() {}
+
+func (x *UnmuteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmuteRequest.ProtoReflect.Descriptor instead.
+func (*UnmuteRequest) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UnmuteRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type UnmuteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmuteResponse) Reset() {
+	*x = UnmuteResponse{}
+	mi := &file_follower_follower_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmuteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmuteResponse) ProtoMessage() {}
+
+func (x *UnmuteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmuteResponse.ProtoReflect.Descriptor instead.
+func (*UnmuteResponse) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UnmuteResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GetMutedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMutedRequest) Reset() {
+	*x = GetMutedRequest{}
+	mi := &file_follower_follower_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMutedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMutedRequest) ProtoMessage() {}
+
+func (x *GetMutedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMutedRequest.ProtoReflect.Descriptor instead.
+func (*GetMutedRequest) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{41}
+}
+
+type GetMutedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Muted         []string               `protobuf:"bytes,1,rep,name=muted,proto3" json:"muted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMutedResponse) Reset() {
+	*x = GetMutedResponse{}
+	mi := &file_follower_follower_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMutedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMutedResponse) ProtoMessage() {}
+
+func (x *GetMutedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMutedResponse.ProtoReflect.Descriptor instead.
+func (*GetMutedResponse) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetMutedResponse) GetMuted() []string {
+	if x != nil {
+		return x.Muted
+	}
+	return nil
+}
+
+type GetRestrictionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRestrictionsRequest) Reset() {
+	*x = GetRestrictionsRequest{}
+	mi := &file_follower_follower_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRestrictionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRestrictionsRequest) ProtoMessage() {}
+
+func (x *GetRestrictionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRestrictionsRequest.ProtoReflect.Descriptor instead.
+func (*GetRestrictionsRequest) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetRestrictionsRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+// blocked su korisnici koje je username blokirao, blocked_by oni koji su
+// blokirali njega, a muted oni koje je utisao.
+type GetRestrictionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Blocked       []string               `protobuf:"bytes,1,rep,name=blocked,proto3" json:"blocked,omitempty"`
+	BlockedBy     []string               `protobuf:"bytes,2,rep,name=blocked_by,json=blockedBy,proto3" json:"blocked_by,omitempty"`
+	Muted         []string               `protobuf:"bytes,3,rep,name=muted,proto3" json:"muted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRestrictionsResponse) Reset() {
+	*x = GetRestrictionsResponse{}
+	mi := &file_follower_follower_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRestrictionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRestrictionsResponse) ProtoMessage() {}
+
+func (x *GetRestrictionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRestrictionsResponse.ProtoReflect.Descriptor instead.
+func (*GetRestrictionsResponse) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetRestrictionsResponse) GetBlocked() []string {
+	if x != nil {
+		return x.Blocked
+	}
+	return nil
+}
+
+func (x *GetRestrictionsResponse) GetBlockedBy() []string {
+	if x != nil {
+		return x.BlockedBy
+	}
+	return nil
+}
+
+func (x *GetRestrictionsResponse) GetMuted() []string {
+	if x != nil {
+		return x.Muted
+	}
+	return nil
+}
+
+type ReconcileUsersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// dry_run samo prijavljuje cvorove koji bi bili uklonjeni
+	DryRun        bool `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileUsersRequest) Reset() {
+	*x = ReconcileUsersRequest{}
+	mi := &file_follower_follower_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileUsersRequest) ProtoMessage() {}
+
+func (x *ReconcileUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileUsersRequest.ProtoReflect.Descriptor instead.
+func (*ReconcileUsersRequest) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ReconcileUsersRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ReconcileUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Checked       int64                  `protobuf:"varint,1,opt,name=checked,proto3" json:"checked,omitempty"`
+	Removed       []string               `protobuf:"bytes,2,rep,name=removed,proto3" json:"removed,omitempty"`
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileUsersResponse) Reset() {
+	*x = ReconcileUsersResponse{}
+	mi := &file_follower_follower_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileUsersResponse) ProtoMessage() {}
+
+func (x *ReconcileUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileUsersResponse.ProtoReflect.Descriptor instead.
+func (*ReconcileUsersResponse) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ReconcileUsersResponse) GetChecked() int64 {
+	if x != nil {
+		return x.Checked
+	}
+	return 0
+}
+
+func (x *ReconcileUsersResponse) GetRemoved() []string {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+func (x *ReconcileUsersResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ExportGraphRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// csv (podrazumevano) ili ndjson
+	Format string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	// next_cursor iz prethodne stranice, prazno za prvu
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// podrazumevano 1000, najvise 10000
+	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportGraphRequest) Reset() {
+	*x = ExportGraphRequest{}
+	mi := &file_follower_follower_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportGraphRequest) ProtoMessage() {}
+
+func (x *ExportGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportGraphRequest.ProtoReflect.Descriptor instead.
+func (*ExportGraphRequest) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ExportGraphRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportGraphRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ExportGraphRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ExportGraphResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Format string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	// jedna FOLLOWS veza po redu; CSV pocinje zaglavljem follower,followee
+	Data  string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Count int64  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// prazno kada nema vise veza
+	NextCursor    string `protobuf:"bytes,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportGraphResponse) Reset() {
+	*x = ExportGraphResponse{}
+	mi := &file_follower_follower_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportGraphResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportGraphResponse) ProtoMessage() {}
+
+func (x *ExportGraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportGraphResponse.ProtoReflect.Descriptor instead.
+func (*ExportGraphResponse) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ExportGraphResponse) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportGraphResponse) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *ExportGraphResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ExportGraphResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type ImportGraphRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// csv (podrazumevano) ili ndjson, u istom obliku kao ExportGraph
+	Format string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	Data   string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// podrazumevano 500, najvise 5000
+	BatchSize     int32 `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportGraphRequest) Reset() {
+	*x = ImportGraphRequest{}
+	mi := &file_follower_follower_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportGraphRequest) ProtoMessage() {}
+
+func (x *ImportGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportGraphRequest.ProtoReflect.Descriptor instead.
+func (*ImportGraphRequest) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ImportGraphRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportGraphRequest) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *ImportGraphRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+type ImportGraphResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// broj ispravnih veza koje su obradjene
+	Processed int64 `protobuf:"varint,1,opt,name=processed,proto3" json:"processed,omitempty"`
+	// broj veza koje ranije nisu postojale
+	Created int64 `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	// neispravni redovi, veze korisnika sa samim sobom i blokirani parovi
+	Skipped int64 `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// opis prvih gresaka u parsiranju
+	Errors        []string `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportGraphResponse) Reset() {
+	*x = ImportGraphResponse{}
+	mi := &file_follower_follower_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportGraphResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportGraphResponse) ProtoMessage() {}
+
+func (x *ImportGraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportGraphResponse.ProtoReflect.Descriptor instead.
+func (*ImportGraphResponse) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ImportGraphResponse) GetProcessed() int64 {
+	if x != nil {
+		return x.Processed
+	}
+	return 0
+}
+
+func (x *ImportGraphResponse) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportGraphResponse) GetSkipped() int64 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *ImportGraphResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type RenameUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	NewUsername   string                 `protobuf:"bytes,2,opt,name=new_username,json=newUsername,proto3" json:"new_username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameUserRequest) Reset() {
+	*x = RenameUserRequest{}
+	mi := &file_follower_follower_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameUserRequest) ProtoMessage() {}
+
+func (x *RenameUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameUserRequest.ProtoReflect.Descriptor instead.
+func (*RenameUserRequest) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RenameUserRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *RenameUserRequest) GetNewUsername() string {
+	if x != nil {
+		return x.NewUsername
+	}
+	return ""
+}
+
+type RenameUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameUserResponse) Reset() {
+	*x = RenameUserResponse{}
+	mi := &file_follower_follower_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameUserResponse) ProtoMessage() {}
+
+func (x *RenameUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameUserResponse.ProtoReflect.Descriptor instead.
+func (*RenameUserResponse) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RenameUserResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type DeleteUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserRequest) Reset() {
+	*x = DeleteUserRequest{}
+	mi := &file_follower_follower_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserRequest) ProtoMessage() {}
+
+func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DeleteUserRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type DeleteUserResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Status               string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	RemovedRelationships int64                  `protobuf:"varint,2,opt,name=removed_relationships,json=removedRelationships,proto3" json:"removed_relationships,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DeleteUserResponse) Reset() {
+	*x = DeleteUserResponse{}
+	mi := &file_follower_follower_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserResponse) ProtoMessage() {}
+
+func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follower_follower_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
+	return file_follower_follower_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *DeleteUserResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeleteUserResponse) GetRemovedRelationships() int64 {
+	if x != nil {
+		return x.RemovedRelationships
+	}
+	return 0
+}
+
+var File_follower_follower_proto protoreflect.FileDescriptor
+
+const file_follower_follower_proto_rawDesc = "" +
+	"\n" +
+	"\x17follower/follower.proto\x12\bfollower\x1a\x1cgoogle/api/annotations.proto\x1a\x15google/api/http.proto\"\x1f\n" +
+	"\rFollowRequest\x12\x0e\n" +
+	"\x02to\x18\x01 \x01(\tR\x02to\"B\n" +
+	"\x0eFollowResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
+	"\apending\x18\x02 \x01(\bR\apending\"!\n" +
+	"\x0fUnfollowRequest\x12\x0e\n" +
+	"\x02to\x18\x01 \x01(\tR\x02to\"*\n" +
+	"\x10UnfollowResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"1\n" +
+	"\x13GetFollowingRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"4\n" +
+	"\x14GetFollowingResponse\x12\x1c\n" +
+	"\tfollowing\x18\x01 \x03(\tR\tfollowing\"1\n" +
+	"\x13GetFollowersRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"4\n" +
+	"\x14GetFollowersResponse\x12\x1c\n" +
+	"\tfollowers\x18\x01 \x03(\tR\tfollowers\"4\n" +
+	"\x16GetFollowCountsRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"q\n" +
+	"\x17GetFollowCountsResponse\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1c\n" +
+	"\tfollowers\x18\x02 \x01(\x03R\tfollowers\x12\x1c\n" +
+	"\tfollowing\x18\x03 \x01(\x03R\tfollowing\"L\n" +
+	"\x12IsFollowingRequest\x12\x1a\n" +
+	"\bfollower\x18\x01 \x01(\tR\bfollower\x12\x1a\n" +
+	"\bfollowee\x18\x02 \x01(\tR\bfollowee\"3\n" +
+	"\x13IsFollowingResponse\x12\x1c\n" +
+	"\tfollowing\x18\x01 \x01(\bR\tfollowing\"<\n" +
+	"\x1cGetRelationshipStatusRequest\x12\x1c\n" +
+	"\tusernames\x18\x01 \x03(\tR\tusernames\"Y\n" +
+	"\x1dGetRelationshipStatusResponse\x128\n" +
+	"\bstatuses\x18\x01 \x03(\v2\x1c.follower.RelationshipStatusR\bstatuses\"\xa7\x01\n" +
+	"\x12RelationshipStatus\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1c\n" +
+	"\tfollowing\x18\x02 \x01(\bR\tfollowing\x12\x1f\n" +
+	"\vfollowed_by\x18\x03 \x01(\bR\n" +
+	"followedBy\x12\x1c\n" +
+	"\trequested\x18\x04 \x01(\bR\trequested\x12\x18\n" +
+	"\ablocked\x18\x05 \x01(\bR\ablocked\"M\n" +
+	"\x19GetMutualFollowersRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x14\n" +
+	"\x05other\x18\x02 \x01(\tR\x05other\"]\n" +
+	"\x1aGetMutualFollowersResponse\x12)\n" +
+	"\x10mutual_followers\x18\x01 \x03(\tR\x0fmutualFollowers\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"D\n" +
+	"\x10RecommendRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x1a\n" +
+	"\bstrategy\x18\x02 \x01(\tR\bstrategy\"R\n" +
+	"\x11RecommendResponse\x12=\n" +
+	"\x11recommended_users\x18\x01 \x03(\v2\x10.follower.RecDTOR\x10recommendedUsers\"n\n" +
+	"\x06RecDTO\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x18\n" +
+	"\amutuals\x18\x02 \x01(\x03R\amutuals\x12\x18\n" +
+	"\areasons\x18\x03 \x03(\tR\areasons\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x01R\x05score\":\n" +
+	"\x1cDismissRecommendationRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"7\n" +
+	"\x1dDismissRecommendationResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"4\n" +
+	"\x18SetAccountPrivacyRequest\x12\x18\n" +
+	"\aprivate\x18\x01 \x01(\bR\aprivate\"b\n" +
+	"\x19SetAccountPrivacyResponse\x12\x18\n" +
+	"\aprivate\x18\x01 \x01(\bR\aprivate\x12+\n" +
+	"\x11approved_requests\x18\x02 \x01(\x03R\x10approvedRequests\"\x1a\n" +
+	"\x18GetFollowRequestsRequest\"S\n" +
+	"\x19GetFollowRequestsResponse\x126\n" +
+	"\brequests\x18\x01 \x03(\v2\x1a.follower.FollowRequestDTOR\brequests\"Q\n" +
+	"\x10FollowRequestDTO\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12!\n" +
+	"\frequested_at\x18\x02 \x01(\tR\vrequestedAt\"1\n" +
+	"\x1bApproveFollowRequestRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\"6\n" +
+	"\x1cApproveFollowRequestResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"0\n" +
+	"\x1aRejectFollowRequestRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\"5\n" +
+	"\x1bRejectFollowRequestResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"*\n" +
+	"\fBlockRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"'\n" +
+	"\rBlockResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\",\n" +
+	"\x0eUnblockRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\")\n" +
+	"\x0fUnblockResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"\x13\n" +
+	"\x11GetBlockedRequest\".\n" +
+	"\x12GetBlockedResponse\x12\x18\n" +
+	"\ablocked\x18\x01 \x03(\tR\ablocked\")\n" +
+	"\vMuteRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"&\n" +
+	"\fMuteResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"+\n" +
+	"\rUnmuteRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"(\n" +
+	"\x0eUnmuteResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"\x11\n" +
+	"\x0fGetMutedRequest\"(\n" +
+	"\x10GetMutedResponse\x12\x14\n" +
+	"\x05muted\x18\x01 \x03(\tR\x05muted\"4\n" +
+	"\x16GetRestrictionsRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"h\n" +
+	"\x17GetRestrictionsResponse\x12\x18\n" +
+	"\ablocked\x18\x01 \x03(\tR\ablocked\x12\x1d\n" +
+	"\n" +
+	"blocked_by\x18\x02 \x03(\tR\tblockedBy\x12\x14\n" +
+	"\x05muted\x18\x03 \x03(\tR\x05muted\"0\n" +
+	"\x15ReconcileUsersRequest\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\"e\n" +
+	"\x16ReconcileUsersResponse\x12\x18\n" +
+	"\achecked\x18\x01 \x01(\x03R\achecked\x12\x18\n" +
+	"\aremoved\x18\x02 \x03(\tR\aremoved\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"Z\n" +
+	"\x12ExportGraphRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"x\n" +
+	"\x13ExportGraphResponse\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\tR\x04data\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\x12\x1f\n" +
+	"\vnext_cursor\x18\x04 \x01(\tR\n" +
+	"nextCursor\"_\n" +
+	"\x12ImportGraphRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\tR\x04data\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x03 \x01(\x05R\tbatchSize\"\x7f\n" +
+	"\x13ImportGraphResponse\x12\x1c\n" +
+	"\tprocessed\x18\x01 \x01(\x03R\tprocessed\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\x03R\acreated\x12\x18\n" +
+	"\askipped\x18\x03 \x01(\x03R\askipped\x12\x16\n" +
+	"\x06errors\x18\x04 \x03(\tR\x06errors\"R\n" +
+	"\x11RenameUserRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12!\n" +
+	"\fnew_username\x18\x02 \x01(\tR\vnewUsername\",\n" +
+	"\x12RenameUserResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"/\n" +
+	"\x11DeleteUserRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"a\n" +
+	"\x12DeleteUserResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x123\n" +
+	"\x15removed_relationships\x18\x02 \x01(\x03R\x14removedRelationships2\x96\x17\n" +
+	"\x0fFollowerService\x12S\n" +
+	"\x06Follow\x12\x17.follower.FollowRequest\x1a\x18.follower.FollowResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/api/follow\x12[\n" +
+	"\bUnfollow\x12\x19.follower.UnfollowRequest\x1a\x1a.follower.UnfollowResponse\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/api/follow/{to}\x12p\n" +
+	"\fGetFollowing\x12\x1d.follower.GetFollowingRequest\x1a\x1e.follower.GetFollowingResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/following/{username}\x12p\n" +
+	"\fGetFollowers\x12\x1d.follower.GetFollowersRequest\x1a\x1e.follower.GetFollowersResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/followers/{username}\x12}\n" +
+	"\x0fGetFollowCounts\x12 .follower.GetFollowCountsRequest\x1a!.follower.GetFollowCountsResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/follow-counts/{username}\x12{\n" +
+	"\vIsFollowing\x12\x1c.follower.IsFollowingRequest\x1a\x1d.follower.IsFollowingResponse\"/\x82\xd3\xe4\x93\x02)\x12'/api/is-following/{follower}/{followee}\x12\x8e\x01\n" +
+	"\x15GetRelationshipStatus\x12&.follower.GetRelationshipStatusRequest\x1a'.follower.GetRelationshipStatusResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/relationships/status\x12\x91\x01\n" +
+	"\x12GetMutualFollowers\x12#.follower.GetMutualFollowersRequest\x1a$.follower.GetMutualFollowersResponse\"0\x82\xd3\xe4\x93\x02*\x12(/api/mutual-followers/{username}/{other}\x12\\\n" +
+	"\tRecommend\x12\x1a.follower.RecommendRequest\x1a\x1b.follower.RecommendResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/api/recommend\x12\x8b\x01\n" +
+	"\x15DismissRecommendation\x12&.follower.DismissRecommendationRequest\x1a'.follower.DismissRecommendationResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/recommend/dismiss\x12}\n" +
+	"\x11SetAccountPrivacy\x12\".follower.SetAccountPrivacyRequest\x1a#.follower.SetAccountPrivacyResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\x1a\x14/api/account/privacy\x12z\n" +
+	"\x11GetFollowRequests\x12\".follower.GetFollowRequestsRequest\x1a#.follower.GetFollowRequestsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/follow-requests\x12\x92\x01\n" +
+	"\x14ApproveFollowRequest\x12%.follower.ApproveFollowRequestRequest\x1a&.follower.ApproveFollowRequestResponse\"+\x82\xd3\xe4\x93\x02%\"#/api/follow-requests/{from}/approve\x12\x87\x01\n" +
+	"\x13RejectFollowRequest\x12$.follower.RejectFollowRequestRequest\x1a%.follower.RejectFollowRequestResponse\"#\x82\xd3\xe4\x93\x02\x1d*\x1b/api/follow-requests/{from}\x12P\n" +
+	"\x05Block\x12\x16.follower.BlockRequest\x1a\x17.follower.BlockResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/api/blocks\x12^\n" +
+	"\aUnblock\x12\x18.follower.UnblockRequest\x1a\x19.follower.UnblockResponse\"\x1e\x82\xd3\xe4\x93\x02\x18*\x16/api/blocks/{username}\x12\\\n" +
+	"\n" +
+	"GetBlocked\x12\x1b.follower.GetBlockedRequest\x1a\x1c.follower.GetBlockedResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/api/blocks\x12L\n" +
+	"\x04Mute\x12\x15.follower.MuteRequest\x1a\x16.follower.MuteResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/api/mutes\x12Z\n" +
+	"\x06Unmute\x12\x17.follower.UnmuteRequest\x1a\x18.follower.UnmuteResponse\"\x1d\x82\xd3\xe4\x93\x02\x17*\x15/api/mutes/{username}\x12U\n" +
+	"\bGetMuted\x12\x19.follower.GetMutedRequest\x1a\x1a.follower.GetMutedResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/api/mutes\x12}\n" +
+	"\x0eReconcileUsers\x12\x1f.follower.ReconcileUsersRequest\x1a .follower.ReconcileUsersResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/admin/follower/reconcile\x12m\n" +
+	"\vExportGraph\x12\x1c.follower.ExportGraphRequest\x1a\x1d.follower.ExportGraphResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/admin/follower/graph\x12w\n" +
+	"\vImportGraph\x12\x1c.follower.ImportGraphRequest\x1a\x1d.follower.ImportGraphResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/admin/follower/graph/import\x12\x7f\n" +
+	"\n" +
+	"RenameUser\x12\x1b.follower.RenameUserRequest\x1a\x1c.follower.RenameUserResponse\"6\x82\xd3\xe4\x93\x020:\x01*\"+/api/admin/follower/users/{username}/rename\x12u\n" +
+	"\n" +
+	"DeleteUser\x12\x1b.follower.DeleteUserRequest\x1a\x1c.follower.DeleteUserResponse\",\x82\xd3\xe4\x93\x02&*$/api/admin/follower/users/{username}\x12V\n" +
+	"\x0fGetRestrictions\x12 .follower.GetRestrictionsRequest\x1a!.follower.GetRestrictionsResponseB'Z%soa-team-5/api-gateway/proto/followerb\x06proto3"
 
 var (
 	file_follower_follower_proto_rawDescOnce sync.Once
@@ -552,37 +2891,125 @@ func file_follower_follower_proto_rawDescGZIP() []byte {
 	return file_follower_follower_proto_rawDescData
 }
 
-var file_follower_follower_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_follower_follower_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
 var file_follower_follower_proto_goTypes = []any{
-	(*FollowRequest)(nil),        // 0: follower.FollowRequest
-	(*FollowResponse)(nil),       // 1: follower.FollowResponse
-	(*UnfollowRequest)(nil),      // 2: follower.UnfollowRequest
-	(*UnfollowResponse)(nil),     // 3: follower.UnfollowResponse
-	(*GetFollowingRequest)(nil),  // 4: follower.GetFollowingRequest
-	(*GetFollowingResponse)(nil), // 5: follower.GetFollowingResponse
-	(*GetFollowersRequest)(nil),  // 6: follower.GetFollowersRequest
-	(*GetFollowersResponse)(nil), // 7: follower.GetFollowersResponse
-	(*RecommendRequest)(nil),     // 8: follower.RecommendRequest
-	(*RecommendResponse)(nil),    // 9: follower.RecommendResponse
-	(*RecDTO)(nil),               // 10: follower.RecDTO
+	(*FollowRequest)(nil),                 // 0: follower.FollowRequest
+	(*FollowResponse)(nil),                // 1: follower.FollowResponse
+	(*UnfollowRequest)(nil),               // 2: follower.UnfollowRequest
+	(*UnfollowResponse)(nil),              // 3: follower.UnfollowResponse
+	(*GetFollowingRequest)(nil),           // 4: follower.GetFollowingRequest
+	(*GetFollowingResponse)(nil),          // 5: follower.GetFollowingResponse
+	(*GetFollowersRequest)(nil),           // 6: follower.GetFollowersRequest
+	(*GetFollowersResponse)(nil),          // 7: follower.GetFollowersResponse
+	(*GetFollowCountsRequest)(nil),        // 8: follower.GetFollowCountsRequest
+	(*GetFollowCountsResponse)(nil),       // 9: follower.GetFollowCountsResponse
+	(*IsFollowingRequest)(nil),            // 10: follower.IsFollowingRequest
+	(*IsFollowingResponse)(nil),           // 11: follower.IsFollowingResponse
+	(*GetRelationshipStatusRequest)(nil),  // 12: follower.GetRelationshipStatusRequest
+	(*GetRelationshipStatusResponse)(nil), // 13: follower.GetRelationshipStatusResponse
+	(*RelationshipStatus)(nil),            // 14: follower.RelationshipStatus
+	(*GetMutualFollowersRequest)(nil),     // 15: follower.GetMutualFollowersRequest
+	(*GetMutualFollowersResponse)(nil),    // 16: follower.GetMutualFollowersResponse
+	(*RecommendRequest)(nil),              // 17: follower.RecommendRequest
+	(*RecommendResponse)(nil),             // 18: follower.RecommendResponse
+	(*RecDTO)(nil),                        // 19: follower.RecDTO
+	(*DismissRecommendationRequest)(nil),  // 20: follower.DismissRecommendationRequest
+	(*DismissRecommendationResponse)(nil), // 21: follower.DismissRecommendationResponse
+	(*SetAccountPrivacyRequest)(nil),      // 22: follower.SetAccountPrivacyRequest
+	(*SetAccountPrivacyResponse)(nil),     // 23: follower.SetAccountPrivacyResponse
+	(*GetFollowRequestsRequest)(nil),      // 24: follower.GetFollowRequestsRequest
+	(*GetFollowRequestsResponse)(nil),     // 25: follower.GetFollowRequestsResponse
+	(*FollowRequestDTO)(nil),              // 26: follower.FollowRequestDTO
+	(*ApproveFollowRequestRequest)(nil),   // 27: follower.ApproveFollowRequestRequest
+	(*ApproveFollowRequestResponse)(nil),  // 28: follower.ApproveFollowRequestResponse
+	(*RejectFollowRequestRequest)(nil),    // 29: follower.RejectFollowRequestRequest
+	(*RejectFollowRequestResponse)(nil),   // 30: follower.RejectFollowRequestResponse
+	(*BlockRequest)(nil),                  // 31: follower.BlockRequest
+	(*BlockResponse)(nil),                 // 32: follower.BlockResponse
+	(*UnblockRequest)(nil),                // 33: follower.UnblockRequest
+	(*UnblockResponse)(nil),               // 34: follower.UnblockResponse
+	(*GetBlockedRequest)(nil),             // 35: follower.GetBlockedRequest
+	(*GetBlockedResponse)(nil),            // 36: follower.GetBlockedResponse
+	(*MuteRequest)(nil),                   // 37: follower.MuteRequest
+	(*MuteResponse)(nil),                  // 38: follower.MuteResponse
+	(*UnmuteRequest)(nil),                 // 39: follower.UnmuteRequest
+	(*UnmuteResponse)(nil),                // 40: follower.UnmuteResponse
+	(*GetMutedRequest)(nil),               // 41: follower.GetMutedRequest
+	(*GetMutedResponse)(nil),              // 42: follower.GetMutedResponse
+	(*GetRestrictionsRequest)(nil),        // 43: follower.GetRestrictionsRequest
+	(*GetRestrictionsResponse)(nil),       // 44: follower.GetRestrictionsResponse
+	(*ReconcileUsersRequest)(nil),         // 45: follower.ReconcileUsersRequest
+	(*ReconcileUsersResponse)(nil),        // 46: follower.ReconcileUsersResponse
+	(*ExportGraphRequest)(nil),            // 47: follower.ExportGraphRequest
+	(*ExportGraphResponse)(nil),           // 48: follower.ExportGraphResponse
+	(*ImportGraphRequest)(nil),            // 49: follower.ImportGraphRequest
+	(*ImportGraphResponse)(nil),           // 50: follower.ImportGraphResponse
+	(*RenameUserRequest)(nil),             // 51: follower.RenameUserRequest
+	(*RenameUserResponse)(nil),            // 52: follower.RenameUserResponse
+	(*DeleteUserRequest)(nil),             // 53: follower.DeleteUserRequest
+	(*DeleteUserResponse)(nil),            // 54: follower.DeleteUserResponse
 }
 var file_follower_follower_proto_depIdxs = []int32{
-	10, // 0: follower.RecommendResponse.recommended_users:type_name -> follower.RecDTO
-	0,  // 1: follower.FollowerService.Follow:input_type -> follower.FollowRequest
-	2,  // 2: follower.FollowerService.Unfollow:input_type -> follower.UnfollowRequest
-	4,  // 3: follower.FollowerService.GetFollowing:input_type -> follower.GetFollowingRequest
-	6,  // 4: follower.FollowerService.GetFollowers:input_type -> follower.GetFollowersRequest
-	8,  // 5: follower.FollowerService.Recommend:input_type -> follower.RecommendRequest
-	1,  // 6: follower.FollowerService.Follow:output_type -> follower.FollowResponse
-	3,  // 7: follower.FollowerService.Unfollow:output_type -> follower.UnfollowResponse
-	5,  // 8: follower.FollowerService.GetFollowing:output_type -> follower.GetFollowingResponse
-	7,  // 9: follower.FollowerService.GetFollowers:output_type -> follower.GetFollowersResponse
-	9,  // 10: follower.FollowerService.Recommend:output_type -> follower.RecommendResponse
-	6,  // [6:11] is the sub-list for method output_type
-	1,  // [1:6] is the sub-list for method input_type
-	1,  // [1:1] is the sub-list for extension type_name
-	1,  // [1:1] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
+	14, // 0: follower.GetRelationshipStatusResponse.statuses:type_name -> follower.RelationshipStatus
+	19, // 1: follower.RecommendResponse.recommended_users:type_name -> follower.RecDTO
+	26, // 2: follower.GetFollowRequestsResponse.requests:type_name -> follower.FollowRequestDTO
+	0,  // 3: follower.FollowerService.Follow:input_type -> follower.FollowRequest
+	2,  // 4: follower.FollowerService.Unfollow:input_type -> follower.UnfollowRequest
+	4,  // 5: follower.FollowerService.GetFollowing:input_type -> follower.GetFollowingRequest
+	6,  // 6: follower.FollowerService.GetFollowers:input_type -> follower.GetFollowersRequest
+	8,  // 7: follower.FollowerService.GetFollowCounts:input_type -> follower.GetFollowCountsRequest
+	10, // 8: follower.FollowerService.IsFollowing:input_type -> follower.IsFollowingRequest
+	12, // 9: follower.FollowerService.GetRelationshipStatus:input_type -> follower.GetRelationshipStatusRequest
+	15, // 10: follower.FollowerService.GetMutualFollowers:input_type -> follower.GetMutualFollowersRequest
+	17, // 11: follower.FollowerService.Recommend:input_type -> follower.RecommendRequest
+	20, // 12: follower.FollowerService.DismissRecommendation:input_type -> follower.DismissRecommendationRequest
+	22, // 13: follower.FollowerService.SetAccountPrivacy:input_type -> follower.SetAccountPrivacyRequest
+	24, // 14: follower.FollowerService.GetFollowRequests:input_type -> follower.GetFollowRequestsRequest
+	27, // 15: follower.FollowerService.ApproveFollowRequest:input_type -> follower.ApproveFollowRequestRequest
+	29, // 16: follower.FollowerService.RejectFollowRequest:input_type -> follower.RejectFollowRequestRequest
+	31, // 17: follower.FollowerService.Block:input_type -> follower.BlockRequest
+	33, // 18: follower.FollowerService.Unblock:input_type -> follower.UnblockRequest
+	35, // 19: follower.FollowerService.GetBlocked:input_type -> follower.GetBlockedRequest
+	37, // 20: follower.FollowerService.Mute:input_type -> follower.MuteRequest
+	39, // 21: follower.FollowerService.Unmute:input_type -> follower.UnmuteRequest
+	41, // 22: follower.FollowerService.GetMuted:input_type -> follower.GetMutedRequest
+	45, // 23: follower.FollowerService.ReconcileUsers:input_type -> follower.ReconcileUsersRequest
+	47, // 24: follower.FollowerService.ExportGraph:input_type -> follower.ExportGraphRequest
+	49, // 25: follower.FollowerService.ImportGraph:input_type -> follower.ImportGraphRequest
+	51, // 26: follower.FollowerService.RenameUser:input_type -> follower.RenameUserRequest
+	53, // 27: follower.FollowerService.DeleteUser:input_type -> follower.DeleteUserRequest
+	43, // 28: follower.FollowerService.GetRestrictions:input_type -> follower.GetRestrictionsRequest
+	1,  // 29: follower.FollowerService.Follow:output_type -> follower.FollowResponse
+	3,  // 30: follower.FollowerService.Unfollow:output_type -> follower.UnfollowResponse
+	5,  // 31: follower.FollowerService.GetFollowing:output_type -> follower.GetFollowingResponse
+	7,  // 32: follower.FollowerService.GetFollowers:output_type -> follower.GetFollowersResponse
+	9,  // 33: follower.FollowerService.GetFollowCounts:output_type -> follower.GetFollowCountsResponse
+	11, // 34: follower.FollowerService.IsFollowing:output_type -> follower.IsFollowingResponse
+	13, // 35: follower.FollowerService.GetRelationshipStatus:output_type -> follower.GetRelationshipStatusResponse
+	16, // 36: follower.FollowerService.GetMutualFollowers:output_type -> follower.GetMutualFollowersResponse
+	18, // 37: follower.FollowerService.Recommend:output_type -> follower.RecommendResponse
+	21, // 38: follower.FollowerService.DismissRecommendation:output_type -> follower.DismissRecommendationResponse
+	23, // 39: follower.FollowerService.SetAccountPrivacy:output_type -> follower.SetAccountPrivacyResponse
+	25, // 40: follower.FollowerService.GetFollowRequests:output_type -> follower.GetFollowRequestsResponse
+	28, // 41: follower.FollowerService.ApproveFollowRequest:output_type -> follower.ApproveFollowRequestResponse
+	30, // 42: follower.FollowerService.RejectFollowRequest:output_type -> follower.RejectFollowRequestResponse
+	32, // 43: follower.FollowerService.Block:output_type -> follower.BlockResponse
+	34, // 44: follower.FollowerService.Unblock:output_type -> follower.UnblockResponse
+	36, // 45: follower.FollowerService.GetBlocked:output_type -> follower.GetBlockedResponse
+	38, // 46: follower.FollowerService.Mute:output_type -> follower.MuteResponse
+	40, // 47: follower.FollowerService.Unmute:output_type -> follower.UnmuteResponse
+	42, // 48: follower.FollowerService.GetMuted:output_type -> follower.GetMutedResponse
+	46, // 49: follower.FollowerService.ReconcileUsers:output_type -> follower.ReconcileUsersResponse
+	48, // 50: follower.FollowerService.ExportGraph:output_type -> follower.ExportGraphResponse
+	50, // 51: follower.FollowerService.ImportGraph:output_type -> follower.ImportGraphResponse
+	52, // 52: follower.FollowerService.RenameUser:output_type -> follower.RenameUserResponse
+	54, // 53: follower.FollowerService.DeleteUser:output_type -> follower.DeleteUserResponse
+	44, // 54: follower.FollowerService.GetRestrictions:output_type -> follower.GetRestrictionsResponse
+	29, // [29:55] is the sub-list for method output_type
+	3,  // [3:29] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_follower_follower_proto_init() }
@@ -596,7 +3023,7 @@ func file_follower_follower_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_follower_follower_proto_rawDesc), len(file_follower_follower_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   55,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
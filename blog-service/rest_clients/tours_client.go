@@ -0,0 +1,61 @@
+package rest_clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+type ToursClient struct {
+	BaseURL string
+	Client  *http.Client
+}
+
+// TourSummary odgovara sazetku ture koji vraca tours-service.
+type TourSummary struct {
+	ID            string  `json:"id"`
+	Name          string  `json:"name"`
+	Difficulty    string  `json:"difficulty"`
+	AverageRating float64 `json:"averageRating"`
+	RatingCount   int     `json:"ratingCount"`
+}
+
+func NewToursClient(baseURL string) *ToursClient {
+	return &ToursClient{
+		BaseURL: baseURL,
+		Client:  &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+// GetTourSummaries vraca sazetke objavljenih i arhiviranih tura. Ture koje
+// ne postoje ili jos nisu objavljene nema u rezultatu.
+func (tc *ToursClient) GetTourSummaries(ctx context.Context, ids []string) ([]TourSummary, error) {
+	if len(ids) == 0 {
+		return []TourSummary{}, nil
+	}
+
+	reqURL := fmt.Sprintf("%s/api/tours/summaries?ids=%s", tc.BaseURL, url.QueryEscape(strings.Join(ids, ",")))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := tc.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("tours-service returned status %d", resp.StatusCode)
+	}
+
+	var summaries []TourSummary
+	if err := json.NewDecoder(resp.Body).Decode(&summaries); err != nil {
+		return nil, err
+	}
+	return summaries, nil
+}
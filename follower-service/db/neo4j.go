@@ -44,3 +44,22 @@ func ConnectNeo4j(uri, user, pass string) {
 
 	log.Fatal("[ERROR] Could not connect to Neo4j after multiple attempts:", err)
 }
+
+// EnsureSchema pravi ogranicenje jedinstvenosti korisnickog imena, koje
+// ubrzava MERGE po username-u i sprecava duplikate pri uvozu i preimenovanju,
+// i indeks po kome relay cita outbox. Ako u grafu vec postoje duplikati,
+// servis radi dalje uz upozorenje.
+func EnsureSchema(ctx context.Context) {
+	_, err := neo4j.ExecuteQuery(ctx, Driver,
+		"CREATE CONSTRAINT user_username IF NOT EXISTS FOR (u:User) REQUIRE u.username IS UNIQUE",
+		nil, neo4j.EagerResultTransformer)
+	if err != nil {
+		log.Printf("[WARN] Could not create User.username constraint: %v\n", err)
+	}
+	_, err = neo4j.ExecuteQuery(ctx, Driver,
+		"CREATE INDEX outbox_event_occurred_at IF NOT EXISTS FOR (e:OutboxEvent) ON (e.occurredAt)",
+		nil, neo4j.EagerResultTransformer)
+	if err != nil {
+		log.Printf("[WARN] Could not create OutboxEvent index: %v\n", err)
+	}
+}
@@ -0,0 +1,234 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"follower-service/db"
+	pb "follower-service/proto/follower"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Block blokira korisnika i uklanja pracenje i zahteve za pracenje u oba
+// smera. Dok blokada traje, nijedan od njih ne moze da prati drugog.
+func (s *FollowerServer) Block(ctx context.Context, req *pb.BlockRequest) (*pb.BlockResponse, error) {
+	username, _, _, err := GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Username == "" || req.Username == username {
+		return nil, status.Error(codes.InvalidArgument, "cannot block yourself")
+	}
+	if err := checkFollowTarget(ctx, req.Username); err != nil {
+		return nil, err
+	}
+
+	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+
+	_, err = session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		q := `
+		MERGE (me:User {username:$u})
+		MERGE (other:User {username:$other})
+		MERGE (me)-[:` + relB + `]->(other)
+		WITH me, other
+		OPTIONAL MATCH (me)-[r:` + relF + `|` + relReq + `]-(other)
+		WITH collect(CASE WHEN type(r) = '` + relF + `' THEN startNode(r).username END) AS unfollowers,
+			collect(r) AS rels
+		FOREACH (r IN rels | DELETE r)
+		RETURN unfollowers`
+		res, err := tx.Run(ctx, q, map[string]any{"u": username, "other": req.Username})
+		if err != nil {
+			return nil, err
+		}
+		if !res.Next(ctx) {
+			return nil, errors.New("no result")
+		}
+		unfollowers, ok := res.Record().Values[0].([]any)
+		if !ok {
+			return nil, errors.New("invalid data format")
+		}
+		for _, follower := range unfollowers {
+			follower := follower.(string)
+			followee := req.Username
+			if follower == req.Username {
+				followee = username
+			}
+			if err := addFollowEvent(ctx, tx, SubjectUnfollowed, follower, followee); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.BlockResponse{
+		Status: "blocked successfully",
+	}, nil
+}
+
+func (s *FollowerServer) Unblock(ctx context.Context, req *pb.UnblockRequest) (*pb.UnblockResponse, error) {
+	username, _, _, err := GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := deleteRelationship(ctx, relB, username, req.Username); err != nil {
+		return nil, err
+	}
+	return &pb.UnblockResponse{
+		Status: "unblocked successfully",
+	}, nil
+}
+
+func (s *FollowerServer) GetBlocked(ctx context.Context, req *pb.GetBlockedRequest) (*pb.GetBlockedResponse, error) {
+	username, _, _, err := GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	blocked, err := listRelated(ctx, `MATCH (:User {username:$u})-[:`+relB+`]->(o:User) RETURN o.username AS u ORDER BY u`, username)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetBlockedResponse{
+		Blocked: blocked,
+	}, nil
+}
+
+// Mute skriva sadrzaj korisnika bez prekidanja pracenja.
+func (s *FollowerServer) Mute(ctx context.Context, req *pb.MuteRequest) (*pb.MuteResponse, error) {
+	username, _, _, err := GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Username == "" || req.Username == username {
+		return nil, status.Error(codes.InvalidArgument, "cannot mute yourself")
+	}
+	if err := checkFollowTarget(ctx, req.Username); err != nil {
+		return nil, err
+	}
+
+	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+
+	_, err = session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		q := `
+		MERGE (me:User {username:$u})
+		MERGE (other:User {username:$other})
+		MERGE (me)-[:` + relM + `]->(other)`
+		_, err := tx.Run(ctx, q, map[string]any{"u": username, "other": req.Username})
+		return nil, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.MuteResponse{
+		Status: "muted successfully",
+	}, nil
+}
+
+func (s *FollowerServer) Unmute(ctx context.Context, req *pb.UnmuteRequest) (*pb.UnmuteResponse, error) {
+	username, _, _, err := GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := deleteRelationship(ctx, relM, username, req.Username); err != nil {
+		return nil, err
+	}
+	return &pb.UnmuteResponse{
+		Status: "unmuted successfully",
+	}, nil
+}
+
+func (s *FollowerServer) GetMuted(ctx context.Context, req *pb.GetMutedRequest) (*pb.GetMutedResponse, error) {
+	username, _, _, err := GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	muted, err := listRelated(ctx, `MATCH (:User {username:$u})-[:`+relM+`]->(o:User) RETURN o.username AS u ORDER BY u`, username)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetMutedResponse{
+		Muted: muted,
+	}, nil
+}
+
+func (s *FollowerServer) GetRestrictions(ctx context.Context, req *pb.GetRestrictionsRequest) (*pb.GetRestrictionsResponse, error) {
+	blocked, err := listRelated(ctx, `MATCH (:User {username:$u})-[:`+relB+`]->(o:User) RETURN o.username AS u ORDER BY u`, req.Username)
+	if err != nil {
+		return nil, err
+	}
+	blockedBy, err := listRelated(ctx, `MATCH (o:User)-[:`+relB+`]->(:User {username:$u}) RETURN o.username AS u ORDER BY u`, req.Username)
+	if err != nil {
+		return nil, err
+	}
+	muted, err := listRelated(ctx, `MATCH (:User {username:$u})-[:`+relM+`]->(o:User) RETURN o.username AS u ORDER BY u`, req.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetRestrictionsResponse{
+		Blocked:   blocked,
+		BlockedBy: blockedBy,
+		Muted:     muted,
+	}, nil
+}
+
+// isBlocked proverava da li je bilo koji od dva korisnika blokirao drugog.
+func isBlocked(ctx context.Context, tx neo4j.ManagedTransaction, a, b string) (bool, error) {
+	q := `RETURN EXISTS { (:User {username:$a})-[:` + relB + `]-(:User {username:$b}) }`
+	res, err := tx.Run(ctx, q, map[string]any{"a": a, "b": b})
+	if err != nil {
+		return false, err
+	}
+	if !res.Next(ctx) {
+		return false, errors.New("no result")
+	}
+	blocked, _ := res.Record().Values[0].(bool)
+	return blocked, nil
+}
+
+func deleteRelationship(ctx context.Context, rel, from, to string) error {
+	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+
+	_, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		q := `MATCH (:User {username:$from})-[r:` + rel + `]->(:User {username:$to}) DELETE r`
+		_, err := tx.Run(ctx, q, map[string]any{"from": from, "to": to})
+		return nil, err
+	})
+	return err
+}
+
+// listRelated izvrsava upit koji vraca jednu kolonu sa korisnickim imenima.
+func listRelated(ctx context.Context, q, username string) ([]string, error) {
+	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+
+	data, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		res, err := tx.Run(ctx, q, map[string]any{"u": username})
+		if err != nil {
+			return nil, err
+		}
+		usernames := make([]string, 0)
+		for res.Next(ctx) {
+			usernames = append(usernames, res.Record().Values[0].(string))
+		}
+		return usernames, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	usernames, ok := data.([]string)
+	if !ok {
+		return nil, errors.New("invalid data format")
+	}
+	return usernames, nil
+}
@@ -0,0 +1,32 @@
+package handlers
+
+import (
+	"context"
+	"follower-service/outbox"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+const (
+	SubjectFollowed    = "follower_followed"
+	SubjectUnfollowed  = "follower_unfollowed"
+	SubjectUserRenamed = "follower_user_renamed"
+)
+
+// FollowEvent se objavljuje posle svake promene FOLLOWS veze, da bi drugi
+// servisi (npr. blog feed) mogli da azuriraju svoje podatke.
+type FollowEvent struct {
+	Follower string `json:"follower"`
+	Followee string `json:"followee"`
+}
+
+// UserRenamedEvent se objavljuje kada administrator promeni korisnicko ime u
+// grafu.
+type UserRenamedEvent struct {
+	OldUsername string `json:"oldUsername"`
+	NewUsername string `json:"newUsername"`
+}
+
+func addFollowEvent(ctx context.Context, tx neo4j.ManagedTransaction, subject, follower, followee string) error {
+	return outbox.Add(ctx, tx, subject, FollowEvent{Follower: follower, Followee: followee})
+}
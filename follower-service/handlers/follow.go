@@ -13,7 +13,15 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-const relF = "FOLLOWS"
+const (
+	relF   = "FOLLOWS"
+	relReq = "FOLLOW_REQUEST"
+	relB   = "BLOCKS"
+	relM   = "MUTES"
+	relD   = "DISMISSED"
+)
+
+var errBlocked = status.Error(codes.PermissionDenied, "cannot follow this user")
 
 type FollowerServer struct {
 	pb.UnimplementedFollowerServiceServer
@@ -35,20 +43,60 @@ func (s *FollowerServer) Follow(ctx context.Context, req *pb.FollowRequest) (*pb
 		return nil, errors.New("cannot follow yourself")
 	}
 
+	if err := checkFollowTarget(ctx, toUsername); err != nil {
+		return nil, err
+	}
+
 	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
 	defer session.Close(ctx)
 
-	_, err = session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+	// Privatnom nalogu se umesto FOLLOWS veze salje FOLLOW_REQUEST, osim ako
+	// ga korisnik vec prati
+	data, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		blocked, err := isBlocked(ctx, tx, fromUsername, toUsername)
+		if err != nil {
+			return nil, err
+		}
+		if blocked {
+			return nil, errBlocked
+		}
+
 		q := `
 		MERGE (a:User {username:$from})
 		MERGE (b:User {username:$to})
-		MERGE (a)-[:` + relF + `]->(b)`
-		_, err := tx.Run(ctx, q, map[string]any{"from": fromUsername, "to": toUsername})
-		return nil, err
+		WITH a, b, EXISTS { (a)-[:` + relF + `]->(b) } AS already
+		WITH a, b, already, coalesce(b.private, false) AND NOT already AS pending
+		FOREACH (_ IN CASE WHEN pending THEN [1] ELSE [] END |
+			MERGE (a)-[r:` + relReq + `]->(b)
+			ON CREATE SET r.requestedAt = datetime())
+		FOREACH (_ IN CASE WHEN pending THEN [] ELSE [1] END |
+			MERGE (a)-[:` + relF + `]->(b))
+		RETURN pending, already`
+		res, err := tx.Run(ctx, q, map[string]any{"from": fromUsername, "to": toUsername})
+		if err != nil {
+			return nil, err
+		}
+		if !res.Next(ctx) {
+			return nil, errors.New("no result")
+		}
+		pending, _ := res.Record().Values[0].(bool)
+		already, _ := res.Record().Values[1].(bool)
+		if !pending && !already {
+			if err := addFollowEvent(ctx, tx, SubjectFollowed, fromUsername, toUsername); err != nil {
+				return nil, err
+			}
+		}
+		return pending, nil
 	})
 	if err != nil {
 		return nil, err
 	}
+	if pending, _ := data.(bool); pending {
+		return &pb.FollowResponse{
+			Status:  "follow request sent",
+			Pending: true,
+		}, nil
+	}
 	return &pb.FollowResponse{
 		Status: "followed successfully",
 	}, nil
@@ -70,18 +118,23 @@ func (s *FollowerServer) Unfollow(ctx context.Context, req *pb.UnfollowRequest)
 	defer session.Close(ctx)
 
 	_, err = session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		// Otpracivanje povlaci i zahtev koji jos nije odobren
 		q := `
-		MATCH (a:User {username:$from})-[r:` + relF + `]->(b:User {username:$to})
+		MATCH (a:User {username:$from})-[r:` + relF + `|` + relReq + `]->(b:User {username:$to})
+		WITH r, type(r) = '` + relF + `' AS wasFollowing
 		DELETE r
-		RETURN COUNT(*)`
+		RETURN COUNT(CASE WHEN wasFollowing THEN 1 END)`
 		res, err := tx.Run(ctx, q, map[string]any{"from": fromUsername, "to": toUsername})
 		if err != nil {
 			return nil, err
 		}
-		if res.Next(ctx) {
-			return res.Record().Values[0], nil
+		if !res.Next(ctx) {
+			return nil, errors.New("no result")
+		}
+		if removed, _ := res.Record().Values[0].(int64); removed > 0 {
+			return nil, addFollowEvent(ctx, tx, SubjectUnfollowed, fromUsername, toUsername)
 		}
-		return nil, errors.New("no result")
+		return nil, nil
 	})
 	if err != nil {
 		return nil, err
@@ -152,48 +205,6 @@ func (s *FollowerServer) GetFollowers(ctx context.Context, req *pb.GetFollowersR
 	}, nil
 }
 
-func (s *FollowerServer) Recommend(ctx context.Context, req *pb.RecommendRequest) (*pb.RecommendResponse, error) {
-	username, _, _, err := GetClaimsFromContext(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	limit := 10
-
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
-	defer session.Close(ctx)
-
-	data, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
-		q := `
-		MATCH (me:User {username:$u})-[:` + relF + `]->(m:User)-[:` + relF + `]->(rec:User)
-		WHERE NOT (me)-[:` + relF + `]->(rec) AND me <> rec
-		RETURN rec.username AS username, COUNT(DISTINCT m) AS mutuals
-		ORDER BY mutuals DESC, username ASC
-		LIMIT $limit`
-		res, err := tx.Run(ctx, q, map[string]any{"u": username, "limit": limit})
-		if err != nil {
-			return nil, err
-		}
-		recs := make([]*pb.RecDTO, 0)
-		for res.Next(ctx) {
-			rec := &pb.RecDTO{Username: res.Record().Values[0].(string), Mutuals: res.Record().Values[1].(int64)}
-			recs = append(recs, rec)
-		}
-		return recs, nil
-	})
-	if err != nil {
-		return nil, err
-	}
-	recommendations, ok := data.([]*pb.RecDTO)
-	if !ok {
-		return nil, errors.New("invalid data format")
-	}
-
-	return &pb.RecommendResponse{
-		RecommendedUsers: recommendations,
-	}, nil
-}
-
 func GetClaimsFromContext(ctx context.Context) (string, string, string, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
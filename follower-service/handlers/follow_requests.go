@@ -0,0 +1,179 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"follower-service/db"
+	pb "follower-service/proto/follower"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetAccountPrivacy menja privatnost naloga trenutnog korisnika. Kada nalog
+// postane javan, svi zahtevi koji cekaju se automatski odobravaju.
+func (s *FollowerServer) SetAccountPrivacy(ctx context.Context, req *pb.SetAccountPrivacyRequest) (*pb.SetAccountPrivacyResponse, error) {
+	username, _, _, err := GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkFollowTarget(ctx, username); err != nil {
+		return nil, err
+	}
+
+	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+
+	data, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		_, err := tx.Run(ctx, `MERGE (u:User {username:$u}) SET u.private = $private`,
+			map[string]any{"u": username, "private": req.Private})
+		if err != nil {
+			return nil, err
+		}
+		approved := make([]string, 0)
+		if req.Private {
+			return approved, nil
+		}
+
+		q := `
+		MATCH (a:User)-[r:` + relReq + `]->(me:User {username:$u})
+		DELETE r
+		MERGE (a)-[:` + relF + `]->(me)
+		RETURN a.username`
+		res, err := tx.Run(ctx, q, map[string]any{"u": username})
+		if err != nil {
+			return nil, err
+		}
+		for res.Next(ctx) {
+			approved = append(approved, res.Record().Values[0].(string))
+		}
+		for _, follower := range approved {
+			if err := addFollowEvent(ctx, tx, SubjectFollowed, follower, username); err != nil {
+				return nil, err
+			}
+		}
+		return approved, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	approved, ok := data.([]string)
+	if !ok {
+		return nil, errors.New("invalid data format")
+	}
+
+	return &pb.SetAccountPrivacyResponse{
+		Private:          req.Private,
+		ApprovedRequests: int64(len(approved)),
+	}, nil
+}
+
+// GetFollowRequests vraca zahteve za pracenje koji cekaju odobrenje
+// trenutnog korisnika, od najstarijeg.
+func (s *FollowerServer) GetFollowRequests(ctx context.Context, req *pb.GetFollowRequestsRequest) (*pb.GetFollowRequestsResponse, error) {
+	username, _, _, err := GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+
+	data, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		q := `
+		MATCH (a:User)-[r:` + relReq + `]->(:User {username:$u})
+		RETURN a.username AS username, r.requestedAt AS requestedAt
+		ORDER BY requestedAt ASC, username ASC`
+		res, err := tx.Run(ctx, q, map[string]any{"u": username})
+		if err != nil {
+			return nil, err
+		}
+		requests := make([]*pb.FollowRequestDTO, 0)
+		for res.Next(ctx) {
+			request := &pb.FollowRequestDTO{Username: res.Record().Values[0].(string)}
+			if requestedAt, ok := res.Record().Values[1].(time.Time); ok {
+				request.RequestedAt = requestedAt.Format(time.RFC3339)
+			}
+			requests = append(requests, request)
+		}
+		return requests, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	requests, ok := data.([]*pb.FollowRequestDTO)
+	if !ok {
+		return nil, errors.New("invalid data format")
+	}
+
+	return &pb.GetFollowRequestsResponse{
+		Requests: requests,
+	}, nil
+}
+
+func (s *FollowerServer) ApproveFollowRequest(ctx context.Context, req *pb.ApproveFollowRequestRequest) (*pb.ApproveFollowRequestResponse, error) {
+	username, _, _, err := GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	q := `
+	MATCH (a:User {username:$from})-[r:` + relReq + `]->(me:User {username:$u})
+	DELETE r
+	MERGE (a)-[:` + relF + `]->(me)
+	RETURN COUNT(*)`
+	if err := resolveFollowRequest(ctx, q, req.From, username, true); err != nil {
+		return nil, err
+	}
+
+	return &pb.ApproveFollowRequestResponse{
+		Status: "follow request approved",
+	}, nil
+}
+
+func (s *FollowerServer) RejectFollowRequest(ctx context.Context, req *pb.RejectFollowRequestRequest) (*pb.RejectFollowRequestResponse, error) {
+	username, _, _, err := GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	q := `
+	MATCH (:User {username:$from})-[r:` + relReq + `]->(:User {username:$u})
+	DELETE r
+	RETURN COUNT(*)`
+	if err := resolveFollowRequest(ctx, q, req.From, username, false); err != nil {
+		return nil, err
+	}
+
+	return &pb.RejectFollowRequestResponse{
+		Status: "follow request rejected",
+	}, nil
+}
+
+// resolveFollowRequest izvrsava upit koji odobrava ili odbija zahtev i vraca
+// NotFound ako zahtev od korisnika from ne postoji. Za odobren zahtev se u
+// istoj transakciji upisuje dogadjaj o pracenju.
+func resolveFollowRequest(ctx context.Context, q, from, username string, approved bool) error {
+	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+
+	_, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		res, err := tx.Run(ctx, q, map[string]any{"from": from, "u": username})
+		if err != nil {
+			return nil, err
+		}
+		if !res.Next(ctx) {
+			return nil, errors.New("no result")
+		}
+		if count, _ := res.Record().Values[0].(int64); count == 0 {
+			return nil, status.Errorf(codes.NotFound, "no pending follow request from %s", from)
+		}
+		if !approved {
+			return nil, nil
+		}
+		return nil, addFollowEvent(ctx, tx, SubjectFollowed, from, username)
+	})
+	return err
+}
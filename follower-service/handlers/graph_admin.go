@@ -0,0 +1,516 @@
+package handlers
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"follower-service/db"
+	"follower-service/outbox"
+	pb "follower-service/proto/follower"
+	stakeproto "follower-service/proto/stakeholders"
+	"io"
+	"log"
+	"strings"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	formatCSV    = "csv"
+	formatNDJSON = "ndjson"
+
+	defaultExportLimit = 1000
+	maxExportLimit     = 10000
+	defaultImportBatch = 500
+	maxImportBatch     = 5000
+	// Broj gresaka u parsiranju koje se vracaju u odgovoru
+	maxImportErrors = 20
+)
+
+// graphEdge je jedna FOLLOWS veza u izvozu i uvozu.
+type graphEdge struct {
+	Follower string `json:"follower"`
+	Followee string `json:"followee"`
+}
+
+func requireAdmin(ctx context.Context) error {
+	_, _, role, err := GetClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	if role != "admin" {
+		return status.Error(codes.PermissionDenied, "only admin can manage the social graph")
+	}
+	return nil
+}
+
+func parseGraphFormat(format string) (string, error) {
+	switch format {
+	case "", formatCSV:
+		return formatCSV, nil
+	case formatNDJSON:
+		return formatNDJSON, nil
+	default:
+		return "", status.Errorf(codes.InvalidArgument, "unsupported format %q", format)
+	}
+}
+
+// ExportGraph vraca jednu stranicu FOLLOWS veza sortiranih po pratiocu pa po
+// pracenom. Kursor je poslednja vracena veza, pa izmene grafa izmedju dve
+// stranice ne dovode do preskakanja ostalih veza.
+func (s *FollowerServer) ExportGraph(ctx context.Context, req *pb.ExportGraphRequest) (*pb.ExportGraphResponse, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	format, err := parseGraphFormat(req.Format)
+	if err != nil {
+		return nil, err
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultExportLimit
+	}
+	limit = min(limit, maxExportLimit)
+
+	var after graphEdge
+	if req.Cursor != "" {
+		if after, err = decodeExportCursor(req.Cursor); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+		}
+	}
+
+	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+
+	data, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		q := `
+		MATCH (a:User)-[:` + relF + `]->(b:User)
+		WHERE a.username > $afterFollower
+			OR (a.username = $afterFollower AND b.username > $afterFollowee)
+		RETURN a.username, b.username
+		ORDER BY a.username, b.username
+		LIMIT $limit`
+		res, err := tx.Run(ctx, q, map[string]any{
+			"afterFollower": after.Follower,
+			"afterFollowee": after.Followee,
+			"limit":         limit + 1,
+		})
+		if err != nil {
+			return nil, err
+		}
+		edges := make([]graphEdge, 0)
+		for res.Next(ctx) {
+			values := res.Record().Values
+			edges = append(edges, graphEdge{Follower: values[0].(string), Followee: values[1].(string)})
+		}
+		return edges, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	edges, ok := data.([]graphEdge)
+	if !ok {
+		return nil, errors.New("invalid data format")
+	}
+
+	nextCursor := ""
+	if len(edges) > limit {
+		edges = edges[:limit]
+		nextCursor = encodeExportCursor(edges[limit-1])
+	}
+
+	encoded, err := encodeEdges(format, edges)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ExportGraphResponse{
+		Format:     format,
+		Data:       encoded,
+		Count:      int64(len(edges)),
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// ImportGraph dodaje FOLLOWS veze iz CSV ili NDJSON podataka, po istim
+// pravilima kao Follow: korisnici moraju da postoje u stakeholders-service i
+// ne smeju biti blokirani, parovi koji su se blokirali se preskacu, privatnom
+// nalogu se upisuje zahtev za pracenje, a za svaku novu vezu se u istoj
+// transakciji upisuje dogadjaj o pracenju. Svaka grupa se upisuje preko MERGE
+// u zasebnoj transakciji, pa se prekinut uvoz moze jednostavno ponoviti.
+func (s *FollowerServer) ImportGraph(ctx context.Context, req *pb.ImportGraphRequest) (*pb.ImportGraphResponse, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	format, err := parseGraphFormat(req.Format)
+	if err != nil {
+		return nil, err
+	}
+	batchSize := int(req.BatchSize)
+	if batchSize <= 0 {
+		batchSize = defaultImportBatch
+	}
+	batchSize = min(batchSize, maxImportBatch)
+
+	edges, skipped, parseErrors, err := decodeEdges(format, req.Data)
+	if err != nil {
+		return nil, err
+	}
+	edges, invalid, userErrors, err := filterImportUsers(ctx, edges)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range userErrors {
+		if len(parseErrors) >= maxImportErrors {
+			break
+		}
+		parseErrors = append(parseErrors, e)
+	}
+
+	resp := &pb.ImportGraphResponse{
+		Skipped: int64(skipped + invalid),
+		Errors:  parseErrors,
+	}
+	for start := 0; start < len(edges); start += batchSize {
+		batch := edges[start:min(start+batchSize, len(edges))]
+		result, err := importBatch(ctx, batch)
+		if err != nil {
+			log.Printf("Greška pri uvozu grafa posle %d veza: %v", resp.Processed, err)
+			return nil, status.Errorf(codes.Internal, "import failed after %d relationships", resp.Processed)
+		}
+		resp.Processed += result.processed
+		resp.Created += result.created
+		resp.Requested += result.requested
+		resp.Skipped += int64(len(batch)) - result.processed
+	}
+	return resp, nil
+}
+
+// filterImportUsers proverava sve korisnike iz uvoza u stakeholders-service,
+// kao checkFollowTarget, i izbacuje veze nepostojecih i blokiranih korisnika.
+func filterImportUsers(ctx context.Context, edges []graphEdge) ([]graphEdge, int, []string, error) {
+	usernames := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, edge := range edges {
+		for _, username := range []string{edge.Follower, edge.Followee} {
+			if !seen[username] {
+				seen[username] = true
+				usernames = append(usernames, username)
+			}
+		}
+	}
+
+	rejected := make(map[string]string)
+	userErrors := make([]string, 0)
+	for start := 0; start < len(usernames); start += reconcileBatchSize {
+		batch := usernames[start:min(start+reconcileBatchSize, len(usernames))]
+
+		callCtx, cancel := context.WithTimeout(ctx, stakeholdersTimeout)
+		resp, err := stakeholdersClient.CheckUsers(callCtx, &stakeproto.CheckUsersRequest{Usernames: batch})
+		cancel()
+		if err != nil {
+			log.Printf("Greška pri proveri korisnika za uvoz grafa: %v", err)
+			return nil, 0, nil, status.Error(codes.Unavailable, "cannot verify users, try again later")
+		}
+		if len(resp.Users) != len(batch) {
+			return nil, 0, nil, status.Error(codes.Unavailable, "unexpected CheckUsers response size")
+		}
+		for _, user := range resp.Users {
+			reason := ""
+			if !user.Exists {
+				reason = "not found"
+			} else if user.IsBlocked {
+				reason = "is blocked"
+			}
+			if reason != "" {
+				rejected[user.Username] = reason
+				userErrors = append(userErrors, fmt.Sprintf("user %s %s", user.Username, reason))
+			}
+		}
+	}
+
+	valid := make([]graphEdge, 0, len(edges))
+	for _, edge := range edges {
+		if rejected[edge.Follower] == "" && rejected[edge.Followee] == "" {
+			valid = append(valid, edge)
+		}
+	}
+	return valid, len(edges) - len(valid), userErrors, nil
+}
+
+type importResult struct {
+	processed int64
+	created   int64
+	requested int64
+}
+
+func importBatch(ctx context.Context, batch []graphEdge) (importResult, error) {
+	rows := make([]map[string]any, len(batch))
+	for i, edge := range batch {
+		rows[i] = map[string]any{"follower": edge.Follower, "followee": edge.Followee}
+	}
+
+	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+
+	data, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		q := `
+		UNWIND $edges AS e
+		MERGE (a:User {username:e.follower})
+		MERGE (b:User {username:e.followee})
+		WITH a, b WHERE NOT EXISTS { (a)-[:` + relB + `]-(b) }
+		WITH a, b, EXISTS { (a)-[:` + relF + `]->(b) } AS already
+		WITH a, b, already, coalesce(b.private, false) AND NOT already AS pending
+		FOREACH (_ IN CASE WHEN pending THEN [1] ELSE [] END |
+			MERGE (a)-[r:` + relReq + `]->(b)
+			ON CREATE SET r.requestedAt = datetime())
+		FOREACH (_ IN CASE WHEN pending THEN [] ELSE [1] END |
+			MERGE (a)-[:` + relF + `]->(b))
+		RETURN a.username, b.username, already, pending`
+		res, err := tx.Run(ctx, q, map[string]any{"edges": rows})
+		if err != nil {
+			return nil, err
+		}
+		var result importResult
+		for res.Next(ctx) {
+			values := res.Record().Values
+			already, _ := values[2].(bool)
+			pending, _ := values[3].(bool)
+			result.processed++
+			switch {
+			case pending:
+				result.requested++
+			case !already:
+				result.created++
+				if err := addFollowEvent(ctx, tx, SubjectFollowed, values[0].(string), values[1].(string)); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return result, res.Err()
+	})
+	if err != nil {
+		return importResult{}, err
+	}
+	result, ok := data.(importResult)
+	if !ok {
+		return importResult{}, errors.New("invalid data format")
+	}
+	return result, nil
+}
+
+// RenameUser menja korisnicko ime u grafu, uz zadrzavanje svih veza. U istoj
+// transakciji se upisuje dogadjaj, da bi blog i notification servis azurirali
+// korisnicko ime u svojim podacima.
+func (s *FollowerServer) RenameUser(ctx context.Context, req *pb.RenameUserRequest) (*pb.RenameUserResponse, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	newUsername := strings.TrimSpace(req.NewUsername)
+	if req.Username == "" || newUsername == "" || newUsername == req.Username {
+		return nil, status.Error(codes.InvalidArgument, "a new, different username is required")
+	}
+
+	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+
+	_, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		q := `
+		OPTIONAL MATCH (u:User {username:$old})
+		RETURN u IS NOT NULL, EXISTS { (:User {username:$new}) }`
+		res, err := tx.Run(ctx, q, map[string]any{"old": req.Username, "new": newUsername})
+		if err != nil {
+			return nil, err
+		}
+		if !res.Next(ctx) {
+			return nil, errors.New("no result")
+		}
+		values := res.Record().Values
+		if found, _ := values[0].(bool); !found {
+			return nil, status.Errorf(codes.NotFound, "user %s not found", req.Username)
+		}
+		if taken, _ := values[1].(bool); taken {
+			return nil, status.Errorf(codes.AlreadyExists, "user %s already exists", newUsername)
+		}
+
+		_, err = tx.Run(ctx, `MATCH (u:User {username:$old}) SET u.username = $new`,
+			map[string]any{"old": req.Username, "new": newUsername})
+		if err != nil {
+			return nil, err
+		}
+		return nil, outbox.Add(ctx, tx, SubjectUserRenamed, UserRenamedEvent{OldUsername: req.Username, NewUsername: newUsername})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RenameUserResponse{
+		Status: "user renamed",
+	}, nil
+}
+
+// DeleteUser brise korisnika iz grafa zajedno sa svim vezama. Za uklonjena
+// pracenja se upisuju dogadjaji, da bi blog feed bio uskladjen.
+func (s *FollowerServer) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb.DeleteUserResponse, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+
+	data, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		q := `
+		MATCH (u:User {username:$u})
+		OPTIONAL MATCH (u)-[f:` + relF + `]-(:User)
+		WITH u, [r IN collect(f) | [startNode(r).username, endNode(r).username]] AS follows
+		WITH u, follows, COUNT { (u)--() } AS rels
+		DETACH DELETE u
+		RETURN follows, rels`
+		res, err := tx.Run(ctx, q, map[string]any{"u": req.Username})
+		if err != nil {
+			return nil, err
+		}
+		if !res.Next(ctx) {
+			return nil, status.Errorf(codes.NotFound, "user %s not found", req.Username)
+		}
+		values := res.Record().Values
+		follows, _ := values[0].([]any)
+		for _, pair := range follows {
+			pair := pair.([]any)
+			if err := addFollowEvent(ctx, tx, SubjectUnfollowed, pair[0].(string), pair[1].(string)); err != nil {
+				return nil, err
+			}
+		}
+		return values[1], nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	removed, ok := data.(int64)
+	if !ok {
+		return nil, errors.New("invalid data format")
+	}
+
+	return &pb.DeleteUserResponse{
+		Status:               "user deleted",
+		RemovedRelationships: removed,
+	}, nil
+}
+
+func encodeExportCursor(edge graphEdge) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(edge.Follower + "\n" + edge.Followee))
+}
+
+func decodeExportCursor(cursor string) (graphEdge, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return graphEdge{}, err
+	}
+	follower, followee, ok := strings.Cut(string(raw), "\n")
+	if !ok {
+		return graphEdge{}, errors.New("malformed cursor")
+	}
+	return graphEdge{Follower: follower, Followee: followee}, nil
+}
+
+func encodeEdges(format string, edges []graphEdge) (string, error) {
+	var buf bytes.Buffer
+	if format == formatNDJSON {
+		enc := json.NewEncoder(&buf)
+		for _, edge := range edges {
+			if err := enc.Encode(edge); err != nil {
+				return "", err
+			}
+		}
+		return buf.String(), nil
+	}
+
+	w := csv.NewWriter(&buf)
+	w.Write([]string{"follower", "followee"})
+	for _, edge := range edges {
+		w.Write([]string{edge.Follower, edge.Followee})
+	}
+	w.Flush()
+	return buf.String(), w.Error()
+}
+
+// decodeEdges parsira veze za uvoz. Neispravni redovi i veze korisnika sa
+// samim sobom se preskacu, a duplikati se uklanjaju.
+func decodeEdges(format, data string) ([]graphEdge, int, []string, error) {
+	edges := make([]graphEdge, 0)
+	seen := make(map[graphEdge]bool)
+	skipped := 0
+	parseErrors := make([]string, 0)
+
+	add := func(line int, edge graphEdge, err error) {
+		edge.Follower = strings.TrimSpace(edge.Follower)
+		edge.Followee = strings.TrimSpace(edge.Followee)
+		if err == nil && (edge.Follower == "" || edge.Followee == "") {
+			err = errors.New("follower and followee are required")
+		}
+		if err != nil {
+			skipped++
+			if len(parseErrors) < maxImportErrors {
+				parseErrors = append(parseErrors, fmt.Sprintf("line %d: %v", line, err))
+			}
+			return
+		}
+		if edge.Follower == edge.Followee || seen[edge] {
+			skipped++
+			return
+		}
+		seen[edge] = true
+		edges = append(edges, edge)
+	}
+
+	if format == formatNDJSON {
+		scanner := bufio.NewScanner(strings.NewReader(data))
+		scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+		for line := 1; scanner.Scan(); line++ {
+			text := strings.TrimSpace(scanner.Text())
+			if text == "" {
+				continue
+			}
+			var edge graphEdge
+			err := json.Unmarshal([]byte(text), &edge)
+			add(line, edge, err)
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, 0, nil, status.Errorf(codes.InvalidArgument, "cannot read data: %v", err)
+		}
+		return edges, skipped, parseErrors, nil
+	}
+
+	r := csv.NewReader(strings.NewReader(data))
+	r.FieldsPerRecord = -1
+	for first := true; ; first = false {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, 0, nil, status.Errorf(codes.InvalidArgument, "cannot read data: %v", err)
+			}
+			add(parseErr.Line, graphEdge{}, parseErr.Err)
+			continue
+		}
+		if first && len(record) == 2 && record[0] == "follower" && record[1] == "followee" {
+			continue
+		}
+		line, _ := r.FieldPos(0)
+		if len(record) != 2 {
+			add(line, graphEdge{}, fmt.Errorf("expected 2 fields, got %d", len(record)))
+			continue
+		}
+		add(line, graphEdge{Follower: record[0], Followee: record[1]}, nil)
+	}
+	return edges, skipped, parseErrors, nil
+}
@@ -0,0 +1,267 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"follower-service/db"
+	"log"
+	"sort"
+
+	pb "follower-service/proto/follower"
+	stakeproto "follower-service/proto/stakeholders"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	strategyMutuals = "mutuals"
+	strategyTours   = "tours"
+	strategyNearby  = "nearby"
+	strategyAll     = "all"
+
+	defaultRecommendLimit = 10
+	maxRecommendLimit     = 50
+	nearbyRadiusKm        = 25.0
+
+	// Tezine pojedinacnih signala pri racunanju score-a
+	mutualWeight     = 3.0
+	sharedTourWeight = 2.0
+	nearbyWeight     = 2.0
+)
+
+// recExclusions izbacuje korisnika, one koje vec prati ili im je poslao
+// zahtev, blokirane u oba smera, utisane, odbacene preporuke i korisnike
+// blokirane na nivou sistema.
+const recExclusions = `rec <> me
+		AND NOT (me)-[:` + relF + `|` + relReq + `|` + relM + `|` + relD + `]->(rec)
+		AND NOT (me)-[:` + relB + `]-(rec)
+		AND NOT coalesce(rec.blocked, false)`
+
+func (s *FollowerServer) Recommend(ctx context.Context, req *pb.RecommendRequest) (*pb.RecommendResponse, error) {
+	username, _, _, err := GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultRecommendLimit
+	}
+	limit = min(limit, maxRecommendLimit)
+
+	strategy := req.Strategy
+	if strategy == "" {
+		strategy = strategyMutuals
+	}
+
+	recs := make(map[string]*pb.RecDTO)
+	switch strategy {
+	case strategyMutuals:
+		err = recommendByMutuals(ctx, username, limit, recs)
+	case strategyTours:
+		err = recommendByTours(ctx, username, limit, recs)
+	case strategyNearby:
+		err = recommendNearby(ctx, username, limit, recs)
+	case strategyAll:
+		// Svaka strategija daje vise kandidata od limita, da bi spajanje
+		// po score-u imalo iz cega da bira
+		pool := min(limit*3, maxRecommendLimit*3)
+		if err = recommendByMutuals(ctx, username, pool, recs); err != nil {
+			break
+		}
+		if err = recommendByTours(ctx, username, pool, recs); err != nil {
+			break
+		}
+		if nErr := recommendNearby(ctx, username, pool, recs); nErr != nil {
+			log.Printf("Preporuke u blizini nisu dostupne za %s: %v", username, nErr)
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown recommendation strategy %q", req.Strategy)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	recommendations := make([]*pb.RecDTO, 0, len(recs))
+	for _, rec := range recs {
+		recommendations = append(recommendations, rec)
+	}
+	sort.Slice(recommendations, func(i, j int) bool {
+		if recommendations[i].Score != recommendations[j].Score {
+			return recommendations[i].Score > recommendations[j].Score
+		}
+		return recommendations[i].Username < recommendations[j].Username
+	})
+	if len(recommendations) > limit {
+		recommendations = recommendations[:limit]
+	}
+
+	return &pb.RecommendResponse{
+		RecommendedUsers: recommendations,
+	}, nil
+}
+
+func (s *FollowerServer) DismissRecommendation(ctx context.Context, req *pb.DismissRecommendationRequest) (*pb.DismissRecommendationResponse, error) {
+	username, _, _, err := GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Username == "" || req.Username == username {
+		return nil, status.Error(codes.InvalidArgument, "invalid username")
+	}
+	if err := checkFollowTarget(ctx, req.Username); err != nil {
+		return nil, err
+	}
+
+	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+
+	_, err = session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		q := `
+		MERGE (me:User {username:$u})
+		MERGE (other:User {username:$other})
+		MERGE (me)-[d:` + relD + `]->(other)
+		SET d.dismissedAt = datetime()`
+		_, err := tx.Run(ctx, q, map[string]any{"u": username, "other": req.Username})
+		return nil, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DismissRecommendationResponse{
+		Status: "recommendation dismissed",
+	}, nil
+}
+
+// recommendByMutuals preporucuje korisnike koje prate oni koje korisnik prati.
+func recommendByMutuals(ctx context.Context, username string, limit int, recs map[string]*pb.RecDTO) error {
+	q := `
+	MATCH (me:User {username:$u})-[:` + relF + `]->(m:User)-[:` + relF + `]->(rec:User)
+	WHERE ` + recExclusions + `
+	RETURN rec.username AS username, COUNT(DISTINCT m) AS mutuals
+	ORDER BY mutuals DESC, username ASC
+	LIMIT $limit`
+
+	return readRecommendations(ctx, q, username, limit, func(values []any) {
+		mutuals := values[1].(int64)
+		rec := addRecommendation(recs, values[0].(string), mutualWeight*float64(mutuals))
+		rec.Mutuals = mutuals
+		if mutuals == 1 {
+			rec.Reasons = append(rec.Reasons, "1 mutual follow")
+		} else {
+			rec.Reasons = append(rec.Reasons, fmt.Sprintf("%d mutual follows", mutuals))
+		}
+	})
+}
+
+// recommendByTours preporucuje korisnike koji su kupili, zavrsili ili ocenili
+// iste ture. Za svaku zajednicku turu se navodi najjaca veza drugog korisnika.
+func recommendByTours(ctx context.Context, username string, limit int, recs map[string]*pb.RecDTO) error {
+	q := `
+	MATCH (me:User {username:$u})-[:` + relPurchased + `|` + relCompleted + `|` + relReviewed + `]->(t:Tour)
+		<-[r:` + relPurchased + `|` + relCompleted + `|` + relReviewed + `]-(rec:User)
+	WHERE ` + recExclusions + `
+	WITH rec, t, collect(type(r)) AS kinds
+	WITH rec, t, CASE
+		WHEN '` + relReviewed + `' IN kinds THEN 'reviewed'
+		WHEN '` + relCompleted + `' IN kinds THEN 'completed'
+		ELSE 'bought' END AS kind
+	RETURN rec.username AS username, COUNT(t) AS shared, collect(kind + ' ' + coalesce(t.name, 'a tour'))[..3] AS tours
+	ORDER BY shared DESC, username ASC
+	LIMIT $limit`
+
+	return readRecommendations(ctx, q, username, limit, func(values []any) {
+		rec := addRecommendation(recs, values[0].(string), sharedTourWeight*float64(values[1].(int64)))
+		for _, tour := range values[2].([]any) {
+			rec.Reasons = append(rec.Reasons, "also "+tour.(string))
+		}
+	})
+}
+
+// recommendNearby preporucuje korisnike cija je pozicija blizu pozicije
+// korisnika. Pozicije cuva stakeholders-service, pa se iskljucenja iz grafa
+// primenjuju naknadno.
+func recommendNearby(ctx context.Context, username string, limit int, recs map[string]*pb.RecDTO) error {
+	callCtx, cancel := context.WithTimeout(ctx, stakeholdersTimeout)
+	defer cancel()
+
+	resp, err := stakeholdersClient.GetNearbyUsers(callCtx, &stakeproto.GetNearbyUsersRequest{
+		Username: username,
+		RadiusKm: nearbyRadiusKm,
+		Limit:    int32(limit * 2),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil
+		}
+		log.Printf("Greška pri dohvatanju korisnika u blizini za %s: %v", username, err)
+		return status.Error(codes.Unavailable, "nearby recommendations are not available, try again later")
+	}
+
+	excluded, err := listRelated(ctx, `
+	MATCH (me:User {username:$u})-[r]-(o:User)
+	WHERE type(r) = '`+relB+`'
+		OR (startNode(r) = me AND type(r) IN ['`+relF+`', '`+relReq+`', '`+relM+`', '`+relD+`'])
+	RETURN DISTINCT o.username AS u`, username)
+	if err != nil {
+		return err
+	}
+	skip := make(map[string]bool, len(excluded))
+	for _, u := range excluded {
+		skip[u] = true
+	}
+
+	added := 0
+	for _, user := range resp.Users {
+		if skip[user.Username] || user.Username == username {
+			continue
+		}
+		rec := addRecommendation(recs, user.Username, nearbyWeight*max(0, 1-user.DistanceKm/nearbyRadiusKm))
+		rec.Reasons = append(rec.Reasons, fmt.Sprintf("nearby (%.1f km)", user.DistanceKm))
+		if added++; added == limit {
+			break
+		}
+	}
+	return nil
+}
+
+func addRecommendation(recs map[string]*pb.RecDTO, username string, score float64) *pb.RecDTO {
+	rec, ok := recs[username]
+	if !ok {
+		rec = &pb.RecDTO{Username: username}
+		recs[username] = rec
+	}
+	rec.Score += score
+	return rec
+}
+
+func readRecommendations(ctx context.Context, q, username string, limit int, add func([]any)) error {
+	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+
+	data, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		res, err := tx.Run(ctx, q, map[string]any{"u": username, "limit": limit})
+		if err != nil {
+			return nil, err
+		}
+		rows := make([][]any, 0)
+		for res.Next(ctx) {
+			rows = append(rows, res.Record().Values)
+		}
+		return rows, nil
+	})
+	if err != nil {
+		return err
+	}
+	rows, ok := data.([][]any)
+	if !ok {
+		return errors.New("invalid data format")
+	}
+	for _, row := range rows {
+		add(row)
+	}
+	return nil
+}
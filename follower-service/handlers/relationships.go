@@ -0,0 +1,171 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"follower-service/db"
+	pb "follower-service/proto/follower"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxRelationshipStatusUsernames = 100
+
+// GetFollowCounts vraca broj pratilaca i pracenih bez ucitavanja listi.
+// Za nepoznatog korisnika oba broja su 0.
+func (s *FollowerServer) GetFollowCounts(ctx context.Context, req *pb.GetFollowCountsRequest) (*pb.GetFollowCountsResponse, error) {
+	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+
+	data, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		q := `
+		OPTIONAL MATCH (u:User {username:$u})
+		RETURN COUNT { (u)<-[:` + relF + `]-(:User) } AS followers,
+			COUNT { (u)-[:` + relF + `]->(:User) } AS following`
+		res, err := tx.Run(ctx, q, map[string]any{"u": req.Username})
+		if err != nil {
+			return nil, err
+		}
+		if res.Next(ctx) {
+			return res.Record().Values, nil
+		}
+		return nil, errors.New("no result")
+	})
+	if err != nil {
+		return nil, err
+	}
+	values, ok := data.([]any)
+	if !ok {
+		return nil, errors.New("invalid data format")
+	}
+	followers, _ := values[0].(int64)
+	following, _ := values[1].(int64)
+
+	return &pb.GetFollowCountsResponse{
+		Username:  req.Username,
+		Followers: followers,
+		Following: following,
+	}, nil
+}
+
+func (s *FollowerServer) IsFollowing(ctx context.Context, req *pb.IsFollowingRequest) (*pb.IsFollowingResponse, error) {
+	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+
+	data, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		q := `RETURN EXISTS { (:User {username:$follower})-[:` + relF + `]->(:User {username:$followee}) }`
+		res, err := tx.Run(ctx, q, map[string]any{"follower": req.Follower, "followee": req.Followee})
+		if err != nil {
+			return nil, err
+		}
+		if res.Next(ctx) {
+			return res.Record().Values[0], nil
+		}
+		return nil, errors.New("no result")
+	})
+	if err != nil {
+		return nil, err
+	}
+	following, ok := data.(bool)
+	if !ok {
+		return nil, errors.New("invalid data format")
+	}
+
+	return &pb.IsFollowingResponse{
+		Following: following,
+	}, nil
+}
+
+// GetRelationshipStatus vraca odnos trenutnog korisnika prema svakom od
+// navedenih korisnika, u istom redosledu. Da li je neko blokirao trenutnog
+// korisnika se ne otkriva.
+func (s *FollowerServer) GetRelationshipStatus(ctx context.Context, req *pb.GetRelationshipStatusRequest) (*pb.GetRelationshipStatusResponse, error) {
+	username, _, _, err := GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Usernames) == 0 {
+		return &pb.GetRelationshipStatusResponse{Statuses: []*pb.RelationshipStatus{}}, nil
+	}
+	if len(req.Usernames) > maxRelationshipStatusUsernames {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d usernames can be checked at once", maxRelationshipStatusUsernames)
+	}
+
+	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+
+	data, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		q := `
+		UNWIND $usernames AS other
+		RETURN other,
+			EXISTS { (:User {username:$u})-[:` + relF + `]->(:User {username:other}) } AS following,
+			EXISTS { (:User {username:other})-[:` + relF + `]->(:User {username:$u}) } AS followedBy,
+			EXISTS { (:User {username:$u})-[:` + relReq + `]->(:User {username:other}) } AS requested,
+			EXISTS { (:User {username:$u})-[:` + relB + `]->(:User {username:other}) } AS blocked`
+		res, err := tx.Run(ctx, q, map[string]any{"u": username, "usernames": req.Usernames})
+		if err != nil {
+			return nil, err
+		}
+		statuses := make([]*pb.RelationshipStatus, 0, len(req.Usernames))
+		for res.Next(ctx) {
+			values := res.Record().Values
+			statuses = append(statuses, &pb.RelationshipStatus{
+				Username:   values[0].(string),
+				Following:  values[1].(bool),
+				FollowedBy: values[2].(bool),
+				Requested:  values[3].(bool),
+				Blocked:    values[4].(bool),
+			})
+		}
+		return statuses, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	statuses, ok := data.([]*pb.RelationshipStatus)
+	if !ok {
+		return nil, errors.New("invalid data format")
+	}
+
+	return &pb.GetRelationshipStatusResponse{
+		Statuses: statuses,
+	}, nil
+}
+
+func (s *FollowerServer) GetMutualFollowers(ctx context.Context, req *pb.GetMutualFollowersRequest) (*pb.GetMutualFollowersResponse, error) {
+	if req.Username == "" || req.Other == "" {
+		return nil, status.Error(codes.InvalidArgument, "both usernames are required")
+	}
+
+	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+
+	data, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		q := `
+		MATCH (:User {username:$u})<-[:` + relF + `]-(f:User)-[:` + relF + `]->(:User {username:$other})
+		RETURN f.username AS u ORDER BY u`
+		res, err := tx.Run(ctx, q, map[string]any{"u": req.Username, "other": req.Other})
+		if err != nil {
+			return nil, err
+		}
+		usernames := make([]string, 0)
+		for res.Next(ctx) {
+			usernames = append(usernames, res.Record().Values[0].(string))
+		}
+		return usernames, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	mutuals, ok := data.([]string)
+	if !ok {
+		return nil, errors.New("invalid data format")
+	}
+
+	return &pb.GetMutualFollowersResponse{
+		MutualFollowers: mutuals,
+		Count:           int64(len(mutuals)),
+	}, nil
+}
@@ -0,0 +1,185 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"follower-service/db"
+	stakeproto "follower-service/proto/stakeholders"
+	"shared/events"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+const (
+	SubjectExecutionCompleted = "tours_execution_completed"
+	SubjectReviewCreated      = "tours_review_created"
+	SubjectActivityImported   = "tours_activity_imported"
+	SubjectCheckoutCompleted  = "purchase_checkout_completed"
+
+	relPurchased = "PURCHASED"
+	relCompleted = "COMPLETED"
+	relReviewed  = "REVIEWED"
+)
+
+// TourActivityEvent odgovara payload-u dogadjaja koji objavljuje
+// tours-service.
+type TourActivityEvent struct {
+	UserID   string `json:"userId"`
+	Username string `json:"username"`
+	TourID   string `json:"tourId"`
+	TourName string `json:"tourName"`
+}
+
+// ImportedActivityEvent je zavrsena tura ili recenzija nastala pre nego sto je
+// tours-service objavljivao dogadjaje. At je vreme aktivnosti, a ne uvoza.
+type ImportedActivityEvent struct {
+	TourActivityEvent
+	Activity string    `json:"activity"` // completed ili reviewed
+	At       time.Time `json:"at"`
+}
+
+// CheckoutCompletedEvent objavljuje purchase-service kada korisnik plati
+// korpu.
+type CheckoutCompletedEvent struct {
+	UserID string `json:"userId"`
+	Tours  []struct {
+		TourID   string `json:"tourId"`
+		TourName string `json:"tourName"`
+	} `json:"tours"`
+}
+
+var importedRels = map[string]string{
+	"completed": relCompleted,
+	"reviewed":  relReviewed,
+}
+
+// SubscribeTourEvents belezi u grafu koje ture je korisnik kupio, zavrsio ili
+// ocenio, da bi se korisnici sa zajednickim turama mogli preporuciti.
+func SubscribeTourEvents(natsConn *nats.Conn) {
+	events.Consume(context.Background(), natsConn, events.ConsumerConfig{
+		Durable: "follower-service-tours",
+		Handlers: map[string]events.Handler{
+			SubjectExecutionCompleted: handleTourEvent(relCompleted),
+			SubjectReviewCreated:      handleTourEvent(relReviewed),
+			SubjectActivityImported:   onActivityImported,
+			SubjectCheckoutCompleted:  onCheckoutCompleted,
+		},
+	})
+}
+
+func handleTourEvent(rel string) events.Handler {
+	return func(ctx context.Context, envelope events.Envelope) error {
+		var event TourActivityEvent
+		if err := envelope.DecodePayload(&event); err != nil {
+			return err
+		}
+		return recordTourActivity(ctx, event, rel, envelope.OccurredAt)
+	}
+}
+
+func onActivityImported(ctx context.Context, envelope events.Envelope) error {
+	var event ImportedActivityEvent
+	if err := envelope.DecodePayload(&event); err != nil {
+		return err
+	}
+	rel, ok := importedRels[event.Activity]
+	if !ok {
+		return events.Permanent(errors.New("unknown activity " + event.Activity))
+	}
+	at := event.At
+	if at.IsZero() {
+		at = envelope.OccurredAt
+	}
+	return recordTourActivity(ctx, event.TourActivityEvent, rel, at)
+}
+
+// onCheckoutCompleted belezi kupovinu svake ture iz placene korpe.
+func onCheckoutCompleted(ctx context.Context, envelope events.Envelope) error {
+	var event CheckoutCompletedEvent
+	if err := envelope.DecodePayload(&event); err != nil {
+		return err
+	}
+	for _, tour := range event.Tours {
+		activity := TourActivityEvent{UserID: event.UserID, TourID: tour.TourID, TourName: tour.TourName}
+		if err := recordTourActivity(ctx, activity, relPurchased, envelope.OccurredAt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordTourActivity upisuje vezu rel izmedju korisnika i ture. Dogadjaji
+// koji nemaju korisnicko ime (kupovine, uvezene zavrsene ture) se razresavaju
+// po ID-ju korisnika.
+func recordTourActivity(ctx context.Context, event TourActivityEvent, rel string, at time.Time) error {
+	if event.TourID == "" || (event.Username == "" && event.UserID == "") {
+		return events.Permanent(errors.New("missing user or tour id"))
+	}
+	if event.Username == "" {
+		username, err := usernameForUserID(ctx, event.UserID)
+		if err != nil {
+			return err
+		}
+		event.Username = username
+	}
+
+	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+
+	_, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		q := `
+		MERGE (u:User {username:$u})
+		MERGE (t:Tour {id:$tourId})
+		SET t.name = CASE WHEN $tourName = '' THEN t.name ELSE $tourName END
+		MERGE (u)-[r:` + rel + `]->(t)
+		SET r.at = datetime($at)`
+		_, err := tx.Run(ctx, q, map[string]any{
+			"u":        event.Username,
+			"tourId":   event.TourID,
+			"tourName": event.TourName,
+			"at":       at.UTC().Format(time.RFC3339),
+		})
+		return nil, err
+	})
+	return err
+}
+
+// usernameForUserID trazi korisnicko ime prvo u grafu, a zatim u
+// stakeholders-service. Nepostojeci korisnik je trajna greska.
+func usernameForUserID(ctx context.Context, userID string) (string, error) {
+	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+
+	data, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		res, err := tx.Run(ctx, `MATCH (u:User {userId:$id}) RETURN u.username LIMIT 1`, map[string]any{"id": userID})
+		if err != nil {
+			return nil, err
+		}
+		if res.Next(ctx) {
+			username, _ := res.Record().Values[0].(string)
+			return username, nil
+		}
+		return "", res.Err()
+	})
+	if err != nil {
+		return "", err
+	}
+	if username, _ := data.(string); username != "" {
+		return username, nil
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, stakeholdersTimeout)
+	defer cancel()
+	resp, err := stakeholdersClient.GetUserContacts(callCtx, &stakeproto.GetUserContactsRequest{UserIds: []string{userID}})
+	if err != nil {
+		return "", err
+	}
+	for _, user := range resp.Users {
+		if user.UserId == userID && user.Username != "" {
+			return user.Username, nil
+		}
+	}
+	return "", events.Permanent(errors.New("user " + userID + " not found"))
+}
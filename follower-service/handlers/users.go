@@ -0,0 +1,267 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"follower-service/db"
+	pb "follower-service/proto/follower"
+	stakeproto "follower-service/proto/stakeholders"
+	"log"
+	"shared/events"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	SubjectUserRegistered = "stakeholders_user_registered"
+	SubjectUserBlocked    = "stakeholders_user_blocked"
+	SubjectProfileUpdated = "stakeholders_profile_updated"
+
+	stakeholdersTimeout = 3 * time.Second
+	// Broj korisnika koji se proverava jednim CheckUsers pozivom
+	reconcileBatchSize = 500
+	// Ovoliko cvorova sme da se obrise bez obzira na udeo, da prag ne bi
+	// blokirao uskladjivanje malih grafova
+	reconcileFreeDeletions   = 10
+	defaultReconcileMaxRatio = 0.1
+)
+
+// errReconcileLimit znaci da bi uskladjivanje obrisalo neocekivano velik deo
+// grafa, sto najcesce ukazuje na gresku u stakeholders-service, a ne na
+// stvarno obrisane korisnike.
+var errReconcileLimit = errors.New("too many users would be removed")
+
+// reconcileMaxRatio je najveci udeo User cvorova koji jedno uskladjivanje sme
+// da obrise.
+var reconcileMaxRatio = defaultReconcileMaxRatio
+
+var stakeholdersClient stakeproto.StakeholdersServiceClient
+
+func InitStakeholdersClient(c stakeproto.StakeholdersServiceClient) {
+	stakeholdersClient = c
+}
+
+// UserEvent odgovara payload-u dogadjaja koji objavljuje stakeholders-service.
+type UserEvent struct {
+	Username       string `json:"username"`
+	UserID         string `json:"userId,omitempty"`
+	Role           string `json:"role,omitempty"`
+	Blocked        bool   `json:"blocked"`
+	FirstName      string `json:"firstName,omitempty"`
+	LastName       string `json:"lastName,omitempty"`
+	ProfilePicture string `json:"profilePicture,omitempty"`
+}
+
+// checkFollowTarget proverava u stakeholders-service da korisnik postoji i da
+// nije blokiran. Poziva se pre svake operacije koja MERGE-om pravi User cvor
+// (Follow, Block, Mute, DismissRecommendation, SetAccountPrivacy), da se ne bi
+// pravili cvorovi za nepostojece korisnike.
+func checkFollowTarget(ctx context.Context, username string) error {
+	callCtx, cancel := context.WithTimeout(ctx, stakeholdersTimeout)
+	defer cancel()
+
+	resp, err := stakeholdersClient.CheckUsers(callCtx, &stakeproto.CheckUsersRequest{Usernames: []string{username}})
+	if err != nil {
+		log.Printf("Greška pri proveri korisnika %s u stakeholders-service: %v", username, err)
+		return status.Error(codes.Unavailable, "cannot verify user, try again later")
+	}
+	if len(resp.Users) == 0 || !resp.Users[0].Exists {
+		return status.Errorf(codes.NotFound, "user %s not found", username)
+	}
+	if resp.Users[0].IsBlocked {
+		return status.Errorf(codes.FailedPrecondition, "user %s is blocked", username)
+	}
+	return nil
+}
+
+// SubscribeUserEvents azurira User cvorove na osnovu dogadjaja iz
+// stakeholders-service. Replike dele trajni consumer, pa dogadjaj obradi samo
+// jedna od njih, a neuspela obrada se ponavlja.
+func SubscribeUserEvents(natsConn *nats.Conn) {
+	events.Consume(context.Background(), natsConn, events.ConsumerConfig{
+		Durable: "follower-service-users",
+		Handlers: map[string]events.Handler{
+			SubjectUserRegistered: handleUserEvent(onUserRegistered),
+			SubjectUserBlocked:    handleUserEvent(onUserBlocked),
+			SubjectProfileUpdated: handleUserEvent(onProfileUpdated),
+		},
+	})
+}
+
+func handleUserEvent(handle func(context.Context, neo4j.ManagedTransaction, UserEvent) error) events.Handler {
+	return func(ctx context.Context, envelope events.Envelope) error {
+		var event UserEvent
+		if err := envelope.DecodePayload(&event); err != nil {
+			return err
+		}
+		if event.Username == "" {
+			return events.Permanent(errors.New("missing username"))
+		}
+
+		session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+		defer session.Close(ctx)
+
+		_, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+			return nil, handle(ctx, tx, event)
+		})
+		return err
+	}
+}
+
+func onUserRegistered(ctx context.Context, tx neo4j.ManagedTransaction, event UserEvent) error {
+	q := `
+	MERGE (u:User {username:$u})
+	SET u.userId = $userId, u.role = $role, u.blocked = false`
+	_, err := tx.Run(ctx, q, map[string]any{"u": event.Username, "userId": event.UserID, "role": event.Role})
+	return err
+}
+
+func onUserBlocked(ctx context.Context, tx neo4j.ManagedTransaction, event UserEvent) error {
+	q := `
+	MERGE (u:User {username:$u})
+	SET u.blocked = $blocked`
+	_, err := tx.Run(ctx, q, map[string]any{"u": event.Username, "blocked": event.Blocked})
+	return err
+}
+
+func onProfileUpdated(ctx context.Context, tx neo4j.ManagedTransaction, event UserEvent) error {
+	q := `
+	MERGE (u:User {username:$u})
+	SET u.firstName = $firstName, u.lastName = $lastName, u.profilePicture = $picture`
+	_, err := tx.Run(ctx, q, map[string]any{
+		"u":         event.Username,
+		"firstName": event.FirstName,
+		"lastName":  event.LastName,
+		"picture":   event.ProfilePicture,
+	})
+	return err
+}
+
+func (s *FollowerServer) ReconcileUsers(ctx context.Context, req *pb.ReconcileUsersRequest) (*pb.ReconcileUsersResponse, error) {
+	_, _, role, err := GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if role != "admin" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can reconcile users")
+	}
+
+	checked, removed, err := reconcileUsers(ctx, req.DryRun)
+	if errors.Is(err, errReconcileLimit) {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v; run with dry_run to review them or raise FOLLOWER_RECONCILE_MAX_RATIO", err)
+	}
+	if err != nil {
+		log.Printf("Greška pri usklađivanju korisnika: %v", err)
+		return nil, status.Error(codes.Internal, "failed to reconcile users")
+	}
+
+	return &pb.ReconcileUsersResponse{
+		Checked: int64(checked),
+		Removed: removed,
+		DryRun:  req.DryRun,
+	}, nil
+}
+
+// reconcileUsers proverava sve User cvorove u stakeholders-service i brise
+// one za koje korisnik ne postoji, zajedno sa svim njihovim vezama. Ako bi
+// bilo obrisano vise od reconcileMaxRatio cvorova, nista se ne brise i vraca
+// se errReconcileLimit.
+func reconcileUsers(ctx context.Context, dryRun bool) (int, []string, error) {
+	usernames, err := allUsernames(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	orphans := make([]string, 0)
+	for start := 0; start < len(usernames); start += reconcileBatchSize {
+		batch := usernames[start:min(start+reconcileBatchSize, len(usernames))]
+
+		callCtx, cancel := context.WithTimeout(ctx, stakeholdersTimeout)
+		resp, err := stakeholdersClient.CheckUsers(callCtx, &stakeproto.CheckUsersRequest{Usernames: batch})
+		cancel()
+		if err != nil {
+			return 0, nil, err
+		}
+		if len(resp.Users) != len(batch) {
+			return 0, nil, errors.New("unexpected CheckUsers response size")
+		}
+		for _, user := range resp.Users {
+			if !user.Exists {
+				orphans = append(orphans, user.Username)
+			}
+		}
+	}
+
+	if dryRun || len(orphans) == 0 {
+		return len(usernames), orphans, nil
+	}
+	if len(orphans) > reconcileFreeDeletions && float64(len(orphans)) > reconcileMaxRatio*float64(len(usernames)) {
+		log.Printf("Usklađivanje prekinuto: %d od %d User čvorova nema korisnika u stakeholders-service (dozvoljeno %.0f%%)", len(orphans), len(usernames), reconcileMaxRatio*100)
+		return 0, nil, fmt.Errorf("%w: %d of %d", errReconcileLimit, len(orphans), len(usernames))
+	}
+
+	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+
+	_, err = session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		_, err := tx.Run(ctx, `MATCH (u:User) WHERE u.username IN $orphans DETACH DELETE u`, map[string]any{"orphans": orphans})
+		return nil, err
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	log.Printf("Uklonjeno %d User čvorova bez korisnika u stakeholders-service: %v", len(orphans), orphans)
+	return len(usernames), orphans, nil
+}
+
+func allUsernames(ctx context.Context) ([]string, error) {
+	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+
+	data, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		res, err := tx.Run(ctx, `MATCH (u:User) WHERE u.username IS NOT NULL RETURN u.username ORDER BY u.username`, nil)
+		if err != nil {
+			return nil, err
+		}
+		usernames := make([]string, 0)
+		for res.Next(ctx) {
+			usernames = append(usernames, res.Record().Values[0].(string))
+		}
+		return usernames, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	usernames, ok := data.([]string)
+	if !ok {
+		return nil, errors.New("invalid data format")
+	}
+	return usernames, nil
+}
+
+// StartReconciler periodicno uklanja cvorove bez korisnika. Interval 0
+// iskljucuje automatsko uskladjivanje; tada ga pokrece administrator.
+// maxRatio vazi i za uskladjivanje koje pokrece administrator; vrednost van
+// (0, 1] zadrzava podrazumevanih 10%.
+func StartReconciler(interval time.Duration, maxRatio float64) {
+	if maxRatio > 0 && maxRatio <= 1 {
+		reconcileMaxRatio = maxRatio
+	}
+	if interval <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			if _, _, err := reconcileUsers(context.Background(), false); err != nil {
+				log.Printf("Greška pri automatskom usklađivanju korisnika: %v", err)
+			}
+		}
+	}()
+}
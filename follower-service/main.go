@@ -1,16 +1,23 @@
 package main
 
 import (
+	"context"
 	"follower-service/db"
 	"follower-service/handlers"
+	"follower-service/outbox"
 	"log"
 	"net"
 	"os"
+	"strconv"
+	"time"
 
 	pb "follower-service/proto/follower"
+	stakeproto "follower-service/proto/stakeholders"
 
 	"github.com/joho/godotenv"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"shared/events"
 )
 
 func main() {
@@ -28,6 +35,42 @@ func main() {
 	}
 
 	db.ConnectNeo4j(uri, user, pass)
+	db.EnsureSchema(context.Background())
+
+	// Dogadjaji nisu kriticni za rad servisa, pa se veza uspostavlja u
+	// pozadini; dok NATS nije dostupan dogadjaji o pracenju cekaju u outbox-u, User cvorovi se uskladjuju samo
+	// rekoncilijacijom, a preporuke po turama ostaju bez novih podataka
+	natsConn, err := events.Connect(os.Getenv("NATS_URL"), "follower-service")
+	if err != nil {
+		log.Fatalf("Failed to configure NATS connection: %v", err)
+	}
+	defer natsConn.Close()
+	outbox.StartRelay(context.Background(), natsConn, outbox.ConfigFromEnv())
+	handlers.SubscribeUserEvents(natsConn)
+	handlers.SubscribeTourEvents(natsConn)
+
+	stakeholdersServiceAddress := "stakeholders-service:8081"
+	//stakeholdersServiceAddress := "127.0.0.1:8081"
+
+	stakeholdersConn, err := grpc.Dial(
+		stakeholdersServiceAddress,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		log.Fatalf("Failed to dial stakeholders service: %v", err)
+	}
+	defer stakeholdersConn.Close()
+	handlers.InitStakeholdersClient(stakeproto.NewStakeholdersServiceClient(stakeholdersConn))
+
+	reconcileInterval, err := time.ParseDuration(os.Getenv("FOLLOWER_RECONCILE_INTERVAL"))
+	if err != nil {
+		reconcileInterval = 0
+	}
+	reconcileMaxRatio, err := strconv.ParseFloat(os.Getenv("FOLLOWER_RECONCILE_MAX_RATIO"), 64)
+	if err != nil {
+		reconcileMaxRatio = 0
+	}
+	handlers.StartReconciler(reconcileInterval, reconcileMaxRatio)
 
 	lis, err := net.Listen("tcp", ":8084")
 	if err != nil {
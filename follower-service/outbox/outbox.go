@@ -0,0 +1,31 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"shared/events"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// Producer se upisuje u omotac svakog dogadjaja ovog servisa.
+const Producer = "follower-service"
+
+// Add upisuje dogadjaj kao OutboxEvent cvor u okviru transakcije tx, tako da
+// se dogadjaj objavi ako i samo ako je promena grafa sacuvana.
+func Add(ctx context.Context, tx neo4j.ManagedTransaction, eventType string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	q := `
+	CREATE (:OutboxEvent {id:randomUUID(), type:$type, version:$version, payload:$payload, occurredAt:$occurredAt})`
+	_, err = tx.Run(ctx, q, map[string]any{
+		"type":       eventType,
+		"version":    events.Version,
+		"payload":    string(data),
+		"occurredAt": time.Now().UTC(),
+	})
+	return err
+}
@@ -0,0 +1,166 @@
+package outbox
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"follower-service/db"
+	"log"
+	"shared/env"
+	"shared/events"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// Posle ovog vremena dogadjaj koji je preuzela replika koja je u
+// medjuvremenu pala moze da preuzme druga replika
+const claimTimeout = 30 * time.Second
+
+type Config struct {
+	Interval  time.Duration
+	BatchSize int
+}
+
+// ConfigFromEnv cita OUTBOX_POLL_INTERVAL.
+func ConfigFromEnv() Config {
+	return Config{Interval: env.PositiveDuration("OUTBOX_POLL_INTERVAL", time.Second), BatchSize: 100}
+}
+
+type pendingEvent struct {
+	ID         string
+	Type       string
+	Version    int
+	Payload    string
+	OccurredAt time.Time
+}
+
+// StartRelay objavljuje OutboxEvent cvorove u JetStream i brise ih kada ih
+// JetStream potvrdi. Dogadjaj se moze objaviti i vise puta, pa potrosaci
+// moraju biti idempotentni.
+func StartRelay(ctx context.Context, natsConn *nats.Conn, cfg Config) {
+	publisher, err := events.NewPublisher(natsConn)
+	if err != nil {
+		log.Fatalf("[outbox] %s: %v", Producer, err)
+	}
+	go func() {
+		ticker := time.NewTicker(cfg.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			for {
+				n, err := relayBatch(ctx, publisher, cfg.BatchSize)
+				if err != nil {
+					log.Printf("[outbox] %s: relay failed: %v", Producer, err)
+					break
+				}
+				if n < cfg.BatchSize {
+					break
+				}
+			}
+		}
+	}()
+}
+
+// relayBatch preuzima najvise batchSize dogadjaja oznakom claimToken, tako da
+// ih druga replika ne objavi istovremeno, objavljuje ih redom i brise
+// objavljene. Preuzeti a neobjavljeni dogadjaji se oslobadjaju. Redosled vazi
+// samo unutar jedne serije; replike objavljuju svoje serije nezavisno.
+func relayBatch(ctx context.Context, publisher *events.Publisher, batchSize int) (int, error) {
+	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+
+	token, err := newClaimToken()
+	if err != nil {
+		return 0, err
+	}
+	data, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		q := `
+		MATCH (e:OutboxEvent)
+		WHERE e.claimedAt IS NULL OR e.claimedAt < $expired
+		WITH e ORDER BY e.occurredAt, e.id LIMIT $limit
+		SET e.claimedAt = datetime(), e.claimToken = $token
+		RETURN e.id, e.type, e.version, e.payload, e.occurredAt`
+		res, err := tx.Run(ctx, q, map[string]any{
+			"expired": time.Now().UTC().Add(-claimTimeout),
+			"limit":   batchSize,
+			"token":   token,
+		})
+		if err != nil {
+			return nil, err
+		}
+		pending := make([]pendingEvent, 0)
+		for res.Next(ctx) {
+			values := res.Record().Values
+			event := pendingEvent{ID: values[0].(string), Type: values[1].(string), Payload: values[3].(string)}
+			if version, ok := values[2].(int64); ok {
+				event.Version = int(version)
+			}
+			if occurredAt, ok := values[4].(time.Time); ok {
+				event.OccurredAt = occurredAt
+			}
+			pending = append(pending, event)
+		}
+		return pending, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	pending, ok := data.([]pendingEvent)
+	if !ok {
+		return 0, errors.New("invalid data format")
+	}
+	if len(pending) == 0 {
+		return 0, nil
+	}
+
+	published := make([]string, 0, len(pending))
+	var publishErr error
+	for _, event := range pending {
+		if publishErr = publisher.Publish(ctx, envelopeFor(event)); publishErr != nil {
+			// Ostali dogadjaji cekaju, da bi se sacuvao redosled
+			break
+		}
+		published = append(published, event.ID)
+	}
+	_, err = session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		q := `
+		MATCH (e:OutboxEvent {claimToken:$token})
+		WITH e, e.id IN $published AS done
+		FOREACH (_ IN CASE WHEN done THEN [1] ELSE [] END | DELETE e)
+		FOREACH (_ IN CASE WHEN done THEN [] ELSE [1] END | REMOVE e.claimedAt, e.claimToken)`
+		_, err := tx.Run(ctx, q, map[string]any{"token": token, "published": published})
+		return nil, err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(published), publishErr
+}
+
+func newClaimToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func envelopeFor(event pendingEvent) events.Envelope {
+	return events.Envelope{
+		ID:         event.ID,
+		Type:       event.Type,
+		Version:    event.Version,
+		OccurredAt: event.OccurredAt,
+		Producer:   Producer,
+		Payload:    json.RawMessage(event.Payload),
+	}
+}
@@ -19,11 +19,32 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	FollowerService_Follow_FullMethodName       = "/follower.FollowerService/Follow"
-	FollowerService_Unfollow_FullMethodName     = "/follower.FollowerService/Unfollow"
-	FollowerService_GetFollowing_FullMethodName = "/follower.FollowerService/GetFollowing"
-	FollowerService_GetFollowers_FullMethodName = "/follower.FollowerService/GetFollowers"
-	FollowerService_Recommend_FullMethodName    = "/follower.FollowerService/Recommend"
+	FollowerService_Follow_FullMethodName                = "/follower.FollowerService/Follow"
+	FollowerService_Unfollow_FullMethodName              = "/follower.FollowerService/Unfollow"
+	FollowerService_GetFollowing_FullMethodName          = "/follower.FollowerService/GetFollowing"
+	FollowerService_GetFollowers_FullMethodName          = "/follower.FollowerService/GetFollowers"
+	FollowerService_GetFollowCounts_FullMethodName       = "/follower.FollowerService/GetFollowCounts"
+	FollowerService_IsFollowing_FullMethodName           = "/follower.FollowerService/IsFollowing"
+	FollowerService_GetRelationshipStatus_FullMethodName = "/follower.FollowerService/GetRelationshipStatus"
+	FollowerService_GetMutualFollowers_FullMethodName    = "/follower.FollowerService/GetMutualFollowers"
+	FollowerService_Recommend_FullMethodName             = "/follower.FollowerService/Recommend"
+	FollowerService_DismissRecommendation_FullMethodName = "/follower.FollowerService/DismissRecommendation"
+	FollowerService_SetAccountPrivacy_FullMethodName     = "/follower.FollowerService/SetAccountPrivacy"
+	FollowerService_GetFollowRequests_FullMethodName     = "/follower.FollowerService/GetFollowRequests"
+	FollowerService_ApproveFollowRequest_FullMethodName  = "/follower.FollowerService/ApproveFollowRequest"
+	FollowerService_RejectFollowRequest_FullMethodName   = "/follower.FollowerService/RejectFollowRequest"
+	FollowerService_Block_FullMethodName                 = "/follower.FollowerService/Block"
+	FollowerService_Unblock_FullMethodName               = "/follower.FollowerService/Unblock"
+	FollowerService_GetBlocked_FullMethodName            = "/follower.FollowerService/GetBlocked"
+	FollowerService_Mute_FullMethodName                  = "/follower.FollowerService/Mute"
+	FollowerService_Unmute_FullMethodName                = "/follower.FollowerService/Unmute"
+	FollowerService_GetMuted_FullMethodName              = "/follower.FollowerService/GetMuted"
+	FollowerService_ReconcileUsers_FullMethodName        = "/follower.FollowerService/ReconcileUsers"
+	FollowerService_ExportGraph_FullMethodName           = "/follower.FollowerService/ExportGraph"
+	FollowerService_ImportGraph_FullMethodName           = "/follower.FollowerService/ImportGraph"
+	FollowerService_RenameUser_FullMethodName            = "/follower.FollowerService/RenameUser"
+	FollowerService_DeleteUser_FullMethodName            = "/follower.FollowerService/DeleteUser"
+	FollowerService_GetRestrictions_FullMethodName       = "/follower.FollowerService/GetRestrictions"
 )
 
 // FollowerServiceClient is the client API for FollowerService service.
@@ -34,7 +55,38 @@ type FollowerServiceClient interface {
 	Unfollow(ctx context.Context, in *UnfollowRequest, opts ...grpc.CallOption) (*UnfollowResponse, error)
 	GetFollowing(ctx context.Context, in *GetFollowingRequest, opts ...grpc.CallOption) (*GetFollowingResponse, error)
 	GetFollowers(ctx context.Context, in *GetFollowersRequest, opts ...grpc.CallOption) (*GetFollowersResponse, error)
+	GetFollowCounts(ctx context.Context, in *GetFollowCountsRequest, opts ...grpc.CallOption) (*GetFollowCountsResponse, error)
+	IsFollowing(ctx context.Context, in *IsFollowingRequest, opts ...grpc.CallOption) (*IsFollowingResponse, error)
+	// Odnos trenutnog korisnika prema svakom od navedenih korisnika
+	GetRelationshipStatus(ctx context.Context, in *GetRelationshipStatusRequest, opts ...grpc.CallOption) (*GetRelationshipStatusResponse, error)
+	// Korisnici koji prate i username i other
+	GetMutualFollowers(ctx context.Context, in *GetMutualFollowersRequest, opts ...grpc.CallOption) (*GetMutualFollowersResponse, error)
 	Recommend(ctx context.Context, in *RecommendRequest, opts ...grpc.CallOption) (*RecommendResponse, error)
+	// Odbacena preporuka se vise ne nudi korisniku.
+	DismissRecommendation(ctx context.Context, in *DismissRecommendationRequest, opts ...grpc.CallOption) (*DismissRecommendationResponse, error)
+	SetAccountPrivacy(ctx context.Context, in *SetAccountPrivacyRequest, opts ...grpc.CallOption) (*SetAccountPrivacyResponse, error)
+	GetFollowRequests(ctx context.Context, in *GetFollowRequestsRequest, opts ...grpc.CallOption) (*GetFollowRequestsResponse, error)
+	ApproveFollowRequest(ctx context.Context, in *ApproveFollowRequestRequest, opts ...grpc.CallOption) (*ApproveFollowRequestResponse, error)
+	RejectFollowRequest(ctx context.Context, in *RejectFollowRequestRequest, opts ...grpc.CallOption) (*RejectFollowRequestResponse, error)
+	Block(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error)
+	Unblock(ctx context.Context, in *UnblockRequest, opts ...grpc.CallOption) (*UnblockResponse, error)
+	GetBlocked(ctx context.Context, in *GetBlockedRequest, opts ...grpc.CallOption) (*GetBlockedResponse, error)
+	Mute(ctx context.Context, in *MuteRequest, opts ...grpc.CallOption) (*MuteResponse, error)
+	Unmute(ctx context.Context, in *UnmuteRequest, opts ...grpc.CallOption) (*UnmuteResponse, error)
+	GetMuted(ctx context.Context, in *GetMutedRequest, opts ...grpc.CallOption) (*GetMutedResponse, error)
+	// Uklanja User cvorove za koje u stakeholders-service vise ne postoji
+	// korisnik. Dostupno samo administratoru.
+	ReconcileUsers(ctx context.Context, in *ReconcileUsersRequest, opts ...grpc.CallOption) (*ReconcileUsersResponse, error)
+	// Izvoz FOLLOWS grafa u CSV ili NDJSON formatu, stranicu po stranicu.
+	// Dostupno samo administratoru, kao i ostale operacije nad grafom.
+	ExportGraph(ctx context.Context, in *ExportGraphRequest, opts ...grpc.CallOption) (*ExportGraphResponse, error)
+	// Uvoz FOLLOWS veza u grupama; ponovni uvoz istih podataka ne menja graf.
+	ImportGraph(ctx context.Context, in *ImportGraphRequest, opts ...grpc.CallOption) (*ImportGraphResponse, error)
+	RenameUser(ctx context.Context, in *RenameUserRequest, opts ...grpc.CallOption) (*RenameUserResponse, error)
+	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
+	// Interni poziv za druge servise, nije izlozen preko gateway-a jer otkriva
+	// ko je blokirao korisnika.
+	GetRestrictions(ctx context.Context, in *GetRestrictionsRequest, opts ...grpc.CallOption) (*GetRestrictionsResponse, error)
 }
 
 type followerServiceClient struct {
@@ -85,6 +137,46 @@ func (c *followerServiceClient) GetFollowers(ctx context.Context, in *GetFollowe
 	return out, nil
 }
 
+func (c *followerServiceClient) GetFollowCounts(ctx context.Context, in *GetFollowCountsRequest, opts ...grpc.CallOption) (*GetFollowCountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFollowCountsResponse)
+	err := c.cc.Invoke(ctx, FollowerService_GetFollowCounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followerServiceClient) IsFollowing(ctx context.Context, in *IsFollowingRequest, opts ...grpc.CallOption) (*IsFollowingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsFollowingResponse)
+	err := c.cc.Invoke(ctx, FollowerService_IsFollowing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followerServiceClient) GetRelationshipStatus(ctx context.Context, in *GetRelationshipStatusRequest, opts ...grpc.CallOption) (*GetRelationshipStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRelationshipStatusResponse)
+	err := c.cc.Invoke(ctx, FollowerService_GetRelationshipStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followerServiceClient) GetMutualFollowers(ctx context.Context, in *GetMutualFollowersRequest, opts ...grpc.CallOption) (*GetMutualFollowersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMutualFollowersResponse)
+	err := c.cc.Invoke(ctx, FollowerService_GetMutualFollowers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *followerServiceClient) Recommend(ctx context.Context, in *RecommendRequest, opts ...grpc.CallOption) (*RecommendResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RecommendResponse)
@@ -95,6 +187,176 @@ func (c *followerServiceClient) Recommend(ctx context.Context, in *RecommendRequ
 	return out, nil
 }
 
+func (c *followerServiceClient) DismissRecommendation(ctx context.Context, in *DismissRecommendationRequest, opts ...grpc.CallOption) (*DismissRecommendationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DismissRecommendationResponse)
+	err := c.cc.Invoke(ctx, FollowerService_DismissRecommendation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followerServiceClient) SetAccountPrivacy(ctx context.Context, in *SetAccountPrivacyRequest, opts ...grpc.CallOption) (*SetAccountPrivacyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAccountPrivacyResponse)
+	err := c.cc.Invoke(ctx, FollowerService_SetAccountPrivacy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followerServiceClient) GetFollowRequests(ctx context.Context, in *GetFollowRequestsRequest, opts ...grpc.CallOption) (*GetFollowRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFollowRequestsResponse)
+	err := c.cc.Invoke(ctx, FollowerService_GetFollowRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followerServiceClient) ApproveFollowRequest(ctx context.Context, in *ApproveFollowRequestRequest, opts ...grpc.CallOption) (*ApproveFollowRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveFollowRequestResponse)
+	err := c.cc.Invoke(ctx, FollowerService_ApproveFollowRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followerServiceClient) RejectFollowRequest(ctx context.Context, in *RejectFollowRequestRequest, opts ...grpc.CallOption) (*RejectFollowRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectFollowRequestResponse)
+	err := c.cc.Invoke(ctx, FollowerService_RejectFollowRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followerServiceClient) Block(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockResponse)
+	err := c.cc.Invoke(ctx, FollowerService_Block_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followerServiceClient) Unblock(ctx context.Context, in *UnblockRequest, opts ...grpc.CallOption) (*UnblockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnblockResponse)
+	err := c.cc.Invoke(ctx, FollowerService_Unblock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followerServiceClient) GetBlocked(ctx context.Context, in *GetBlockedRequest, opts ...grpc.CallOption) (*GetBlockedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBlockedResponse)
+	err := c.cc.Invoke(ctx, FollowerService_GetBlocked_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followerServiceClient) Mute(ctx context.Context, in *MuteRequest, opts ...grpc.CallOption) (*MuteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MuteResponse)
+	err := c.cc.Invoke(ctx, FollowerService_Mute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followerServiceClient) Unmute(ctx context.Context, in *UnmuteRequest, opts ...grpc.CallOption) (*UnmuteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnmuteResponse)
+	err := c.cc.Invoke(ctx, FollowerService_Unmute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followerServiceClient) GetMuted(ctx context.Context, in *GetMutedRequest, opts ...grpc.CallOption) (*GetMutedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMutedResponse)
+	err := c.cc.Invoke(ctx, FollowerService_GetMuted_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followerServiceClient) ReconcileUsers(ctx context.Context, in *ReconcileUsersRequest, opts ...grpc.CallOption) (*ReconcileUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconcileUsersResponse)
+	err := c.cc.Invoke(ctx, FollowerService_ReconcileUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followerServiceClient) ExportGraph(ctx context.Context, in *ExportGraphRequest, opts ...grpc.CallOption) (*ExportGraphResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportGraphResponse)
+	err := c.cc.Invoke(ctx, FollowerService_ExportGraph_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followerServiceClient) ImportGraph(ctx context.Context, in *ImportGraphRequest, opts ...grpc.CallOption) (*ImportGraphResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportGraphResponse)
+	err := c.cc.Invoke(ctx, FollowerService_ImportGraph_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followerServiceClient) RenameUser(ctx context.Context, in *RenameUserRequest, opts ...grpc.CallOption) (*RenameUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenameUserResponse)
+	err := c.cc.Invoke(ctx, FollowerService_RenameUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followerServiceClient) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteUserResponse)
+	err := c.cc.Invoke(ctx, FollowerService_DeleteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followerServiceClient) GetRestrictions(ctx context.Context, in *GetRestrictionsRequest, opts ...grpc.CallOption) (*GetRestrictionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRestrictionsResponse)
+	err := c.cc.Invoke(ctx, FollowerService_GetRestrictions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FollowerServiceServer is the server API for FollowerService service.
 // All implementations must embed UnimplementedFollowerServiceServer
 // for forward compatibility.
@@ -103,7 +365,38 @@ type FollowerServiceServer interface {
 	Unfollow(context.Context, *UnfollowRequest) (*UnfollowResponse, error)
 	GetFollowing(context.Context, *GetFollowingRequest) (*GetFollowingResponse, error)
 	GetFollowers(context.Context, *GetFollowersRequest) (*GetFollowersResponse, error)
+	GetFollowCounts(context.Context, *GetFollowCountsRequest) (*GetFollowCountsResponse, error)
+	IsFollowing(context.Context, *IsFollowingRequest) (*IsFollowingResponse, error)
+	// Odnos trenutnog korisnika prema svakom od navedenih korisnika
+	GetRelationshipStatus(context.Context, *GetRelationshipStatusRequest) (*GetRelationshipStatusResponse, error)
+	// Korisnici koji prate i username i other
+	GetMutualFollowers(context.Context, *GetMutualFollowersRequest) (*GetMutualFollowersResponse, error)
 	Recommend(context.Context, *RecommendRequest) (*RecommendResponse, error)
+	// Odbacena preporuka se vise ne nudi korisniku.
+	DismissRecommendation(context.Context, *DismissRecommendationRequest) (*DismissRecommendationResponse, error)
+	SetAccountPrivacy(context.Context, *SetAccountPrivacyRequest) (*SetAccountPrivacyResponse, error)
+	GetFollowRequests(context.Context, *GetFollowRequestsRequest) (*GetFollowRequestsResponse, error)
+	ApproveFollowRequest(context.Context, *ApproveFollowRequestRequest) (*ApproveFollowRequestResponse, error)
+	RejectFollowRequest(context.Context, *RejectFollowRequestRequest) (*RejectFollowRequestResponse, error)
+	Block(context.Context, *BlockRequest) (*BlockResponse, error)
+	Unblock(context.Context, *UnblockRequest) (*UnblockResponse, error)
+	GetBlocked(context.Context, *GetBlockedRequest) (*GetBlockedResponse, error)
+	Mute(context.Context, *MuteRequest) (*MuteResponse, error)
+	Unmute(context.Context, *UnmuteRequest) (*UnmuteResponse, error)
+	GetMuted(context.Context, *GetMutedRequest) (*GetMutedResponse, error)
+	// Uklanja User cvorove za koje u stakeholders-service vise ne postoji
+	// korisnik. Dostupno samo administratoru.
+	ReconcileUsers(context.Context, *ReconcileUsersRequest) (*ReconcileUsersResponse, error)
+	// Izvoz FOLLOWS grafa u CSV ili NDJSON formatu, stranicu po stranicu.
+	// Dostupno samo administratoru, kao i ostale operacije nad grafom.
+	ExportGraph(context.Context, *ExportGraphRequest) (*ExportGraphResponse, error)
+	// Uvoz FOLLOWS veza u grupama; ponovni uvoz istih podataka ne menja graf.
+	ImportGraph(context.Context, *ImportGraphRequest) (*ImportGraphResponse, error)
+	RenameUser(context.Context, *RenameUserRequest) (*RenameUserResponse, error)
+	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	// Interni poziv za druge servise, nije izlozen preko gateway-a jer otkriva
+	// ko je blokirao korisnika.
+	GetRestrictions(context.Context, *GetRestrictionsRequest) (*GetRestrictionsResponse, error)
 	mustEmbedUnimplementedFollowerServiceServer()
 }
 
@@ -126,9 +419,72 @@ func (UnimplementedFollowerServiceServer) GetFollowing(context.Context, *GetFoll
 func (UnimplementedFollowerServiceServer) GetFollowers(context.Context, *GetFollowersRequest) (*GetFollowersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFollowers not implemented")
 }
+func (UnimplementedFollowerServiceServer) GetFollowCounts(context.Context, *GetFollowCountsRequest) (*GetFollowCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowCounts not implemented")
+}
+func (UnimplementedFollowerServiceServer) IsFollowing(context.Context, *IsFollowingRequest) (*IsFollowingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsFollowing not implemented")
+}
+func (UnimplementedFollowerServiceServer) GetRelationshipStatus(context.Context, *GetRelationshipStatusRequest) (*GetRelationshipStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelationshipStatus not implemented")
+}
+func (UnimplementedFollowerServiceServer) GetMutualFollowers(context.Context, *GetMutualFollowersRequest) (*GetMutualFollowersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMutualFollowers not implemented")
+}
 func (UnimplementedFollowerServiceServer) Recommend(context.Context, *RecommendRequest) (*RecommendResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Recommend not implemented")
 }
+func (UnimplementedFollowerServiceServer) DismissRecommendation(context.Context, *DismissRecommendationRequest) (*DismissRecommendationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DismissRecommendation not implemented")
+}
+func (UnimplementedFollowerServiceServer) SetAccountPrivacy(context.Context, *SetAccountPrivacyRequest) (*SetAccountPrivacyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAccountPrivacy not implemented")
+}
+func (UnimplementedFollowerServiceServer) GetFollowRequests(context.Context, *GetFollowRequestsRequest) (*GetFollowRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowRequests not implemented")
+}
+func (UnimplementedFollowerServiceServer) ApproveFollowRequest(context.Context, *ApproveFollowRequestRequest) (*ApproveFollowRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveFollowRequest not implemented")
+}
+func (UnimplementedFollowerServiceServer) RejectFollowRequest(context.Context, *RejectFollowRequestRequest) (*RejectFollowRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectFollowRequest not implemented")
+}
+func (UnimplementedFollowerServiceServer) Block(context.Context, *BlockRequest) (*BlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Block not implemented")
+}
+func (UnimplementedFollowerServiceServer) Unblock(context.Context, *UnblockRequest) (*UnblockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unblock not implemented")
+}
+func (UnimplementedFollowerServiceServer) GetBlocked(context.Context, *GetBlockedRequest) (*GetBlockedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlocked not implemented")
+}
+func (UnimplementedFollowerServiceServer) Mute(context.Context, *MuteRequest) (*MuteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mute not implemented")
+}
+func (UnimplementedFollowerServiceServer) Unmute(context.Context, *UnmuteRequest) (*UnmuteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unmute not implemented")
+}
+func (UnimplementedFollowerServiceServer) GetMuted(context.Context, *GetMutedRequest) (*GetMutedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMuted not implemented")
+}
+func (UnimplementedFollowerServiceServer) ReconcileUsers(context.Context, *ReconcileUsersRequest) (*ReconcileUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileUsers not implemented")
+}
+func (UnimplementedFollowerServiceServer) ExportGraph(context.Context, *ExportGraphRequest) (*ExportGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportGraph not implemented")
+}
+func (UnimplementedFollowerServiceServer) ImportGraph(context.Context, *ImportGraphRequest) (*ImportGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportGraph not implemented")
+}
+func (UnimplementedFollowerServiceServer) RenameUser(context.Context, *RenameUserRequest) (*RenameUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameUser not implemented")
+}
+func (UnimplementedFollowerServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedFollowerServiceServer) GetRestrictions(context.Context, *GetRestrictionsRequest) (*GetRestrictionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRestrictions not implemented")
+}
 func (UnimplementedFollowerServiceServer) mustEmbedUnimplementedFollowerServiceServer() {}
 func (UnimplementedFollowerServiceServer) testEmbeddedByValue()                         {}
 
@@ -222,6 +578,78 @@ func _FollowerService_GetFollowers_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FollowerService_GetFollowCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowerServiceServer).GetFollowCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowerService_GetFollowCounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowerServiceServer).GetFollowCounts(ctx, req.(*GetFollowCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowerService_IsFollowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsFollowingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowerServiceServer).IsFollowing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowerService_IsFollowing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowerServiceServer).IsFollowing(ctx, req.(*IsFollowingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowerService_GetRelationshipStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRelationshipStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowerServiceServer).GetRelationshipStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowerService_GetRelationshipStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowerServiceServer).GetRelationshipStatus(ctx, req.(*GetRelationshipStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowerService_GetMutualFollowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMutualFollowersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowerServiceServer).GetMutualFollowers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowerService_GetMutualFollowers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowerServiceServer).GetMutualFollowers(ctx, req.(*GetMutualFollowersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FollowerService_Recommend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RecommendRequest)
 	if err := dec(in); err != nil {
@@ -240,6 +668,312 @@ func _FollowerService_Recommend_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FollowerService_DismissRecommendation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DismissRecommendationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowerServiceServer).DismissRecommendation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowerService_DismissRecommendation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowerServiceServer).DismissRecommendation(ctx, req.(*DismissRecommendationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowerService_SetAccountPrivacy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAccountPrivacyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowerServiceServer).SetAccountPrivacy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowerService_SetAccountPrivacy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowerServiceServer).SetAccountPrivacy(ctx, req.(*SetAccountPrivacyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowerService_GetFollowRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowerServiceServer).GetFollowRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowerService_GetFollowRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowerServiceServer).GetFollowRequests(ctx, req.(*GetFollowRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowerService_ApproveFollowRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveFollowRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowerServiceServer).ApproveFollowRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowerService_ApproveFollowRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowerServiceServer).ApproveFollowRequest(ctx, req.(*ApproveFollowRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowerService_RejectFollowRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectFollowRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowerServiceServer).RejectFollowRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowerService_RejectFollowRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowerServiceServer).RejectFollowRequest(ctx, req.(*RejectFollowRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowerService_Block_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowerServiceServer).Block(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowerService_Block_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowerServiceServer).Block(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowerService_Unblock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowerServiceServer).Unblock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowerService_Unblock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowerServiceServer).Unblock(ctx, req.(*UnblockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowerService_GetBlocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowerServiceServer).GetBlocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowerService_GetBlocked_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowerServiceServer).GetBlocked(ctx, req.(*GetBlockedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowerService_Mute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowerServiceServer).Mute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowerService_Mute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowerServiceServer).Mute(ctx, req.(*MuteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowerService_Unmute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmuteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowerServiceServer).Unmute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowerService_Unmute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowerServiceServer).Unmute(ctx, req.(*UnmuteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowerService_GetMuted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMutedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowerServiceServer).GetMuted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowerService_GetMuted_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowerServiceServer).GetMuted(ctx, req.(*GetMutedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowerService_ReconcileUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowerServiceServer).ReconcileUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowerService_ReconcileUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowerServiceServer).ReconcileUsers(ctx, req.(*ReconcileUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowerService_ExportGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowerServiceServer).ExportGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowerService_ExportGraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowerServiceServer).ExportGraph(ctx, req.(*ExportGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowerService_ImportGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowerServiceServer).ImportGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowerService_ImportGraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowerServiceServer).ImportGraph(ctx, req.(*ImportGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowerService_RenameUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowerServiceServer).RenameUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowerService_RenameUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowerServiceServer).RenameUser(ctx, req.(*RenameUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowerService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowerServiceServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowerService_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowerServiceServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowerService_GetRestrictions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRestrictionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowerServiceServer).GetRestrictions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowerService_GetRestrictions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowerServiceServer).GetRestrictions(ctx, req.(*GetRestrictionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FollowerService_ServiceDesc is the grpc.ServiceDesc for FollowerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -263,10 +997,94 @@ var FollowerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFollowers",
 			Handler:    _FollowerService_GetFollowers_Handler,
 		},
+		{
+			MethodName: "GetFollowCounts",
+			Handler:    _FollowerService_GetFollowCounts_Handler,
+		},
+		{
+			MethodName: "IsFollowing",
+			Handler:    _FollowerService_IsFollowing_Handler,
+		},
+		{
+			MethodName: "GetRelationshipStatus",
+			Handler:    _FollowerService_GetRelationshipStatus_Handler,
+		},
+		{
+			MethodName: "GetMutualFollowers",
+			Handler:    _FollowerService_GetMutualFollowers_Handler,
+		},
 		{
 			MethodName: "Recommend",
 			Handler:    _FollowerService_Recommend_Handler,
 		},
+		{
+			MethodName: "DismissRecommendation",
+			Handler:    _FollowerService_DismissRecommendation_Handler,
+		},
+		{
+			MethodName: "SetAccountPrivacy",
+			Handler:    _FollowerService_SetAccountPrivacy_Handler,
+		},
+		{
+			MethodName: "GetFollowRequests",
+			Handler:    _FollowerService_GetFollowRequests_Handler,
+		},
+		{
+			MethodName: "ApproveFollowRequest",
+			Handler:    _FollowerService_ApproveFollowRequest_Handler,
+		},
+		{
+			MethodName: "RejectFollowRequest",
+			Handler:    _FollowerService_RejectFollowRequest_Handler,
+		},
+		{
+			MethodName: "Block",
+			Handler:    _FollowerService_Block_Handler,
+		},
+		{
+			MethodName: "Unblock",
+			Handler:    _FollowerService_Unblock_Handler,
+		},
+		{
+			MethodName: "GetBlocked",
+			Handler:    _FollowerService_GetBlocked_Handler,
+		},
+		{
+			MethodName: "Mute",
+			Handler:    _FollowerService_Mute_Handler,
+		},
+		{
+			MethodName: "Unmute",
+			Handler:    _FollowerService_Unmute_Handler,
+		},
+		{
+			MethodName: "GetMuted",
+			Handler:    _FollowerService_GetMuted_Handler,
+		},
+		{
+			MethodName: "ReconcileUsers",
+			Handler:    _FollowerService_ReconcileUsers_Handler,
+		},
+		{
+			MethodName: "ExportGraph",
+			Handler:    _FollowerService_ExportGraph_Handler,
+		},
+		{
+			MethodName: "ImportGraph",
+			Handler:    _FollowerService_ImportGraph_Handler,
+		},
+		{
+			MethodName: "RenameUser",
+			Handler:    _FollowerService_RenameUser_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _FollowerService_DeleteUser_Handler,
+		},
+		{
+			MethodName: "GetRestrictions",
+			Handler:    _FollowerService_GetRestrictions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "follower/follower.proto",
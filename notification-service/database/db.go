@@ -0,0 +1,65 @@
+package database
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"notification-service/models"
+)
+
+var GORM_DB *gorm.DB
+
+func InitDB(connStr string) {
+	if connStr == "" {
+		log.Fatal("Greška: Environment varijabla NOTIFICATION_DATABASE_URL nije postavljena.")
+	}
+
+	var err error
+	GORM_DB, err = gorm.Open(postgres.Open(connStr), &gorm.Config{})
+	if err != nil {
+		log.Fatalf("Greška pri povezivanju sa bazom podataka koristeći GORM: %v", err)
+	}
+
+	fmt.Println("Uspješno povezano sa PostgreSQL bazom podataka koristeći GORM!")
+
+	if err := GORM_DB.AutoMigrate(&models.Notification{}, &models.EmailJob{}); err != nil {
+		log.Fatalf("Greška pri automatskoj migraciji šeme baze podataka: %v", err)
+	}
+
+	// Broj neprocitanih se trazi pri svakom ucitavanju stranice, a
+	// neprocitanih je malo u odnosu na sve notifikacije
+	err = GORM_DB.Exec(`
+		CREATE INDEX IF NOT EXISTS idx_notification_unread
+		ON notifications (recipient_id) WHERE NOT read;
+	`).Error
+	if err != nil {
+		log.Printf("Upozorenje: Greška pri kreiranju indeksa 'idx_notification_unread': %v", err)
+	}
+
+	sqlDB, err := GORM_DB.DB()
+	if err != nil {
+		log.Fatalf("Greška pri dobijanju underlying *sql.DB iz GORM-a: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(25)
+	sqlDB.SetMaxIdleConns(25)
+	sqlDB.SetConnMaxLifetime(5 * time.Minute)
+}
+
+func CloseDB() {
+	if GORM_DB != nil {
+		sqlDB, err := GORM_DB.DB()
+		if err != nil {
+			log.Printf("Error getting underlying *sql.DB to close: %v", err)
+		} else {
+			err = sqlDB.Close()
+			if err != nil {
+				log.Printf("Error closing GORM database connection: %v", err)
+			}
+			fmt.Println("GORM konekcija sa bazom podataka zatvorena.")
+		}
+	}
+}
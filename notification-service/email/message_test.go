@@ -0,0 +1,111 @@
+package email
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"strings"
+	"testing"
+)
+
+func parseMessage(t *testing.T, msg Message) *mail.Message {
+	t.Helper()
+	data, err := msg.bytes("Tours <no-reply@tours.test>")
+	if err != nil {
+		t.Fatalf("bytes: %v", err)
+	}
+	parsed, err := mail.ReadMessage(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("ReadMessage: %v", err)
+	}
+	return parsed
+}
+
+func TestMessageBytesHeaders(t *testing.T) {
+	parsed := parseMessage(t, Message{To: "ana@example.com", Subject: "Zdravo, Čačak", Text: "tekst"})
+
+	if got := parsed.Header.Get("From"); got != "Tours <no-reply@tours.test>" {
+		t.Errorf("From = %q", got)
+	}
+	if got := parsed.Header.Get("To"); got != "ana@example.com" {
+		t.Errorf("To = %q", got)
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	if err != nil || subject != "Zdravo, Čačak" {
+		t.Errorf("Subject = %q (%v)", subject, err)
+	}
+	if _, err := parsed.Header.Date(); err != nil {
+		t.Errorf("Date: %v", err)
+	}
+	if id := parsed.Header.Get("Message-ID"); !strings.HasSuffix(id, "@tours.test>") {
+		t.Errorf("Message-ID = %q", id)
+	}
+	if got := parsed.Header.Get("MIME-Version"); got != "1.0" {
+		t.Errorf("MIME-Version = %q", got)
+	}
+}
+
+func TestMessageBytesPlainText(t *testing.T) {
+	text := "Prvi red\nlink: http://app/reset-password?token=" + strings.Repeat("a", 100) + "\n"
+	parsed := parseMessage(t, Message{To: "ana@example.com", Subject: "s", Text: text})
+
+	if got := parsed.Header.Get("Content-Type"); got != "text/plain; charset=utf-8" {
+		t.Errorf("Content-Type = %q", got)
+	}
+	if got := parsed.Header.Get("Content-Transfer-Encoding"); got != "quoted-printable" {
+		t.Errorf("Content-Transfer-Encoding = %q", got)
+	}
+	// Dugi redovi se lome, a krajevi redova postaju CRLF
+	want := strings.ReplaceAll(text, "\n", "\r\n")
+	if body := decodeQP(t, parsed.Body); body != want {
+		t.Errorf("body = %q, want %q", body, want)
+	}
+}
+
+func TestMessageBytesMultipart(t *testing.T) {
+	msg := Message{To: "ana@example.com", Subject: "s", Text: "tekst = verzija", HTML: "<p>html verzija</p>"}
+	parsed := parseMessage(t, msg)
+
+	mediaType, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/alternative" {
+		t.Fatalf("Content-Type = %q (%v)", parsed.Header.Get("Content-Type"), err)
+	}
+
+	mr := multipart.NewReader(parsed.Body, params["boundary"])
+	want := []struct{ contentType, body string }{
+		{"text/plain; charset=utf-8", msg.Text},
+		{"text/html; charset=utf-8", msg.HTML},
+	}
+	for _, w := range want {
+		part, err := mr.NextPart()
+		if err != nil {
+			t.Fatalf("NextPart: %v", err)
+		}
+		if got := part.Header.Get("Content-Type"); got != w.contentType {
+			t.Errorf("part Content-Type = %q, want %q", got, w.contentType)
+		}
+		// multipart.Reader uklanja Content-Transfer-Encoding i dekodira deo
+		body, err := io.ReadAll(part)
+		if err != nil {
+			t.Fatalf("read part: %v", err)
+		}
+		if string(body) != w.body {
+			t.Errorf("part body = %q, want %q", body, w.body)
+		}
+	}
+	if _, err := mr.NextPart(); err != io.EOF {
+		t.Errorf("expected exactly two parts, got %v", err)
+	}
+}
+
+func decodeQP(t *testing.T, r io.Reader) string {
+	t.Helper()
+	body, err := io.ReadAll(quotedprintable.NewReader(r))
+	if err != nil {
+		t.Fatalf("decode quoted-printable: %v", err)
+	}
+	return string(body)
+}
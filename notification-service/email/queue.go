@@ -0,0 +1,282 @@
+package email
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"notification-service/database"
+	"notification-service/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Poslati email-ovi se brisu posle sentRetention, a neuspeli, koji ostaju
+// radi provere, posle failedRetention.
+const (
+	sentRetention   = 7 * 24 * time.Hour
+	failedRetention = 30 * 24 * time.Hour
+)
+
+// Podaci ovih sablona sadrze token za nalog, pa se brisu cim email vise ne
+// ceka na slanje, da token ne bi ostao u bazi.
+var secretTemplates = map[string]bool{
+	TemplateVerification:  true,
+	TemplatePasswordReset: true,
+}
+
+var errNoRecipient = errors.New("recipient has no email address")
+
+// Config odredjuje koliko cesto se red proverava i posle koliko neuspelih
+// pokusaja se email odbacuje.
+type Config struct {
+	Interval    time.Duration
+	MaxAttempts int
+	BatchSize   int
+}
+
+// ConfigFromEnv cita EMAIL_POLL_INTERVAL i EMAIL_MAX_ATTEMPTS.
+func ConfigFromEnv() Config {
+	cfg := Config{Interval: 2 * time.Second, MaxAttempts: 8, BatchSize: 20}
+	if value := os.Getenv("EMAIL_POLL_INTERVAL"); value != "" {
+		if d, err := time.ParseDuration(value); err == nil && d > 0 {
+			cfg.Interval = d
+		} else {
+			log.Printf("Invalid EMAIL_POLL_INTERVAL %q, using %s", value, cfg.Interval)
+		}
+	}
+	if value := os.Getenv("EMAIL_MAX_ATTEMPTS"); value != "" {
+		if n, err := strconv.Atoi(value); err == nil && n > 0 {
+			cfg.MaxAttempts = n
+		} else {
+			log.Printf("Invalid EMAIL_MAX_ATTEMPTS %q, using %d", value, cfg.MaxAttempts)
+		}
+	}
+	return cfg
+}
+
+// Enqueue stavlja u red email za adresu to. Email sa istim dedupKey se ne
+// dodaje ponovo.
+func Enqueue(tx *gorm.DB, dedupKey, to, template string, data any) error {
+	return enqueue(tx, models.EmailJob{DedupKey: dedupKey, To: to, Template: template}, data)
+}
+
+// EnqueueForUser stavlja u red email za korisnika sa ID-jem userID. Adresa i
+// ime se razresavaju tek pri slanju, pa se nedostupan stakeholders-service
+// ponavlja kao i neuspelo slanje. Ime primaoca se upisuje u podatke kao
+// Username.
+func EnqueueForUser(tx *gorm.DB, dedupKey, userID, template string, data any) error {
+	return enqueue(tx, models.EmailJob{DedupKey: dedupKey, RecipientUserID: userID, Template: template}, data)
+}
+
+func enqueue(tx *gorm.DB, job models.EmailJob, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	job.ID = uuid.New()
+	job.Data = string(payload)
+	job.Status = models.EmailPending
+	job.NextAttemptAt = time.Now().UTC()
+	return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&job).Error
+}
+
+// Contact je adresa i ime korisnika kome se salje email.
+type Contact struct {
+	Email string
+	Name  string
+}
+
+// ContactResolver vraca kontakt korisnika sa datim ID-jem. ok je false ako
+// korisniku ne treba slati email (ne postoji, blokiran je ili nema adresu).
+type ContactResolver func(ctx context.Context, userID string) (contact Contact, ok bool, err error)
+
+// StartWorker salje email-ove iz reda. Neuspelo slanje ili razresavanje
+// adrese se ponavlja sa eksponencijalnim razmakom (od 1 minuta do 1 sata), a
+// posle MaxAttempts pokusaja email ostaje u redu sa statusom failed.
+func StartWorker(ctx context.Context, transport Transport, contacts ContactResolver, cfg Config) {
+	go func() {
+		ticker := time.NewTicker(cfg.Interval)
+		defer ticker.Stop()
+		lastPurge := time.Time{}
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			for {
+				n, err := sendBatch(ctx, transport, contacts, cfg)
+				if err != nil {
+					log.Printf("[email] queue failed: %v", err)
+					break
+				}
+				if n < cfg.BatchSize {
+					break
+				}
+			}
+
+			if time.Since(lastPurge) > time.Hour {
+				purge()
+				lastPurge = time.Now()
+			}
+		}
+	}()
+}
+
+// claimLease je vreme za koje je preuzeti email rezervisan za repliku koja ga
+// salje. Mora biti duze od slanja cele serije (BatchSize x smtpTimeout), inace
+// bi ga druga replika preuzela dok je slanje u toku.
+const claimLease = 15 * time.Minute
+
+// sendBatch obradjuje najvise BatchSize dospelih email-ova. Email-ovi se prvo
+// preuzimaju u kratkoj transakciji, salju se van nje, a rezultat svakog se
+// upisuje posebno, pa greska pri upisu jednog ne ponavlja vec poslate.
+func sendBatch(ctx context.Context, transport Transport, contacts ContactResolver, cfg Config) (int, error) {
+	jobs, err := claim(cfg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, job := range jobs {
+		updates := send(ctx, transport, contacts, job, cfg.MaxAttempts)
+		if _, done := updates["status"]; done && secretTemplates[job.Template] {
+			updates["data"] = "{}"
+		}
+		// Rezultat se ne upisuje ako je email u medjuvremenu preuzela druga
+		// replika (posle isteka zakupa)
+		err := database.GORM_DB.Model(&models.EmailJob{}).
+			Where("id = ? AND attempts = ?", job.ID, job.Attempts).
+			Updates(updates).Error
+		if err != nil {
+			log.Printf("[email] cannot record result for %s: %v", job.ID, err)
+		}
+	}
+	return len(jobs), nil
+}
+
+// claim preuzima dospele email-ove: povecava broj pokusaja i pomera
+// next_attempt_at za claimLease, tako da ih druge replike ne uzmu dok traje
+// slanje. SKIP LOCKED omogucava da vise replika preuzima istovremeno. Ako
+// replika padne posle preuzimanja, email se ponovo salje kada zakup istekne.
+func claim(limit int) ([]models.EmailJob, error) {
+	var jobs []models.EmailJob
+	err := database.GORM_DB.Transaction(func(tx *gorm.DB) error {
+		now := time.Now().UTC()
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND next_attempt_at <= ?", models.EmailPending, now).
+			Order("next_attempt_at").
+			Limit(limit).
+			Find(&jobs).Error
+		if err != nil || len(jobs) == 0 {
+			return err
+		}
+
+		ids := make([]uuid.UUID, len(jobs))
+		for i := range jobs {
+			ids[i] = jobs[i].ID
+			jobs[i].Attempts++
+		}
+		return tx.Model(&models.EmailJob{}).Where("id IN ?", ids).Updates(map[string]any{
+			"attempts":        gorm.Expr("attempts + 1"),
+			"next_attempt_at": now.Add(claimLease),
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// send salje jedan preuzeti email i vraca izmene za njegov red. job.Attempts
+// vec ukljucuje ovaj pokusaj.
+func send(ctx context.Context, transport Transport, contacts ContactResolver, job models.EmailJob, maxAttempts int) map[string]any {
+	fail := func(err error) map[string]any {
+		return map[string]any{"status": models.EmailFailed, "last_error": err.Error()}
+	}
+	retry := func(to string, err error) map[string]any {
+		if job.Attempts >= maxAttempts {
+			log.Printf("[email] giving up on %s to %s after %d attempts: %v", job.Template, to, job.Attempts, err)
+			return fail(err)
+		}
+		log.Printf("[email] sending %s to %s failed (attempt %d): %v", job.Template, to, job.Attempts, err)
+		return map[string]any{"last_error": err.Error(), "next_attempt_at": time.Now().UTC().Add(retryDelay(job.Attempts))}
+	}
+
+	var data map[string]any
+	err := json.Unmarshal([]byte(job.Data), &data)
+	if err != nil {
+		// Ponavljanje ne moze da popravi neispravne podatke
+		log.Printf("[email] invalid data for %s: %v", job.ID, err)
+		return fail(err)
+	}
+
+	to := job.To
+	if job.RecipientUserID != "" {
+		contact, ok, err := contacts(ctx, job.RecipientUserID)
+		if err != nil {
+			return retry("user "+job.RecipientUserID, fmt.Errorf("resolve recipient: %w", err))
+		}
+		if !ok {
+			log.Printf("[email] user %s has no address for %s", job.RecipientUserID, job.Template)
+			return fail(errNoRecipient)
+		}
+		to = contact.Email
+		data["Username"] = contact.Name
+	}
+
+	msg, err := Render(job.Template, to, data)
+	if err != nil {
+		log.Printf("[email] cannot render %s for %s: %v", job.Template, job.ID, err)
+		return fail(err)
+	}
+
+	if err := transport.Send(ctx, msg); err != nil {
+		return retry(to, err)
+	}
+	return map[string]any{"status": models.EmailSent, "recipient": to, "last_error": "", "sent_at": time.Now().UTC()}
+}
+
+func retryDelay(attempts int) time.Duration {
+	delay := time.Minute << (attempts - 1)
+	if delay > time.Hour || delay <= 0 {
+		return time.Hour
+	}
+	return delay
+}
+
+func purge() {
+	now := time.Now().UTC()
+	res := database.GORM_DB.
+		Where("status = ? AND sent_at < ?", models.EmailSent, now.Add(-sentRetention)).
+		Or("status = ? AND created_at < ?", models.EmailFailed, now.Add(-failedRetention)).
+		Delete(&models.EmailJob{})
+	if res.Error != nil {
+		log.Printf("[email] purge failed: %v", res.Error)
+	}
+
+	// Redovi sa tokenom nastali pre nego sto su se podaci brisali pri slanju
+	res = database.GORM_DB.Model(&models.EmailJob{}).
+		Where("template IN ? AND status <> ?", templateNames(secretTemplates), models.EmailPending).
+		Where("data <> ?", "{}").
+		Update("data", "{}")
+	if res.Error != nil {
+		log.Printf("[email] redacting tokens failed: %v", res.Error)
+	}
+}
+
+func templateNames(set map[string]bool) []string {
+	names := make([]string, 0, len(set))
+	for name := range set {
+		names = append(names, name)
+	}
+	return names
+}
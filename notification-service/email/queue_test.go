@@ -0,0 +1,330 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"notification-service/database"
+	"notification-service/models"
+
+	"github.com/google/uuid"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// useTestDB postavlja praznu sqlite bazu u memoriji kao database.GORM_DB.
+// Tabela se pravi rucno, jer sqlite ne podrzava gen_random_uuid().
+func useTestDB(t *testing.T) {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	err = db.Exec(`CREATE TABLE email_jobs (
+		id TEXT PRIMARY KEY,
+		dedup_key TEXT NOT NULL UNIQUE,
+		recipient TEXT NOT NULL,
+		recipient_user_id TEXT,
+		template TEXT NOT NULL,
+		data TEXT NOT NULL,
+		status TEXT NOT NULL DEFAULT 'pending',
+		attempts INTEGER NOT NULL DEFAULT 0,
+		next_attempt_at DATETIME NOT NULL,
+		last_error TEXT,
+		created_at DATETIME,
+		sent_at DATETIME
+	)`).Error
+	if err != nil {
+		t.Fatalf("create table: %v", err)
+	}
+
+	prev := database.GORM_DB
+	database.GORM_DB = db
+	t.Cleanup(func() {
+		database.GORM_DB = prev
+		sqlDB.Close()
+	})
+}
+
+// fakeTransport pamti poslate poruke i vraca greske iz errs redom.
+type fakeTransport struct {
+	sent []Message
+	errs []error
+}
+
+func (f *fakeTransport) Send(_ context.Context, msg Message) error {
+	if len(f.errs) > 0 {
+		err := f.errs[0]
+		f.errs = f.errs[1:]
+		if err != nil {
+			return err
+		}
+	}
+	f.sent = append(f.sent, msg)
+	return nil
+}
+
+func noContacts(context.Context, string) (Contact, bool, error) {
+	return Contact{}, false, errors.New("no resolver in test")
+}
+
+func loadJob(t *testing.T, dedupKey string) models.EmailJob {
+	t.Helper()
+	var job models.EmailJob
+	if err := database.GORM_DB.First(&job, "dedup_key = ?", dedupKey).Error; err != nil {
+		t.Fatalf("load job %s: %v", dedupKey, err)
+	}
+	return job
+}
+
+// makeDue pomera sledeci pokusaj u proslost, kao da je razmak istekao.
+func makeDue(t *testing.T, dedupKey string) {
+	t.Helper()
+	err := database.GORM_DB.Model(&models.EmailJob{}).Where("dedup_key = ?", dedupKey).
+		Update("next_attempt_at", time.Now().UTC().Add(-time.Second)).Error
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+var tourPublishedData = map[string]any{"Username": "Ana", "TourName": "Fruska gora", "Link": "http://app/tours/t1"}
+
+func TestSendBatchSendsDueJobs(t *testing.T) {
+	useTestDB(t)
+	if err := Enqueue(database.GORM_DB, "e1", "ana@example.com", TemplateTourPublished, tourPublishedData); err != nil {
+		t.Fatalf("Enqueue: %v", err)
+	}
+	// Isti kljuc se ne dodaje ponovo
+	if err := Enqueue(database.GORM_DB, "e1", "ana@example.com", TemplateTourPublished, tourPublishedData); err != nil {
+		t.Fatalf("Enqueue duplicate: %v", err)
+	}
+
+	transport := &fakeTransport{}
+	n, err := sendBatch(context.Background(), transport, noContacts, Config{MaxAttempts: 3, BatchSize: 10})
+	if err != nil {
+		t.Fatalf("sendBatch: %v", err)
+	}
+	if n != 1 || len(transport.sent) != 1 {
+		t.Fatalf("processed %d, sent %d; want 1 and 1", n, len(transport.sent))
+	}
+	if transport.sent[0].To != "ana@example.com" {
+		t.Errorf("sent to %q", transport.sent[0].To)
+	}
+
+	job := loadJob(t, "e1")
+	if job.Status != models.EmailSent || job.Attempts != 1 || job.SentAt == nil {
+		t.Errorf("job after send = %+v", job)
+	}
+
+	n, err = sendBatch(context.Background(), transport, noContacts, Config{MaxAttempts: 3, BatchSize: 10})
+	if err != nil || n != 0 {
+		t.Errorf("second batch processed %d (%v), want 0", n, err)
+	}
+}
+
+func TestSendBatchRetriesUntilMaxAttempts(t *testing.T) {
+	useTestDB(t)
+	if err := Enqueue(database.GORM_DB, "e1", "ana@example.com", TemplateTourPublished, tourPublishedData); err != nil {
+		t.Fatalf("Enqueue: %v", err)
+	}
+	smtpDown := errors.New("connection refused")
+	transport := &fakeTransport{errs: []error{smtpDown, smtpDown, smtpDown}}
+	cfg := Config{MaxAttempts: 3, BatchSize: 10}
+
+	for attempt := 1; attempt <= 2; attempt++ {
+		before := time.Now().UTC()
+		if _, err := sendBatch(context.Background(), transport, noContacts, cfg); err != nil {
+			t.Fatalf("sendBatch: %v", err)
+		}
+		job := loadJob(t, "e1")
+		if job.Status != models.EmailPending || job.Attempts != attempt || job.LastError != smtpDown.Error() {
+			t.Fatalf("after attempt %d job = %+v", attempt, job)
+		}
+		// Razmak raste eksponencijalno: 1 minut, pa 2 minuta
+		wantDelay := retryDelay(attempt)
+		if delay := job.NextAttemptAt.Sub(before); delay < wantDelay-time.Second || delay > wantDelay+time.Second {
+			t.Errorf("after attempt %d next attempt in %s, want %s", attempt, delay, wantDelay)
+		}
+
+		// Dok razmak ne istekne, email se ne salje ponovo
+		if n, err := sendBatch(context.Background(), transport, noContacts, cfg); err != nil || n != 0 {
+			t.Fatalf("job was picked up before its retry delay: %d (%v)", n, err)
+		}
+		makeDue(t, "e1")
+	}
+
+	if _, err := sendBatch(context.Background(), transport, noContacts, cfg); err != nil {
+		t.Fatalf("sendBatch: %v", err)
+	}
+	job := loadJob(t, "e1")
+	if job.Status != models.EmailFailed || job.Attempts != 3 {
+		t.Errorf("after max attempts job = %+v", job)
+	}
+	if len(transport.sent) != 0 {
+		t.Errorf("sent %d messages, want 0", len(transport.sent))
+	}
+}
+
+func TestSendBatchResolvesRecipientByUserID(t *testing.T) {
+	useTestDB(t)
+	data := map[string]any{"TourName": "Fruska gora", "Link": "http://app/tours/t1"}
+	if err := EnqueueForUser(database.GORM_DB, "e1", "user-1", TemplateTourPublished, data); err != nil {
+		t.Fatalf("EnqueueForUser: %v", err)
+	}
+
+	lookupErr := errors.New("stakeholders unavailable")
+	var lookups int
+	contacts := func(_ context.Context, userID string) (Contact, bool, error) {
+		lookups++
+		if lookups == 1 {
+			return Contact{}, false, lookupErr
+		}
+		return Contact{Email: "ana@example.com", Name: "Ana"}, true, nil
+	}
+	transport := &fakeTransport{}
+	cfg := Config{MaxAttempts: 3, BatchSize: 10}
+
+	// Nedostupan stakeholders-service se ponavlja kao neuspelo slanje
+	if _, err := sendBatch(context.Background(), transport, contacts, cfg); err != nil {
+		t.Fatalf("sendBatch: %v", err)
+	}
+	job := loadJob(t, "e1")
+	if job.Status != models.EmailPending || job.Attempts != 1 || len(transport.sent) != 0 {
+		t.Fatalf("after failed lookup job = %+v, sent %d", job, len(transport.sent))
+	}
+
+	makeDue(t, "e1")
+	if _, err := sendBatch(context.Background(), transport, contacts, cfg); err != nil {
+		t.Fatalf("sendBatch: %v", err)
+	}
+	job = loadJob(t, "e1")
+	if job.Status != models.EmailSent || job.To != "ana@example.com" {
+		t.Errorf("after send job = %+v", job)
+	}
+	if len(transport.sent) != 1 || transport.sent[0].To != "ana@example.com" || transport.sent[0].Subject != `Your tour "Fruska gora" is published` {
+		t.Fatalf("sent = %+v", transport.sent)
+	}
+}
+
+func TestSendBatchSkipsUserWithoutAddress(t *testing.T) {
+	useTestDB(t)
+	if err := EnqueueForUser(database.GORM_DB, "e1", "user-1", TemplateTourPublished, tourPublishedData); err != nil {
+		t.Fatalf("EnqueueForUser: %v", err)
+	}
+	contacts := func(context.Context, string) (Contact, bool, error) { return Contact{}, false, nil }
+
+	if _, err := sendBatch(context.Background(), &fakeTransport{}, contacts, Config{MaxAttempts: 3, BatchSize: 10}); err != nil {
+		t.Fatalf("sendBatch: %v", err)
+	}
+	if job := loadJob(t, "e1"); job.Status != models.EmailFailed || job.LastError != errNoRecipient.Error() {
+		t.Errorf("job = %+v", job)
+	}
+}
+
+func TestClaimLeasesJobs(t *testing.T) {
+	useTestDB(t)
+	for _, key := range []string{"e1", "e2", "e3"} {
+		if err := Enqueue(database.GORM_DB, key, "ana@example.com", TemplateTourPublished, tourPublishedData); err != nil {
+			t.Fatalf("Enqueue: %v", err)
+		}
+	}
+
+	first, err := claim(2)
+	if err != nil || len(first) != 2 {
+		t.Fatalf("first claim = %d jobs (%v), want 2", len(first), err)
+	}
+	second, err := claim(2)
+	if err != nil || len(second) != 1 {
+		t.Fatalf("second claim = %d jobs (%v), want the one left", len(second), err)
+	}
+	for _, job := range first {
+		if job.ID == second[0].ID {
+			t.Fatalf("job %s claimed twice", job.ID)
+		}
+		stored := loadJob(t, job.DedupKey)
+		if stored.Attempts != 1 || time.Until(stored.NextAttemptAt) < claimLease-time.Minute {
+			t.Errorf("claimed job = %+v, want attempt counted and lease set", stored)
+		}
+	}
+}
+
+func TestSendBatchRedactsTokenData(t *testing.T) {
+	useTestDB(t)
+	data := map[string]any{"Username": "Ana", "Link": "http://app/reset-password?token=secret", "ExpiresIn": "1 hour"}
+	if err := Enqueue(database.GORM_DB, "reset", "ana@example.com", TemplatePasswordReset, data); err != nil {
+		t.Fatalf("Enqueue: %v", err)
+	}
+	if err := Enqueue(database.GORM_DB, "tour", "ana@example.com", TemplateTourPublished, tourPublishedData); err != nil {
+		t.Fatalf("Enqueue: %v", err)
+	}
+	smtpDown := errors.New("connection refused")
+	transport := &fakeTransport{errs: []error{smtpDown}}
+	cfg := Config{MaxAttempts: 3, BatchSize: 10}
+
+	// Dok se slanje ponavlja, podaci su potrebni za sledeci pokusaj
+	if _, err := sendBatch(context.Background(), transport, noContacts, cfg); err != nil {
+		t.Fatalf("sendBatch: %v", err)
+	}
+	if job := loadJob(t, "reset"); job.Status != models.EmailPending || !strings.Contains(job.Data, "secret") {
+		t.Fatalf("pending job = %+v", job)
+	}
+
+	makeDue(t, "reset")
+	if _, err := sendBatch(context.Background(), transport, noContacts, cfg); err != nil {
+		t.Fatalf("sendBatch: %v", err)
+	}
+	if job := loadJob(t, "reset"); job.Status != models.EmailSent || job.Data != "{}" {
+		t.Errorf("sent reset job = %+v, want data redacted", job)
+	}
+	if job := loadJob(t, "tour"); job.Status != models.EmailSent || job.Data == "{}" {
+		t.Errorf("sent tour job = %+v, want data kept", job)
+	}
+}
+
+func TestPurgeRemovesOldJobsAndRedactsTokens(t *testing.T) {
+	useTestDB(t)
+	now := time.Now().UTC()
+	old := now.Add(-failedRetention - time.Hour)
+	jobs := []models.EmailJob{
+		{DedupKey: "old-sent", Status: models.EmailSent, SentAt: &old, CreatedAt: old},
+		{DedupKey: "new-sent", Status: models.EmailSent, SentAt: &now, CreatedAt: now},
+		{DedupKey: "old-failed", Status: models.EmailFailed, CreatedAt: old},
+		{DedupKey: "new-failed", Status: models.EmailFailed, CreatedAt: now, Template: TemplateVerification, Data: `{"Link":"token"}`},
+		{DedupKey: "pending", Status: models.EmailPending, CreatedAt: old, Template: TemplateVerification, Data: `{"Link":"token"}`},
+	}
+	for i := range jobs {
+		jobs[i].ID = uuid.New()
+		jobs[i].To = "ana@example.com"
+		if jobs[i].Template == "" {
+			jobs[i].Template, jobs[i].Data = TemplateTourPublished, "{}"
+		}
+		jobs[i].NextAttemptAt = now
+		if err := database.GORM_DB.Create(&jobs[i]).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	purge()
+
+	var left []string
+	database.GORM_DB.Model(&models.EmailJob{}).Order("dedup_key").Pluck("dedup_key", &left)
+	if want := []string{"new-failed", "new-sent", "pending"}; !slices.Equal(left, want) {
+		t.Errorf("jobs left after purge = %v, want %v", left, want)
+	}
+	if job := loadJob(t, "new-failed"); job.Data != "{}" {
+		t.Errorf("failed verification job data = %s, want redacted", job.Data)
+	}
+	if job := loadJob(t, "pending"); job.Data == "{}" {
+		t.Errorf("pending verification job lost its data")
+	}
+}
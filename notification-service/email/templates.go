@@ -0,0 +1,68 @@
+package email
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+)
+
+const (
+	TemplateVerification    = "verification"
+	TemplatePasswordReset   = "password_reset"
+	TemplatePurchaseReceipt = "purchase_receipt"
+	TemplateTourPublished   = "tour_published"
+)
+
+// Svaki sablon ima <ime>.txt sa blokovima "subject" i "text" i <ime>.html sa
+// blokom "content" koji se umece u layout.html.
+//
+//go:embed templates
+var templateFS embed.FS
+
+type compiled struct {
+	text *texttemplate.Template
+	html *htmltemplate.Template
+}
+
+var templates = map[string]compiled{}
+
+func init() {
+	for _, name := range []string{TemplateVerification, TemplatePasswordReset, TemplatePurchaseReceipt, TemplateTourPublished} {
+		templates[name] = compiled{
+			text: texttemplate.Must(texttemplate.New(name).Funcs(funcs).ParseFS(templateFS, "templates/"+name+".txt")),
+			html: htmltemplate.Must(htmltemplate.New(name).Funcs(funcs).ParseFS(templateFS, "templates/layout.html", "templates/"+name+".html")),
+		}
+	}
+}
+
+var funcs = map[string]any{
+	"money": func(amount float64) string { return fmt.Sprintf("%.2f", amount) },
+}
+
+// Render popunjava sablon name podacima data i vraca poruku za adresu to.
+func Render(name, to string, data any) (Message, error) {
+	t, ok := templates[name]
+	if !ok {
+		return Message{}, fmt.Errorf("unknown email template %q", name)
+	}
+
+	var subject, text, html bytes.Buffer
+	if err := t.text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return Message{}, err
+	}
+	if err := t.text.ExecuteTemplate(&text, "text", data); err != nil {
+		return Message{}, err
+	}
+	if err := t.html.ExecuteTemplate(&html, "layout", data); err != nil {
+		return Message{}, err
+	}
+	return Message{
+		To:      to,
+		Subject: strings.TrimSpace(subject.String()),
+		Text:    strings.TrimSpace(text.String()) + "\n",
+		HTML:    html.String(),
+	}, nil
+}
@@ -0,0 +1,89 @@
+package email
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderTemplates(t *testing.T) {
+	tests := []struct {
+		template string
+		data     map[string]any
+		subject  string
+		want     []string
+	}{
+		{
+			template: TemplateVerification,
+			data:     map[string]any{"Username": "Ana", "Link": "http://app/verify-email?token=abc", "ExpiresIn": "24 hours"},
+			subject:  "Confirm your email address",
+			want:     []string{"Hi Ana", "http://app/verify-email?token=abc", "24 hours"},
+		},
+		{
+			template: TemplatePasswordReset,
+			data:     map[string]any{"Username": "Ana", "Link": "http://app/reset-password?token=abc", "ExpiresIn": "1 hour"},
+			subject:  "Reset your password",
+			want:     []string{"Hi Ana", "http://app/reset-password?token=abc", "1 hour"},
+		},
+		{
+			template: TemplatePurchaseReceipt,
+			data: map[string]any{
+				"Username":    "Ana",
+				"Tours":       []map[string]any{{"TourName": "Fruska gora", "Price": 12.5}, {"TourName": "Petrovaradin", "Price": 7.0}},
+				"Amount":      19.5,
+				"PurchasedAt": "Mon, 19 Oct 2026 10:00:00 UTC",
+				"ReceiptID":   "evt-1",
+			},
+			subject: "Your receipt",
+			want:    []string{"Hi Ana", "Fruska gora: 12.50", "Petrovaradin: 7.00", "Total: 19.50", "evt-1"},
+		},
+		{
+			template: TemplateTourPublished,
+			data:     map[string]any{"Username": "Ana", "TourName": "Fruska gora", "Link": "http://app/tours/t1"},
+			subject:  `Your tour "Fruska gora" is published`,
+			want:     []string{"Hi Ana", "Fruska gora", "http://app/tours/t1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.template, func(t *testing.T) {
+			msg, err := Render(tt.template, "ana@example.com", tt.data)
+			if err != nil {
+				t.Fatalf("Render: %v", err)
+			}
+			if msg.To != "ana@example.com" {
+				t.Errorf("To = %q", msg.To)
+			}
+			if msg.Subject != tt.subject {
+				t.Errorf("Subject = %q, want %q", msg.Subject, tt.subject)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(msg.Text, want) {
+					t.Errorf("text does not contain %q:\n%s", want, msg.Text)
+				}
+			}
+			if !strings.Contains(msg.HTML, "<html") || !strings.Contains(msg.HTML, "Ana") {
+				t.Errorf("html is not rendered into the layout:\n%s", msg.HTML)
+			}
+			if strings.Contains(msg.Text+msg.HTML, "<no value>") {
+				t.Errorf("template uses a field missing from the data")
+			}
+		})
+	}
+}
+
+func TestRenderEscapesHTML(t *testing.T) {
+	msg, err := Render(TemplateTourPublished, "ana@example.com", map[string]any{
+		"Username": "<b>Ana</b>", "TourName": "Tura", "Link": "http://app/tours/t1",
+	})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	if strings.Contains(msg.HTML, "<b>Ana</b>") {
+		t.Errorf("username is not escaped in html:\n%s", msg.HTML)
+	}
+}
+
+func TestRenderUnknownTemplate(t *testing.T) {
+	if _, err := Render("missing", "ana@example.com", nil); err == nil {
+		t.Fatal("expected error for unknown template")
+	}
+}
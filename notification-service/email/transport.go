@@ -0,0 +1,158 @@
+package email
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Transport salje gotovu poruku. Greska znaci da poruka nije predata i da
+// slanje treba ponoviti.
+type Transport interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// NewTransportFromEnv bira transport prema EMAIL_TRANSPORT: "smtp" ili "file"
+// (podrazumevano). File transport je namenjen razvoju: poruke upisuje kao .eml
+// fajlove u EMAIL_FILE_DIR i loguje ih, a ako je direktorijum prazan samo ih
+// loguje.
+func NewTransportFromEnv() (Transport, error) {
+	from := os.Getenv("EMAIL_FROM")
+	if from == "" {
+		from = "no-reply@localhost"
+	}
+	if _, err := mail.ParseAddress(from); err != nil {
+		return nil, fmt.Errorf("invalid EMAIL_FROM %q: %w", from, err)
+	}
+
+	switch backend := os.Getenv("EMAIL_TRANSPORT"); backend {
+	case "", "file":
+		return &FileTransport{From: from, Dir: os.Getenv("EMAIL_FILE_DIR")}, nil
+	case "smtp":
+		host := os.Getenv("SMTP_HOST")
+		if host == "" {
+			return nil, fmt.Errorf("SMTP_HOST is required for smtp email transport")
+		}
+		port := os.Getenv("SMTP_PORT")
+		if port == "" {
+			port = "587"
+		}
+		return &SMTPTransport{
+			From:       from,
+			Addr:       net.JoinHostPort(host, port),
+			Username:   os.Getenv("SMTP_USERNAME"),
+			Password:   os.Getenv("SMTP_PASSWORD"),
+			NoStartTLS: os.Getenv("SMTP_STARTTLS") == "false",
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown EMAIL_TRANSPORT %q", backend)
+	}
+}
+
+// SMTPTransport salje poruke preko SMTP servera. STARTTLS se koristi kada ga
+// server nudi, osim ako je iskljucen (npr. za lokalni SMTP sink).
+type SMTPTransport struct {
+	From       string
+	Addr       string
+	Username   string
+	Password   string
+	NoStartTLS bool
+}
+
+const smtpTimeout = 30 * time.Second
+
+func (t *SMTPTransport) Send(ctx context.Context, msg Message) error {
+	data, err := msg.bytes(t.From)
+	if err != nil {
+		return err
+	}
+	from, err := mail.ParseAddress(t.From)
+	if err != nil {
+		return err
+	}
+	to, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, smtpTimeout)
+	defer cancel()
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", t.Addr)
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	host, _, _ := net.SplitHostPort(t.Addr)
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok && !t.NoStartTLS {
+		if err := c.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if t.Username != "" {
+		if err := c.Auth(smtp.PlainAuth("", t.Username, t.Password, host)); err != nil {
+			return err
+		}
+	}
+	if err := c.Mail(from.Address); err != nil {
+		return err
+	}
+	if err := c.Rcpt(to.Address); err != nil {
+		return err
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+type FileTransport struct {
+	From string
+	Dir  string
+}
+
+func (t *FileTransport) Send(ctx context.Context, msg Message) error {
+	if t.Dir == "" {
+		log.Printf("[email] to=%s subject=%q\n%s", msg.To, msg.Subject, msg.Text)
+		return nil
+	}
+
+	data, err := msg.bytes(t.From)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(t.Dir, 0o755); err != nil {
+		return err
+	}
+	recipient := strings.NewReplacer("@", "_at_", "/", "_", "\\", "_").Replace(msg.To)
+	name := filepath.Join(t.Dir, fmt.Sprintf("%s-%s.eml", time.Now().UTC().Format("20060102T150405.000000000"), recipient))
+	if err := os.WriteFile(name, data, 0o644); err != nil {
+		return err
+	}
+	log.Printf("[email] to=%s subject=%q written to %s", msg.To, msg.Subject, name)
+	return nil
+}
@@ -0,0 +1,154 @@
+package email
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"net/mail"
+	"strings"
+	"testing"
+)
+
+// smtpSink je minimalan SMTP server za testove: prihvata jednu poruku po
+// konekciji i salje je na received.
+type smtpSink struct {
+	ln       net.Listener
+	received chan sinkMessage
+	rejectTo string
+}
+
+type sinkMessage struct {
+	from string
+	rcpt []string
+	data string
+}
+
+func startSMTPSink(t *testing.T) *smtpSink {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	sink := &smtpSink{ln: ln, received: make(chan sinkMessage, 1)}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go sink.serve(conn)
+		}
+	}()
+	return sink
+}
+
+func (s *smtpSink) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	reply := func(line string) { conn.Write([]byte(line + "\r\n")) }
+
+	reply("220 sink ready")
+	var msg sinkMessage
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		cmd := strings.TrimRight(line, "\r\n")
+		switch upper := strings.ToUpper(cmd); {
+		case strings.HasPrefix(upper, "EHLO"), strings.HasPrefix(upper, "HELO"):
+			reply("250 sink")
+		case strings.HasPrefix(upper, "MAIL FROM:"):
+			msg.from = strings.Trim(cmd[len("MAIL FROM:"):], "<> ")
+			reply("250 ok")
+		case strings.HasPrefix(upper, "RCPT TO:"):
+			rcpt := strings.Trim(cmd[len("RCPT TO:"):], "<> ")
+			if rcpt == s.rejectTo {
+				reply("550 no such user")
+				continue
+			}
+			msg.rcpt = append(msg.rcpt, rcpt)
+			reply("250 ok")
+		case upper == "DATA":
+			reply("354 end with .")
+			var data strings.Builder
+			for {
+				line, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if line == ".\r\n" {
+					break
+				}
+				data.WriteString(strings.TrimPrefix(line, "."))
+			}
+			msg.data = data.String()
+			s.received <- msg
+			reply("250 queued")
+		case upper == "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 not implemented")
+		}
+	}
+}
+
+func TestSMTPTransportSend(t *testing.T) {
+	sink := startSMTPSink(t)
+	transport := &SMTPTransport{From: "Tours <no-reply@tours.test>", Addr: sink.ln.Addr().String(), NoStartTLS: true}
+
+	msg, err := Render(TemplateTourPublished, "Ana <ana@example.com>", map[string]any{
+		"Username": "Ana", "TourName": "Fruska gora", "Link": "http://app/tours/t1",
+	})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	if err := transport.Send(context.Background(), msg); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+
+	got := <-sink.received
+	if got.from != "no-reply@tours.test" {
+		t.Errorf("MAIL FROM = %q", got.from)
+	}
+	if len(got.rcpt) != 1 || got.rcpt[0] != "ana@example.com" {
+		t.Errorf("RCPT TO = %v", got.rcpt)
+	}
+	parsed, err := mail.ReadMessage(strings.NewReader(got.data))
+	if err != nil {
+		t.Fatalf("sink received invalid message: %v", err)
+	}
+	if to := parsed.Header.Get("To"); to != "Ana <ana@example.com>" {
+		t.Errorf("To header = %q", to)
+	}
+	if !strings.HasPrefix(parsed.Header.Get("Content-Type"), "multipart/alternative") {
+		t.Errorf("Content-Type = %q", parsed.Header.Get("Content-Type"))
+	}
+}
+
+func TestSMTPTransportRejectedRecipient(t *testing.T) {
+	sink := startSMTPSink(t)
+	sink.rejectTo = "nobody@example.com"
+	transport := &SMTPTransport{From: "no-reply@tours.test", Addr: sink.ln.Addr().String(), NoStartTLS: true}
+
+	err := transport.Send(context.Background(), Message{To: "nobody@example.com", Subject: "s", Text: "t"})
+	if err == nil {
+		t.Fatal("expected error for rejected recipient")
+	}
+}
+
+func TestSMTPTransportUnreachable(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	addr := ln.Addr().String()
+	ln.Close()
+
+	transport := &SMTPTransport{From: "no-reply@tours.test", Addr: addr, NoStartTLS: true}
+	if err := transport.Send(context.Background(), Message{To: "ana@example.com", Subject: "s", Text: "t"}); err == nil {
+		t.Fatal("expected error when the server is unreachable")
+	}
+}
@@ -0,0 +1,379 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"notification-service/database"
+	"notification-service/models"
+	"shared/events"
+
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	SubjectFollowed          = "follower_followed"
+	SubjectCommentAdded      = "blog_comment_added"
+	SubjectPostLiked         = "blog_post_liked"
+	SubjectReviewCreated     = "tours_review_created"
+	SubjectReviewReplied     = "tours_review_replied"
+	SubjectCheckoutCompleted = "purchase_checkout_completed"
+	SubjectUserRenamed       = "follower_user_renamed"
+
+	SubjectNotificationImported = "tours_notification_imported"
+)
+
+// Payload-i dogadjaja, sa poljima koja su potrebna za notifikacije.
+
+type FollowEvent struct {
+	Follower string `json:"follower"`
+	Followee string `json:"followee"`
+}
+
+type CommentEvent struct {
+	CommentID      string   `json:"commentId"`
+	PostID         string   `json:"postId"`
+	PostAuthorID   string   `json:"postAuthorId"`
+	PostAuthor     string   `json:"postAuthor"`
+	PostVisibility string   `json:"postVisibility"`
+	ParentAuthorID string   `json:"parentAuthorId,omitempty"`
+	UserID         string   `json:"userId"`
+	Username       string   `json:"username"`
+	Mentions       []string `json:"mentions,omitempty"`
+}
+
+type LikeEvent struct {
+	PostID       string `json:"postId"`
+	PostAuthorID string `json:"postAuthorId"`
+	PostTitle    string `json:"postTitle"`
+	UserID       string `json:"userId"`
+	Username     string `json:"username"`
+}
+
+type ReviewEvent struct {
+	UserID       string `json:"userId"`
+	Username     string `json:"username"`
+	TourID       string `json:"tourId"`
+	TourName     string `json:"tourName"`
+	TourAuthorID string `json:"tourAuthorId"`
+	Rating       int    `json:"rating"`
+}
+
+type ReviewReplyEvent struct {
+	TourID    string `json:"tourId"`
+	TourName  string `json:"tourName"`
+	TouristID string `json:"touristId"`
+	GuideID   string `json:"guideId"`
+	Username  string `json:"username"`
+}
+
+type UserRenamedEvent struct {
+	OldUsername string `json:"oldUsername"`
+	NewUsername string `json:"newUsername"`
+}
+
+// ImportedNotificationEvent je notifikacija koju je tours-service cuvao pre
+// nego sto je notifikacije preuzeo ovaj servis.
+type ImportedNotificationEvent struct {
+	ID            string    `json:"id"`
+	RecipientID   string    `json:"recipientId"`
+	Type          string    `json:"type"`
+	Message       string    `json:"message"`
+	ActorUsername string    `json:"actorUsername"`
+	TourID        string    `json:"tourId"`
+	Read          bool      `json:"read"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+type CheckoutCompletedEvent struct {
+	UserID string  `json:"userId"`
+	Amount float64 `json:"amount"`
+	Tours  []struct {
+		TourID   string  `json:"tourId"`
+		TourName string  `json:"tourName"`
+		Price    float64 `json:"price"`
+	} `json:"tours"`
+}
+
+// builder pravi notifikacije iz payload-a dogadjaja. Notifikacije bez
+// primaoca se preskacu.
+type builder func(ctx context.Context, envelope events.Envelope) ([]models.Notification, error)
+
+// SubscribeDomainEvents prima dogadjaje ostalih servisa preko trajnog
+// consumer-a, tako da svaki dogadjaj obradi jedna replika i da se neuspela
+// obrada ponovi, i pretplacuje repliku na notifikacije za live stream.
+func SubscribeDomainEvents(natsConn *nats.Conn) {
+	builders := map[string]builder{
+		SubjectFollowed:          onFollowed,
+		SubjectCommentAdded:      onCommentAdded,
+		SubjectPostLiked:         onPostLiked,
+		SubjectReviewCreated:     onReviewCreated,
+		SubjectReviewReplied:     onReviewReplied,
+		SubjectCheckoutCompleted: onCheckoutCompleted,
+	}
+	handlers := make(map[string]events.Handler, len(builders))
+	for subject, build := range builders {
+		handlers[subject] = handleEvent(natsConn, build)
+	}
+	handlers[SubjectUserRenamed] = onUserRenamed
+	handlers[SubjectNotificationImported] = onNotificationImported
+	events.Consume(context.Background(), natsConn, events.ConsumerConfig{
+		Durable:  "notification-service",
+		Handlers: handlers,
+	})
+	subscribeLive(natsConn)
+}
+
+func handleEvent(natsConn *nats.Conn, build builder) events.Handler {
+	return func(ctx context.Context, envelope events.Envelope) error {
+		notifications, err := build(ctx, envelope)
+		if err != nil {
+			return err
+		}
+
+		for i := range notifications {
+			n := &notifications[i]
+			if n.RecipientID == "" {
+				continue
+			}
+			n.ID = uuid.New()
+			n.EventID = envelope.ID
+			n.CreatedAt = envelope.OccurredAt.UTC()
+			// Ponovljeni dogadjaj ne pravi novu notifikaciju; isto vazi i za
+			// drugu notifikaciju istom primaocu iz istog dogadjaja
+			result := database.GORM_DB.Clauses(clause.OnConflict{DoNothing: true}).Create(n)
+			if result.Error != nil {
+				// Vec sacuvane notifikacije se pri ponovnoj isporuci preskacu
+				return fmt.Errorf("save notification for %s: %w", n.RecipientID, result.Error)
+			}
+			if result.RowsAffected > 0 {
+				publishLive(natsConn, n)
+			}
+		}
+		return nil
+	}
+}
+
+// onUserRenamed menja korisnicko ime aktera u postojecim notifikacijama, kao
+// i ID resursa notifikacija koje pokazuju na profil korisnika.
+func onUserRenamed(_ context.Context, envelope events.Envelope) error {
+	var event UserRenamedEvent
+	if err := envelope.DecodePayload(&event); err != nil {
+		return err
+	}
+	if event.OldUsername == "" || event.NewUsername == "" {
+		return events.Permanent(errors.New("missing old or new username"))
+	}
+
+	return database.GORM_DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&models.Notification{}).Where("actor_username = ?", event.OldUsername).
+			Update("actor_username", event.NewUsername).Error
+		if err != nil {
+			return err
+		}
+		return tx.Model(&models.Notification{}).Where("resource_type = ? AND resource_id = ?", "user", event.OldUsername).
+			Update("resource_id", event.NewUsername).Error
+	})
+}
+
+// onNotificationImported cuva prenetu notifikaciju sa originalnim vremenom
+// nastanka i stanjem procitanosti. Stare notifikacije se ne salju na live
+// stream.
+func onNotificationImported(_ context.Context, envelope events.Envelope) error {
+	var event ImportedNotificationEvent
+	if err := envelope.DecodePayload(&event); err != nil {
+		return err
+	}
+	if event.ID == "" || event.RecipientID == "" {
+		return events.Permanent(errors.New("missing notification or recipient id"))
+	}
+
+	n := models.Notification{
+		ID:            uuid.New(),
+		EventID:       "tours-notification:" + event.ID,
+		RecipientID:   event.RecipientID,
+		Type:          models.NotificationType(event.Type),
+		ActorUsername: event.ActorUsername,
+		Message:       event.Message,
+		ResourceType:  "tour",
+		ResourceID:    event.TourID,
+		Read:          event.Read,
+		CreatedAt:     event.CreatedAt.UTC(),
+	}
+	if n.Read {
+		n.ReadAt = &n.CreatedAt
+	}
+	return database.GORM_DB.Clauses(clause.OnConflict{DoNothing: true}).Create(&n).Error
+}
+
+func onFollowed(ctx context.Context, envelope events.Envelope) ([]models.Notification, error) {
+	var event FollowEvent
+	if err := envelope.DecodePayload(&event); err != nil {
+		return nil, err
+	}
+	ids, err := resolveUserIDs(ctx, []string{event.Followee})
+	if err != nil {
+		return nil, err
+	}
+	return []models.Notification{{
+		RecipientID:   ids[event.Followee],
+		Type:          models.NotificationNewFollower,
+		ActorUsername: event.Follower,
+		Message:       fmt.Sprintf("%s started following you", event.Follower),
+		ResourceType:  "user",
+		ResourceID:    event.Follower,
+	}}, nil
+}
+
+// onCommentAdded obavestava autora komentara na koji je odgovoreno, autora
+// posta i pomenute korisnike koji smeju da vide post. Ko je vise od toga
+// dobija samo prvu notifikaciju iz tog reda.
+func onCommentAdded(ctx context.Context, envelope events.Envelope) ([]models.Notification, error) {
+	var event CommentEvent
+	if err := envelope.DecodePayload(&event); err != nil {
+		return nil, err
+	}
+
+	notify := func(recipientID string, t models.NotificationType, message string) models.Notification {
+		if recipientID == event.UserID {
+			recipientID = ""
+		}
+		return models.Notification{
+			RecipientID:   recipientID,
+			Type:          t,
+			ActorUsername: event.Username,
+			Message:       message,
+			ResourceType:  "post",
+			ResourceID:    event.PostID,
+		}
+	}
+
+	notifications := []models.Notification{
+		notify(event.ParentAuthorID, models.NotificationCommentReply, fmt.Sprintf("%s replied to your comment", event.Username)),
+		notify(event.PostAuthorID, models.NotificationPostComment, fmt.Sprintf("%s commented on your post", event.Username)),
+	}
+
+	mentions, err := mentionAudience(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := resolveUserIDs(ctx, mentions)
+	if err != nil {
+		return nil, err
+	}
+	for _, username := range mentions {
+		notifications = append(notifications, notify(ids[username], models.NotificationMention, fmt.Sprintf("%s mentioned you in a comment", event.Username)))
+	}
+	return notifications, nil
+}
+
+// mentionAudience vraca pomenute korisnike koji smeju da vide post, po istim
+// pravilima kao blog-service: javni post vide svi, post samo za pratioce
+// autor i njegovi pratioci, a privatni samo autor. Nepoznata vidljivost se
+// tretira kao privatna, da notifikacija ne bi otkrila post.
+func mentionAudience(ctx context.Context, event CommentEvent) ([]string, error) {
+	switch event.PostVisibility {
+	case "public":
+		return event.Mentions, nil
+	case "followers":
+		var visible []string
+		for _, username := range event.Mentions {
+			if username == event.PostAuthor {
+				visible = append(visible, username)
+				continue
+			}
+			following, err := isFollowing(ctx, username, event.PostAuthor)
+			if err != nil {
+				return nil, err
+			}
+			if following {
+				visible = append(visible, username)
+			}
+		}
+		return visible, nil
+	default:
+		if slices.Contains(event.Mentions, event.PostAuthor) {
+			return []string{event.PostAuthor}, nil
+		}
+		return nil, nil
+	}
+}
+
+func onPostLiked(ctx context.Context, envelope events.Envelope) ([]models.Notification, error) {
+	var event LikeEvent
+	if err := envelope.DecodePayload(&event); err != nil {
+		return nil, err
+	}
+	if event.PostAuthorID == event.UserID {
+		return nil, nil
+	}
+	return []models.Notification{{
+		RecipientID:   event.PostAuthorID,
+		Type:          models.NotificationPostLike,
+		ActorUsername: event.Username,
+		Message:       fmt.Sprintf("%s liked your post %q", event.Username, event.PostTitle),
+		ResourceType:  "post",
+		ResourceID:    event.PostID,
+	}}, nil
+}
+
+func onReviewCreated(ctx context.Context, envelope events.Envelope) ([]models.Notification, error) {
+	var event ReviewEvent
+	if err := envelope.DecodePayload(&event); err != nil {
+		return nil, err
+	}
+	if event.TourAuthorID == event.UserID {
+		return nil, nil
+	}
+	return []models.Notification{{
+		RecipientID:   event.TourAuthorID,
+		Type:          models.NotificationTourReview,
+		ActorUsername: event.Username,
+		Message:       fmt.Sprintf("%s left a %d-star review on %s", event.Username, event.Rating, event.TourName),
+		ResourceType:  "tour",
+		ResourceID:    event.TourID,
+	}}, nil
+}
+
+func onReviewReplied(ctx context.Context, envelope events.Envelope) ([]models.Notification, error) {
+	var event ReviewReplyEvent
+	if err := envelope.DecodePayload(&event); err != nil {
+		return nil, err
+	}
+	return []models.Notification{{
+		RecipientID:   event.TouristID,
+		Type:          models.NotificationReviewReply,
+		ActorUsername: event.Username,
+		Message:       fmt.Sprintf("%s replied to your review of %s", event.Username, event.TourName),
+		ResourceType:  "tour",
+		ResourceID:    event.TourID,
+	}}, nil
+}
+
+func onCheckoutCompleted(ctx context.Context, envelope events.Envelope) ([]models.Notification, error) {
+	var event CheckoutCompletedEvent
+	if err := envelope.DecodePayload(&event); err != nil {
+		return nil, err
+	}
+	if len(event.Tours) == 0 {
+		return nil, nil
+	}
+
+	n := models.Notification{
+		RecipientID: event.UserID,
+		Type:        models.NotificationPurchaseCompleted,
+		Message:     fmt.Sprintf("Your purchase of %d tours is complete", len(event.Tours)),
+	}
+	if len(event.Tours) == 1 {
+		n.Message = fmt.Sprintf("Your purchase of %s is complete", event.Tours[0].TourName)
+		n.ResourceType = "tour"
+		n.ResourceID = event.Tours[0].TourID
+	}
+	return []models.Notification{n}, nil
+}
@@ -0,0 +1,271 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"notification-service/models"
+	followerproto "notification-service/proto/follower"
+	stakeproto "notification-service/proto/stakeholders"
+	"shared/events"
+
+	"google.golang.org/grpc"
+)
+
+// fakeStakeholders odgovara na CheckUsers iz mape korisnicko ime -> ID.
+// Ostale metode nisu implementirane.
+type fakeStakeholders struct {
+	stakeproto.StakeholdersServiceClient
+	users   map[string]string
+	blocked map[string]bool
+	err     error
+}
+
+func (f *fakeStakeholders) CheckUsers(_ context.Context, in *stakeproto.CheckUsersRequest, _ ...grpc.CallOption) (*stakeproto.CheckUsersResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	resp := &stakeproto.CheckUsersResponse{}
+	for _, username := range in.GetUsernames() {
+		id, ok := f.users[username]
+		resp.Users = append(resp.Users, &stakeproto.UserStatus{
+			Username:  username,
+			Exists:    ok,
+			IsBlocked: f.blocked[username],
+			UserId:    id,
+		})
+	}
+	return resp, nil
+}
+
+// fakeFollower odgovara na IsFollowing iz skupa parova "pratilac>autor".
+type fakeFollower struct {
+	followerproto.FollowerServiceClient
+	follows map[string]bool
+	err     error
+}
+
+func (f *fakeFollower) IsFollowing(_ context.Context, in *followerproto.IsFollowingRequest, _ ...grpc.CallOption) (*followerproto.IsFollowingResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &followerproto.IsFollowingResponse{Following: f.follows[in.GetFollower()+">"+in.GetFollowee()]}, nil
+}
+
+func useClients(t *testing.T, stakeholders *fakeStakeholders, follower *fakeFollower) {
+	t.Helper()
+	prevStakeholders, prevFollower := stakeholdersClient, followerClient
+	stakeholdersClient, followerClient = stakeholders, follower
+	t.Cleanup(func() { stakeholdersClient, followerClient = prevStakeholders, prevFollower })
+}
+
+func envelopeFor(t *testing.T, subject string, payload any) events.Envelope {
+	t.Helper()
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("marshal payload: %v", err)
+	}
+	return events.Envelope{ID: "evt-1", Type: subject, Version: events.Version, OccurredAt: time.Now(), Payload: raw}
+}
+
+func recipients(notifications []models.Notification, t models.NotificationType) []string {
+	var ids []string
+	for _, n := range notifications {
+		if n.Type == t && n.RecipientID != "" {
+			ids = append(ids, n.RecipientID)
+		}
+	}
+	return ids
+}
+
+var testUsers = map[string]string{
+	"ana":    "id-ana",
+	"marko":  "id-marko",
+	"jelena": "id-jelena",
+	"petar":  "id-petar",
+}
+
+func TestOnFollowedNotifiesFollowee(t *testing.T) {
+	useClients(t, &fakeStakeholders{users: testUsers}, &fakeFollower{})
+
+	notifications, err := onFollowed(context.Background(), envelopeFor(t, SubjectFollowed, FollowEvent{Follower: "ana", Followee: "marko"}))
+	if err != nil {
+		t.Fatalf("onFollowed: %v", err)
+	}
+	if len(notifications) != 1 {
+		t.Fatalf("got %d notifications, want 1", len(notifications))
+	}
+	n := notifications[0]
+	if n.RecipientID != "id-marko" || n.Type != models.NotificationNewFollower || n.ActorUsername != "ana" || n.ResourceID != "ana" {
+		t.Errorf("unexpected notification %+v", n)
+	}
+}
+
+func TestOnFollowedSkipsBlockedFollowee(t *testing.T) {
+	useClients(t, &fakeStakeholders{users: testUsers, blocked: map[string]bool{"marko": true}}, &fakeFollower{})
+
+	notifications, err := onFollowed(context.Background(), envelopeFor(t, SubjectFollowed, FollowEvent{Follower: "ana", Followee: "marko"}))
+	if err != nil {
+		t.Fatalf("onFollowed: %v", err)
+	}
+	if got := recipients(notifications, models.NotificationNewFollower); len(got) != 0 {
+		t.Errorf("blocked followee got notifications: %v", got)
+	}
+}
+
+// Greska pri razresavanju korisnika mora da se vrati, da bi JetStream
+// ponovio isporuku umesto da se dogadjaj izgubi.
+func TestOnFollowedReturnsLookupError(t *testing.T) {
+	useClients(t, &fakeStakeholders{err: errors.New("unavailable")}, &fakeFollower{})
+
+	_, err := onFollowed(context.Background(), envelopeFor(t, SubjectFollowed, FollowEvent{Follower: "ana", Followee: "marko"}))
+	if err == nil {
+		t.Fatal("expected lookup error to be returned")
+	}
+}
+
+func TestOnCommentAddedMentionsRespectVisibility(t *testing.T) {
+	follower := &fakeFollower{follows: map[string]bool{"jelena>marko": true}}
+
+	tests := []struct {
+		name       string
+		visibility string
+		want       []string
+	}{
+		{"public", "public", []string{"id-jelena", "id-petar", "id-marko"}},
+		{"followers", "followers", []string{"id-jelena", "id-marko"}},
+		{"private", "private", []string{"id-marko"}},
+		{"unknown", "", []string{"id-marko"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useClients(t, &fakeStakeholders{users: testUsers}, follower)
+
+			event := CommentEvent{
+				CommentID:      "c1",
+				PostID:         "p1",
+				PostAuthorID:   "id-marko",
+				PostAuthor:     "marko",
+				PostVisibility: tt.visibility,
+				UserID:         "id-ana",
+				Username:       "ana",
+				Mentions:       []string{"jelena", "petar", "marko"},
+			}
+			notifications, err := onCommentAdded(context.Background(), envelopeFor(t, SubjectCommentAdded, event))
+			if err != nil {
+				t.Fatalf("onCommentAdded: %v", err)
+			}
+			got := recipients(notifications, models.NotificationMention)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("mention recipients = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOnCommentAddedSkipsCommenter(t *testing.T) {
+	useClients(t, &fakeStakeholders{users: testUsers}, &fakeFollower{})
+
+	event := CommentEvent{
+		PostID:         "p1",
+		PostAuthorID:   "id-ana",
+		PostAuthor:     "ana",
+		PostVisibility: "public",
+		ParentAuthorID: "id-marko",
+		UserID:         "id-ana",
+		Username:       "ana",
+		Mentions:       []string{"ana"},
+	}
+	notifications, err := onCommentAdded(context.Background(), envelopeFor(t, SubjectCommentAdded, event))
+	if err != nil {
+		t.Fatalf("onCommentAdded: %v", err)
+	}
+	if got := recipients(notifications, models.NotificationCommentReply); !slices.Equal(got, []string{"id-marko"}) {
+		t.Errorf("reply recipients = %v, want [id-marko]", got)
+	}
+	if got := recipients(notifications, models.NotificationPostComment); len(got) != 0 {
+		t.Errorf("author commenting on own post got notified: %v", got)
+	}
+	if got := recipients(notifications, models.NotificationMention); len(got) != 0 {
+		t.Errorf("commenter mentioning themselves got notified: %v", got)
+	}
+}
+
+func TestOnCommentAddedReturnsFollowerError(t *testing.T) {
+	useClients(t, &fakeStakeholders{users: testUsers}, &fakeFollower{err: errors.New("unavailable")})
+
+	event := CommentEvent{
+		PostID:         "p1",
+		PostAuthorID:   "id-marko",
+		PostAuthor:     "marko",
+		PostVisibility: "followers",
+		UserID:         "id-ana",
+		Username:       "ana",
+		Mentions:       []string{"jelena"},
+	}
+	if _, err := onCommentAdded(context.Background(), envelopeFor(t, SubjectCommentAdded, event)); err == nil {
+		t.Fatal("expected follower error to be returned")
+	}
+}
+
+func TestOnPostLikedSkipsOwnPost(t *testing.T) {
+	event := LikeEvent{PostID: "p1", PostAuthorID: "id-ana", PostTitle: "Naslov", UserID: "id-ana", Username: "ana"}
+	notifications, err := onPostLiked(context.Background(), envelopeFor(t, SubjectPostLiked, event))
+	if err != nil {
+		t.Fatalf("onPostLiked: %v", err)
+	}
+	if len(notifications) != 0 {
+		t.Errorf("got %d notifications for own like, want 0", len(notifications))
+	}
+
+	event.UserID, event.Username = "id-marko", "marko"
+	notifications, err = onPostLiked(context.Background(), envelopeFor(t, SubjectPostLiked, event))
+	if err != nil {
+		t.Fatalf("onPostLiked: %v", err)
+	}
+	if got := recipients(notifications, models.NotificationPostLike); !slices.Equal(got, []string{"id-ana"}) {
+		t.Errorf("like recipients = %v, want [id-ana]", got)
+	}
+}
+
+func TestOnReviewCreatedSkipsOwnTour(t *testing.T) {
+	event := ReviewEvent{UserID: "id-ana", Username: "ana", TourID: "t1", TourName: "Tura", TourAuthorID: "id-ana", Rating: 5}
+	notifications, err := onReviewCreated(context.Background(), envelopeFor(t, SubjectReviewCreated, event))
+	if err != nil {
+		t.Fatalf("onReviewCreated: %v", err)
+	}
+	if len(notifications) != 0 {
+		t.Errorf("got %d notifications for own tour, want 0", len(notifications))
+	}
+}
+
+func TestOnCheckoutCompletedMessage(t *testing.T) {
+	var event CheckoutCompletedEvent
+	if err := json.Unmarshal([]byte(`{"userId":"id-ana","tours":[{"tourId":"t1","tourName":"Fruska gora"}]}`), &event); err != nil {
+		t.Fatal(err)
+	}
+	notifications, err := onCheckoutCompleted(context.Background(), envelopeFor(t, SubjectCheckoutCompleted, event))
+	if err != nil {
+		t.Fatalf("onCheckoutCompleted: %v", err)
+	}
+	if len(notifications) != 1 {
+		t.Fatalf("got %d notifications, want 1", len(notifications))
+	}
+	n := notifications[0]
+	if n.RecipientID != "id-ana" || n.ResourceType != "tour" || n.ResourceID != "t1" || n.Message != "Your purchase of Fruska gora is complete" {
+		t.Errorf("unexpected notification %+v", n)
+	}
+
+	event.Tours = append(event.Tours, event.Tours[0])
+	notifications, err = onCheckoutCompleted(context.Background(), envelopeFor(t, SubjectCheckoutCompleted, event))
+	if err != nil {
+		t.Fatalf("onCheckoutCompleted: %v", err)
+	}
+	if notifications[0].ResourceType != "" || notifications[0].Message != "Your purchase of 2 tours is complete" {
+		t.Errorf("unexpected notification %+v", notifications[0])
+	}
+}
@@ -0,0 +1,158 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"notification-service/database"
+	"notification-service/email"
+	stakeproto "notification-service/proto/stakeholders"
+	"shared/events"
+
+	"github.com/nats-io/nats.go"
+)
+
+const (
+	SubjectTourPublished              = "tours_tour_published"
+	SubjectEmailVerificationRequested = "stakeholders_email_verification_requested"
+	SubjectPasswordResetRequested     = "stakeholders_password_reset_requested"
+)
+
+// TourEvent odgovara payload-u dogadjaja o objavi ture iz tours-service.
+type TourEvent struct {
+	TourID   string `json:"tourId"`
+	AuthorID string `json:"authorId"`
+	Name     string `json:"name"`
+}
+
+// AccountTokenEvent odgovara payload-u dogadjaja iz stakeholders-service sa
+// tokenom za potvrdu email adrese ili reset lozinke. Email se salje na adresu
+// iz dogadjaja, jer je token vezan za nju.
+type AccountTokenEvent struct {
+	UserID    string    `json:"userId"`
+	Username  string    `json:"username"`
+	Email     string    `json:"email"`
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+var appBaseURL string
+
+// InitEmail postavlja adresu frontend-a za linkove u email-ovima.
+func InitEmail(baseURL string) {
+	appBaseURL = strings.TrimRight(baseURL, "/")
+}
+
+// SubscribeEmailEvents prima dogadjaje za koje se salju email-ovi i stavlja
+// email-ove u red. Consumer je odvojen od consumer-a za notifikacije, da bi
+// oba dobila svaki dogadjaj i da bi se neuspesi ponavljali nezavisno. Kljuc za
+// deduplikaciju sadrzi ID dogadjaja, pa ponovljeni dogadjaj ne salje isti
+// email ponovo.
+func SubscribeEmailEvents(natsConn *nats.Conn) {
+	events.Consume(context.Background(), natsConn, events.ConsumerConfig{
+		Durable: "notification-service-email",
+		Handlers: map[string]events.Handler{
+			SubjectCheckoutCompleted: emailPurchaseReceipt,
+			SubjectTourPublished:     emailTourPublished,
+
+			SubjectEmailVerificationRequested: emailAccountToken(email.TemplateVerification, "/verify-email"),
+			SubjectPasswordResetRequested:     emailAccountToken(email.TemplatePasswordReset, "/reset-password"),
+		},
+	})
+}
+
+func emailPurchaseReceipt(_ context.Context, envelope events.Envelope) error {
+	var event CheckoutCompletedEvent
+	if err := envelope.DecodePayload(&event); err != nil {
+		return err
+	}
+	if len(event.Tours) == 0 {
+		return nil
+	}
+
+	tours := make([]map[string]any, 0, len(event.Tours))
+	for _, tour := range event.Tours {
+		tours = append(tours, map[string]any{"TourName": tour.TourName, "Price": tour.Price})
+	}
+	return email.EnqueueForUser(database.GORM_DB, envelope.ID+":"+email.TemplatePurchaseReceipt, event.UserID, email.TemplatePurchaseReceipt, map[string]any{
+		"Tours":       tours,
+		"Amount":      event.Amount,
+		"PurchasedAt": envelope.OccurredAt.UTC().Format(time.RFC1123),
+		"ReceiptID":   envelope.ID,
+	})
+}
+
+func emailTourPublished(_ context.Context, envelope events.Envelope) error {
+	var event TourEvent
+	if err := envelope.DecodePayload(&event); err != nil {
+		return err
+	}
+
+	return email.EnqueueForUser(database.GORM_DB, envelope.ID+":"+email.TemplateTourPublished, event.AuthorID, email.TemplateTourPublished, map[string]any{
+		"TourName": event.Name,
+		"Link":     appBaseURL + "/tours/" + event.TourID,
+	})
+}
+
+// emailAccountToken salje link sa tokenom na stranicu frontend-a na path-u.
+func emailAccountToken(template, path string) events.Handler {
+	return func(ctx context.Context, envelope events.Envelope) error {
+		var event AccountTokenEvent
+		if err := envelope.DecodePayload(&event); err != nil {
+			return err
+		}
+		if event.Email == "" || event.Token == "" {
+			return nil
+		}
+		// Token je vec istekao ako je dogadjaj kasnio, pa link ne bi radio
+		if !event.ExpiresAt.IsZero() && time.Now().After(event.ExpiresAt) {
+			return nil
+		}
+
+		return email.Enqueue(database.GORM_DB, envelope.ID+":"+template, event.Email, template, map[string]any{
+			"Username":  event.Username,
+			"Link":      appBaseURL + path + "?token=" + url.QueryEscape(event.Token),
+			"ExpiresIn": formatValidity(event.ExpiresAt.Sub(envelope.OccurredAt)),
+		})
+	}
+}
+
+// formatValidity zaokruzuje trajanje tokena na sate ili minute za tekst
+// email-a.
+func formatValidity(d time.Duration) string {
+	if d >= time.Hour {
+		hours := int(d.Round(time.Hour) / time.Hour)
+		if hours == 1 {
+			return "1 hour"
+		}
+		return fmt.Sprintf("%d hours", hours)
+	}
+	minutes := int(d.Round(time.Minute) / time.Minute)
+	if minutes <= 1 {
+		return "1 minute"
+	}
+	return fmt.Sprintf("%d minutes", minutes)
+}
+
+// EmailContact razresava adresu i ime korisnika za email worker.
+func EmailContact(ctx context.Context, userID string) (email.Contact, bool, error) {
+	contacts, err := lookupContacts(ctx, []string{userID})
+	if err != nil {
+		return email.Contact{}, false, err
+	}
+	contact, ok := contacts[userID]
+	if !ok {
+		return email.Contact{}, false, nil
+	}
+	return email.Contact{Email: contact.GetEmail(), Name: displayName(contact)}, true, nil
+}
+
+func displayName(contact *stakeproto.UserContact) string {
+	if contact.GetFirstName() != "" {
+		return contact.GetFirstName()
+	}
+	return contact.GetUsername()
+}
@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"notification-service/database"
+	"notification-service/models"
+	pb "notification-service/proto/notification"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm/clause"
+)
+
+type NotificationServer struct {
+	pb.UnimplementedNotificationServiceServer
+}
+
+func NewNotificationServer() *NotificationServer {
+	return &NotificationServer{}
+}
+
+func (s *NotificationServer) ListNotifications(ctx context.Context, req *pb.ListNotificationsRequest) (*pb.ListNotificationsResponse, error) {
+	_, userId, _, err := GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := pageLimit(req.GetLimit())
+	query := database.GORM_DB.Where("recipient_id = ?", userId)
+	if req.GetUnreadOnly() {
+		query = query.Where("NOT read")
+	}
+	if req.GetCursor() != "" {
+		c, err := decodeCursor(req.GetCursor())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+		}
+		query = query.Where("(created_at, id) < (?, ?)", c.CreatedAt, c.ID)
+	}
+
+	var notifications []models.Notification
+	if err := query.Order("created_at DESC, id DESC").Limit(limit + 1).Find(&notifications).Error; err != nil {
+		log.Printf("Failed to list notifications for %s: %v", userId, err)
+		return nil, status.Error(codes.Internal, "failed to fetch notifications")
+	}
+
+	nextCursor := ""
+	if len(notifications) > limit {
+		notifications = notifications[:limit]
+		last := notifications[limit-1]
+		nextCursor = encodeCursor(cursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+
+	resp := &pb.ListNotificationsResponse{NextCursor: nextCursor}
+	for i := range notifications {
+		resp.Notifications = append(resp.Notifications, toProto(&notifications[i]))
+	}
+	return resp, nil
+}
+
+func (s *NotificationServer) GetUnreadCount(ctx context.Context, req *pb.GetUnreadCountRequest) (*pb.GetUnreadCountResponse, error) {
+	_, userId, _, err := GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var count int64
+	err = database.GORM_DB.Model(&models.Notification{}).Where("recipient_id = ? AND NOT read", userId).Count(&count).Error
+	if err != nil {
+		log.Printf("Failed to count unread notifications for %s: %v", userId, err)
+		return nil, status.Error(codes.Internal, "failed to count notifications")
+	}
+	return &pb.GetUnreadCountResponse{Count: count}, nil
+}
+
+// MarkRead oznacava notifikaciju kao procitanu, ili kao neprocitanu kada je
+// read=false.
+func (s *NotificationServer) MarkRead(ctx context.Context, req *pb.MarkReadRequest) (*pb.MarkReadResponse, error) {
+	_, userId, _, err := GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	notificationId, err := uuid.Parse(req.GetNotificationId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid notification ID")
+	}
+
+	read := true
+	if req.Read != nil {
+		read = req.GetRead()
+	}
+	var readAt *time.Time
+	if read {
+		now := time.Now().UTC()
+		readAt = &now
+	}
+
+	var notification models.Notification
+	result := database.GORM_DB.Model(&notification).
+		Clauses(clause.Returning{}).
+		Where("id = ? AND recipient_id = ?", notificationId, userId).
+		Updates(map[string]any{"read": read, "read_at": readAt})
+	if result.Error != nil {
+		log.Printf("Failed to update notification %s: %v", notificationId, result.Error)
+		return nil, status.Error(codes.Internal, "failed to update notification")
+	}
+	if result.RowsAffected == 0 {
+		return nil, status.Error(codes.NotFound, "notification not found")
+	}
+	return &pb.MarkReadResponse{Notification: toProto(&notification)}, nil
+}
+
+func (s *NotificationServer) MarkAllRead(ctx context.Context, req *pb.MarkAllReadRequest) (*pb.MarkAllReadResponse, error) {
+	_, userId, _, err := GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := database.GORM_DB.Model(&models.Notification{}).
+		Where("recipient_id = ? AND NOT read", userId).
+		Updates(map[string]any{"read": true, "read_at": time.Now().UTC()})
+	if result.Error != nil {
+		log.Printf("Failed to mark notifications read for %s: %v", userId, result.Error)
+		return nil, status.Error(codes.Internal, "failed to update notifications")
+	}
+	return &pb.MarkAllReadResponse{Updated: result.RowsAffected}, nil
+}
+
+func toProto(n *models.Notification) *pb.Notification {
+	return &pb.Notification{
+		Id:            n.ID.String(),
+		Type:          string(n.Type),
+		ActorUsername: n.ActorUsername,
+		Message:       n.Message,
+		ResourceType:  n.ResourceType,
+		ResourceId:    n.ResourceID,
+		Read:          n.Read,
+		CreatedAt:     n.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+func GetClaimsFromContext(ctx context.Context) (string, string, string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", "", "", status.Error(codes.Unauthenticated, "metadata not provided")
+	}
+
+	username := md.Get("username")
+	userId := md.Get("userId")
+	role := md.Get("role")
+
+	if len(username) == 0 || len(userId) == 0 || len(role) == 0 {
+		return "", "", "", status.Error(codes.Unauthenticated, "claims missing in metadata")
+	}
+
+	return username[0], userId[0], role[0], nil
+}
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultPageLimit = 20
+	maxPageLimit     = 100
+)
+
+// cursor pokazuje na poslednju notifikaciju prethodne stranice. Sortiranje je
+// po (created_at, id), pa je pozicija jednoznacna i kada dve notifikacije
+// imaju isto vreme.
+type cursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+func encodeCursor(c cursor) string {
+	raw := c.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + c.ID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeCursor(s string) (cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return cursor{}, err
+	}
+	createdAt, id, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return cursor{}, errors.New("invalid cursor")
+	}
+	t, err := time.Parse(time.RFC3339Nano, createdAt)
+	if err != nil {
+		return cursor{}, err
+	}
+	parsedID, err := uuid.Parse(id)
+	if err != nil {
+		return cursor{}, err
+	}
+	return cursor{CreatedAt: t, ID: parsedID}, nil
+}
+
+func pageLimit(requested int32) int {
+	if requested <= 0 {
+		return defaultPageLimit
+	}
+	if requested > maxPageLimit {
+		return maxPageLimit
+	}
+	return int(requested)
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"encoding/json"
+	"log"
+	"sync"
+
+	"notification-service/models"
+	pb "notification-service/proto/notification"
+
+	"github.com/nats-io/nats.go"
+)
+
+// Svaka replika prima sve nove notifikacije na ovom subject-u (bez queue
+// grupe) i prosledjuje ih svojim otvorenim stream-ovima, jer dogadjaj obradi
+// jedna replika a korisnik moze biti povezan na drugu.
+const subjectNotificationCreated = "notification_created"
+
+// Koliko notifikacija sme da ceka na sporog klijenta pre nego sto se
+// preskoce; propustene notifikacije klijent vidi u listi.
+const streamBuffer = 16
+
+type hub struct {
+	mu          sync.Mutex
+	subscribers map[string]map[chan *models.Notification]struct{}
+}
+
+var liveHub = &hub{subscribers: make(map[string]map[chan *models.Notification]struct{})}
+
+func (h *hub) subscribe(recipientID string) (chan *models.Notification, func()) {
+	ch := make(chan *models.Notification, streamBuffer)
+	h.mu.Lock()
+	if h.subscribers[recipientID] == nil {
+		h.subscribers[recipientID] = make(map[chan *models.Notification]struct{})
+	}
+	h.subscribers[recipientID][ch] = struct{}{}
+	h.mu.Unlock()
+
+	return ch, func() {
+		h.mu.Lock()
+		delete(h.subscribers[recipientID], ch)
+		if len(h.subscribers[recipientID]) == 0 {
+			delete(h.subscribers, recipientID)
+		}
+		h.mu.Unlock()
+	}
+}
+
+func (h *hub) deliver(n *models.Notification) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for ch := range h.subscribers[n.RecipientID] {
+		select {
+		case ch <- n:
+		default:
+			log.Printf("Stream for %s is full, dropping live notification %s", n.RecipientID, n.ID)
+		}
+	}
+}
+
+// subscribeLive prosledjuje notifikacije koje su sacuvale sve replike
+// stream-ovima otvorenim na ovoj replici.
+func subscribeLive(natsConn *nats.Conn) {
+	_, err := natsConn.Subscribe(subjectNotificationCreated, func(msg *nats.Msg) {
+		var n models.Notification
+		if err := json.Unmarshal(msg.Data, &n); err != nil {
+			log.Printf("Invalid live notification: %v", err)
+			return
+		}
+		liveHub.deliver(&n)
+	})
+	if err != nil {
+		log.Fatalf("Failed to subscribe to %s: %v", subjectNotificationCreated, err)
+	}
+}
+
+func publishLive(natsConn *nats.Conn, n *models.Notification) {
+	data, err := json.Marshal(n)
+	if err != nil {
+		log.Printf("Failed to marshal notification %s: %v", n.ID, err)
+		return
+	}
+	if err := natsConn.Publish(subjectNotificationCreated, data); err != nil {
+		log.Printf("Failed to publish live notification %s: %v", n.ID, err)
+	}
+}
+
+// StreamNotifications drzi vezu otvorenom i salje svaku novu notifikaciju
+// ulogovanog korisnika dok klijent ne prekine vezu.
+func (s *NotificationServer) StreamNotifications(req *pb.StreamNotificationsRequest, stream pb.NotificationService_StreamNotificationsServer) error {
+	_, userId, _, err := GetClaimsFromContext(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	ch, unsubscribe := liveHub.subscribe(userId)
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case n := <-ch:
+			if err := stream.Send(toProto(n)); err != nil {
+				return err
+			}
+		}
+	}
+}
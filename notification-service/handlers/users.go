@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	followerproto "notification-service/proto/follower"
+	stakeproto "notification-service/proto/stakeholders"
+)
+
+const (
+	stakeholdersTimeout = 3 * time.Second
+	followerTimeout     = 3 * time.Second
+)
+
+var (
+	stakeholdersClient stakeproto.StakeholdersServiceClient
+	followerClient     followerproto.FollowerServiceClient
+)
+
+func InitStakeholdersClient(c stakeproto.StakeholdersServiceClient) {
+	stakeholdersClient = c
+}
+
+func InitFollowerClient(c followerproto.FollowerServiceClient) {
+	followerClient = c
+}
+
+// resolveUserIDs vraca ID-jeve korisnika sa datim korisnickim imenima.
+// Nepostojeci i blokirani korisnici se izostavljaju, jer ne treba da dobijaju
+// notifikacije.
+func resolveUserIDs(ctx context.Context, usernames []string) (map[string]string, error) {
+	ids := make(map[string]string, len(usernames))
+	if len(usernames) == 0 {
+		return ids, nil
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, stakeholdersTimeout)
+	defer cancel()
+
+	resp, err := stakeholdersClient.CheckUsers(callCtx, &stakeproto.CheckUsersRequest{Usernames: usernames})
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range resp.GetUsers() {
+		if user.GetExists() && !user.GetIsBlocked() {
+			ids[user.GetUsername()] = user.GetUserId()
+		}
+	}
+	return ids, nil
+}
+
+// lookupContacts vraca podatke za slanje email-a korisnicima sa datim
+// ID-jevima. Blokirani korisnici i korisnici bez adrese se izostavljaju.
+func lookupContacts(ctx context.Context, userIds []string) (map[string]*stakeproto.UserContact, error) {
+	contacts := make(map[string]*stakeproto.UserContact, len(userIds))
+	if len(userIds) == 0 {
+		return contacts, nil
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, stakeholdersTimeout)
+	defer cancel()
+
+	resp, err := stakeholdersClient.GetUserContacts(callCtx, &stakeproto.GetUserContactsRequest{UserIds: userIds})
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range resp.GetUsers() {
+		if user.GetEmail() != "" && !user.GetIsBlocked() {
+			contacts[user.GetUserId()] = user
+		}
+	}
+	return contacts, nil
+}
+
+// isFollowing javlja da li korisnik follower prati korisnika followee.
+func isFollowing(ctx context.Context, follower, followee string) (bool, error) {
+	callCtx, cancel := context.WithTimeout(ctx, followerTimeout)
+	defer cancel()
+
+	resp, err := followerClient.IsFollowing(callCtx, &followerproto.IsFollowingRequest{Follower: follower, Followee: followee})
+	if err != nil {
+		return false, err
+	}
+	return resp.GetFollowing(), nil
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net"
+	"os"
+
+	"notification-service/database"
+	"notification-service/email"
+	"notification-service/handlers"
+	followerproto "notification-service/proto/follower"
+	pb "notification-service/proto/notification"
+	stakeproto "notification-service/proto/stakeholders"
+
+	"github.com/joho/godotenv"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/reflection"
+	"shared/events"
+)
+
+func main() {
+	if os.Getenv("NOTIFICATION_DATABASE_URL") == "" {
+		err := godotenv.Load("../.env")
+		if err != nil {
+			log.Println("Error loading .env file:", err)
+		}
+	}
+
+	database.InitDB(os.Getenv("NOTIFICATION_DATABASE_URL"))
+	defer database.CloseDB()
+
+	stakeholdersServiceAddress := "stakeholders-service:8081"
+	//stakeholdersServiceAddress := "127.0.0.1:8081"
+
+	stakeholdersConn, err := grpc.Dial(
+		stakeholdersServiceAddress,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		log.Fatalf("Failed to dial stakeholders service: %v", err)
+	}
+	defer stakeholdersConn.Close()
+	handlers.InitStakeholdersClient(stakeproto.NewStakeholdersServiceClient(stakeholdersConn))
+
+	followerServiceAddress := "follower-service:8084"
+	//followerServiceAddress := "127.0.0.1:8084"
+
+	followerConn, err := grpc.Dial(
+		followerServiceAddress,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		log.Fatalf("Failed to dial follower service: %v", err)
+	}
+	defer followerConn.Close()
+	handlers.InitFollowerClient(followerproto.NewFollowerServiceClient(followerConn))
+
+	transport, err := email.NewTransportFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to initialize email transport: %v", err)
+	}
+	email.StartWorker(context.Background(), transport, handlers.EmailContact, email.ConfigFromEnv())
+
+	appBaseURL := os.Getenv("APP_BASE_URL")
+	if appBaseURL == "" {
+		appBaseURL = "http://localhost:4200"
+	}
+	handlers.InitEmail(appBaseURL)
+
+	// Veza se uspostavlja u pozadini. Dok NATS nije dostupan postojece
+	// notifikacije se i dalje mogu citati, ali nove ne nastaju, stream ne
+	// isporucuje nista i email-ovi iz reda se i dalje salju
+	natsConn, err := events.Connect(os.Getenv("NATS_URL"), "notification-service")
+	if err != nil {
+		log.Fatalf("Failed to configure NATS connection: %v", err)
+	}
+	defer natsConn.Close()
+	handlers.SubscribeDomainEvents(natsConn)
+	handlers.SubscribeEmailEvents(natsConn)
+
+	port := "8089"
+	lis, err := net.Listen("tcp", ":"+port)
+	if err != nil {
+		log.Fatalf("Failed to listen: %v", err)
+	}
+
+	grpcServer := grpc.NewServer()
+	pb.RegisterNotificationServiceServer(grpcServer, handlers.NewNotificationServer())
+
+	reflection.Register(grpcServer)
+
+	log.Printf("Notification gRPC service listening on port %s", port)
+	if err := grpcServer.Serve(lis); err != nil {
+		log.Fatalf("Failed to serve: %v", err)
+	}
+}
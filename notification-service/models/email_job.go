@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type EmailJobStatus string
+
+const (
+	EmailPending EmailJobStatus = "pending"
+	EmailSent    EmailJobStatus = "sent"
+	EmailFailed  EmailJobStatus = "failed"
+)
+
+// EmailJob je email u redu za slanje. Data su podaci za sablon (JSON), a
+// sablon se popunjava tek pri slanju. Kada je RecipientUserID postavljen,
+// adresa se razresava pri slanju i upisuje u To. DedupKey sprecava da
+// ponovljeni dogadjaj posalje isti email dva puta.
+type EmailJob struct {
+	ID              uuid.UUID      `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	DedupKey        string         `gorm:"type:varchar(200);not null;uniqueIndex"`
+	To              string         `gorm:"column:recipient;type:varchar(320);not null"`
+	RecipientUserID string         `gorm:"type:varchar(24)"`
+	Template        string         `gorm:"type:varchar(50);not null"`
+	Data            string         `gorm:"type:jsonb;not null"`
+	Status          EmailJobStatus `gorm:"type:varchar(20);not null;default:pending;index:idx_email_job_due,priority:1"`
+	Attempts        int            `gorm:"not null;default:0"`
+	NextAttemptAt   time.Time      `gorm:"not null;index:idx_email_job_due,priority:2"`
+	LastError       string         `gorm:"type:text"`
+	CreatedAt       time.Time
+	SentAt          *time.Time
+}
@@ -0,0 +1,37 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type NotificationType string
+
+const (
+	NotificationNewFollower       NotificationType = "new_follower"
+	NotificationPostComment       NotificationType = "post_comment"
+	NotificationCommentReply      NotificationType = "comment_reply"
+	NotificationMention           NotificationType = "mention"
+	NotificationPostLike          NotificationType = "post_like"
+	NotificationTourReview        NotificationType = "tour_review"
+	NotificationReviewReply       NotificationType = "review_reply"
+	NotificationPurchaseCompleted NotificationType = "purchase_completed"
+)
+
+// Notification je jedna notifikacija jednog korisnika. EventID je ID
+// dogadjaja iz kog je nastala; par (EventID, RecipientID) je jedinstven, pa
+// ponovljena isporuka istog dogadjaja ne pravi duplikate.
+type Notification struct {
+	ID            uuid.UUID        `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	EventID       string           `json:"eventId" gorm:"type:varchar(64);not null;uniqueIndex:idx_notification_event_recipient"`
+	RecipientID   string           `json:"recipientId" gorm:"type:varchar(24);not null;uniqueIndex:idx_notification_event_recipient;index:idx_notification_recipient_created,priority:1"`
+	Type          NotificationType `json:"type" gorm:"type:varchar(50);not null"`
+	ActorUsername string           `json:"actorUsername" gorm:"type:varchar(255)"`
+	Message       string           `json:"message" gorm:"type:text;not null"`
+	ResourceType  string           `json:"resourceType" gorm:"type:varchar(20)"`
+	ResourceID    string           `json:"resourceId" gorm:"type:varchar(64)"`
+	Read          bool             `json:"read" gorm:"not null;default:false"`
+	ReadAt        *time.Time       `json:"readAt"`
+	CreatedAt     time.Time        `json:"createdAt" gorm:"not null;index:idx_notification_recipient_created,priority:2"`
+}
@@ -1,5 +1,7 @@
 import asyncio
 import json
+import uuid
+from datetime import datetime, timezone
 from typing import List
 from fastapi import APIRouter
 from nats.aio.client import Client as NATS
@@ -28,7 +30,7 @@ class PurchaseOrchestrator:
 
 		loop = asyncio.get_running_loop()
 		future = loop.create_future()
-		self.pending[tourist_id] = {"future": future, "db": db}
+		self.pending[tourist_id] = {"future": future, "db": db, "tokens": tokens}
 
 		await self.nc.publish("purchase_publish", json.dumps(event).encode())
 
@@ -73,7 +75,41 @@ class PurchaseOrchestrator:
 			result = db.query(models.TourPurchaseToken).filter(
             models.TourPurchaseToken.tourist_id == user_id
         ).all()
+			await self.publish_checkout_completed(user_id, amount, entry["tokens"])
 		
 		if not future.done():
 			future.set_result(result)
-		print(f"CHECKOUT ZAVRSEN za user {user_id} sa statusom {status}.")
\ No newline at end of file
+		print(f"CHECKOUT ZAVRSEN za user {user_id} sa statusom {status}.")
+
+	# Omotac je isti kao u Go servisima (shared/events.Envelope), da bi ga potrosaci
+	# citali na isti nacin
+	async def publish_checkout_completed(self, user_id: str, amount: float, tokens: List[models.TourPurchaseToken]):
+		event = {
+			"id": str(uuid.uuid4()),
+			"type": "purchase_checkout_completed",
+			"version": 1,
+			"occurredAt": datetime.now(timezone.utc).isoformat(),
+			"producer": "purchase-service",
+			"payload": {
+				"userId": user_id,
+				"amount": amount,
+				"tours": [
+					{"tourId": str(token.tour_id), "tourName": token.tour_name, "price": token.price}
+					for token in tokens
+				],
+			},
+		}
+		# Dogadjaj ide u JetStream stream EVENTS (subject events.<tip>), koji prave
+		# Go servisi; Nats-Msg-Id sprecava duplikat ako se objava ponovi
+		js = self.nc.jetstream()
+		for attempt in range(3):
+			try:
+				await js.publish(
+					"events.purchase_checkout_completed",
+					json.dumps(event).encode(),
+					headers={"Nats-Msg-Id": event["id"]},
+				)
+				return
+			except Exception as e:
+				print(f"Neuspesno objavljivanje purchase_checkout_completed za user {user_id} (pokusaj {attempt + 1}): {e}")
+				await asyncio.sleep(2 ** attempt)
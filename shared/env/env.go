@@ -0,0 +1,35 @@
+// Package env cita podesavanja iz promenljivih okruzenja uz podrazumevane
+// vrednosti.
+package env
+
+import (
+	"log"
+	"os"
+	"time"
+)
+
+// Duration cita trajanje iz promenljive name (npr. "30s", "24h"). Prazna
+// vrednost daje fallback, a neispravna ili negativna se loguje i zamenjuje
+// fallback-om. Nula je dozvoljena i obicno iskljucuje periodican posao.
+func Duration(name string, fallback time.Duration) time.Duration {
+	return parseDuration(name, fallback, 0)
+}
+
+// PositiveDuration je kao Duration, ali odbija i nulu; za intervale koji
+// moraju da postoje, npr. ticker-a.
+func PositiveDuration(name string, fallback time.Duration) time.Duration {
+	return parseDuration(name, fallback, time.Nanosecond)
+}
+
+func parseDuration(name string, fallback, min time.Duration) time.Duration {
+	value := os.Getenv(name)
+	if value == "" {
+		return fallback
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d < min {
+		log.Printf("Invalid %s %q, using %s", name, value, fallback)
+		return fallback
+	}
+	return d
+}
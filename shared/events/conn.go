@@ -0,0 +1,36 @@
+package events
+
+import (
+	"log"
+	"time"
+
+	"github.com/nats-io/nats.go"
+)
+
+// DefaultURL je adresa NATS-a u docker-compose mrezi.
+const DefaultURL = "nats://nats:4222"
+
+// Connect se povezuje na NATS pod imenom servisa. Ako server jos nije
+// dostupan, veza se uspostavlja u pozadini i posle svakog prekida se obnavlja
+// bez ogranicenja, pa servis moze da se pokrene pre NATS-a, a pretplate i
+// relay pocinju da rade cim se veza uspostavi. Greska znaci neispravnu
+// adresu ili opcije, a ne nedostupan server.
+func Connect(url, name string) (*nats.Conn, error) {
+	if url == "" {
+		url = DefaultURL
+	}
+	return nats.Connect(url,
+		nats.Name(name),
+		nats.RetryOnFailedConnect(true),
+		nats.MaxReconnects(-1),
+		nats.ReconnectWait(2*time.Second),
+		nats.DisconnectErrHandler(func(_ *nats.Conn, err error) {
+			if err != nil {
+				log.Printf("[nats] %s: disconnected: %v", name, err)
+			}
+		}),
+		nats.ReconnectHandler(func(nc *nats.Conn) {
+			log.Printf("[nats] %s: connected to %s", name, nc.ConnectedUrl())
+		}),
+	)
+}
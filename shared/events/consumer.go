@@ -0,0 +1,175 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// Handler obradjuje jedan dogadjaj. Nil potvrdjuje poruku, greska oznacena sa
+// Permanent je odbacuje, a svaka druga greska vraca poruku na ponovnu
+// isporuku posle backoff-a.
+type Handler func(ctx context.Context, envelope Envelope) error
+
+type permanentError struct{ err error }
+
+func (e permanentError) Error() string { return e.err.Error() }
+func (e permanentError) Unwrap() error { return e.err }
+
+// Permanent oznacava gresku koja se ponovnim pokusajem ne moze ispraviti,
+// npr. neispravan payload.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return permanentError{err: err}
+}
+
+// IsPermanent javlja da li je greska oznacena sa Permanent.
+func IsPermanent(err error) bool {
+	var permanent permanentError
+	return errors.As(err, &permanent)
+}
+
+// DecodePayload upisuje payload u v. Neispravan payload je trajna greska.
+func (e Envelope) DecodePayload(v any) error {
+	if err := json.Unmarshal(e.Payload, v); err != nil {
+		return Permanent(fmt.Errorf("invalid %s payload: %w", e.Type, err))
+	}
+	return nil
+}
+
+// ConsumerConfig opisuje trajni consumer nad stream-om EVENTS.
+type ConsumerConfig struct {
+	// Durable je ime consumer-a; replike istog servisa dele isto ime, pa svaki
+	// dogadjaj obradi samo jedna od njih.
+	Durable string
+	// Handlers mapira tip dogadjaja na obradu. Consumer prati samo te tipove.
+	Handlers map[string]Handler
+	// MaxDeliver je najveci broj isporuka jedne poruke; podrazumevano 10.
+	MaxDeliver int
+}
+
+const (
+	defaultMaxDeliver = 10
+	ackWait           = 30 * time.Second
+	maxBackoff        = 5 * time.Minute
+	setupRetry        = 2 * time.Second
+)
+
+// Consume u pozadini pravi stream i trajni consumer i obradjuje poruke dok se
+// ctx ne otkaze. Dok NATS ili JetStream nisu dostupni pokusava ponovo, pa se
+// moze pozvati odmah posle Connect. Poruke koje nisu potvrdjene ostaju u
+// stream-u dok servis ne proradi.
+func Consume(ctx context.Context, natsConn *nats.Conn, cfg ConsumerConfig) {
+	if cfg.MaxDeliver <= 0 {
+		cfg.MaxDeliver = defaultMaxDeliver
+	}
+	subjects := make([]string, 0, len(cfg.Handlers))
+	for eventType := range cfg.Handlers {
+		subjects = append(subjects, Subject(eventType))
+	}
+
+	go func() {
+		js, err := jetstream.New(natsConn)
+		if err != nil {
+			log.Printf("[events] %s: jetstream unavailable: %v", cfg.Durable, err)
+			return
+		}
+		for {
+			consumeCtx, err := startConsumer(ctx, js, cfg, subjects)
+			if err == nil {
+				<-ctx.Done()
+				consumeCtx.Stop()
+				return
+			}
+			log.Printf("[events] %s: waiting for JetStream: %v", cfg.Durable, err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(setupRetry):
+			}
+		}
+	}()
+}
+
+func startConsumer(ctx context.Context, js jetstream.JetStream, cfg ConsumerConfig, subjects []string) (jetstream.ConsumeContext, error) {
+	setupCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	if _, err := EnsureStream(setupCtx, js); err != nil {
+		return nil, err
+	}
+	consumer, err := js.CreateOrUpdateConsumer(setupCtx, StreamName, jetstream.ConsumerConfig{
+		Durable:        cfg.Durable,
+		FilterSubjects: subjects,
+		AckPolicy:      jetstream.AckExplicitPolicy,
+		DeliverPolicy:  jetstream.DeliverAllPolicy,
+		AckWait:        ackWait,
+		MaxDeliver:     cfg.MaxDeliver,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return consumer.Consume(func(msg jetstream.Msg) {
+		handleMessage(ctx, cfg, msg)
+	})
+}
+
+func handleMessage(ctx context.Context, cfg ConsumerConfig, msg jetstream.Msg) {
+	eventType := strings.TrimPrefix(msg.Subject(), subjectPrefix)
+	handle, ok := cfg.Handlers[eventType]
+	if !ok {
+		msg.Term()
+		return
+	}
+
+	var envelope Envelope
+	err := json.Unmarshal(msg.Data(), &envelope)
+	if err == nil && envelope.Version != Version {
+		err = fmt.Errorf("unsupported envelope version %d", envelope.Version)
+	}
+	if err != nil {
+		log.Printf("[events] %s: dropping invalid %s message: %v", cfg.Durable, eventType, err)
+		msg.Term()
+		return
+	}
+
+	handleCtx, cancel := context.WithTimeout(ctx, ackWait)
+	defer cancel()
+	err = handle(handleCtx, envelope)
+	if err == nil {
+		msg.Ack()
+		return
+	}
+
+	attempt := uint64(1)
+	if meta, metaErr := msg.Metadata(); metaErr == nil {
+		attempt = meta.NumDelivered
+	}
+	if IsPermanent(err) || attempt >= uint64(cfg.MaxDeliver) {
+		log.Printf("[events] %s: dropping %s %s after %d attempt(s): %v", cfg.Durable, eventType, envelope.ID, attempt, err)
+		msg.Term()
+		return
+	}
+	log.Printf("[events] %s: %s %s failed (attempt %d), retrying: %v", cfg.Durable, eventType, envelope.ID, attempt, err)
+	msg.NakWithDelay(backoff(attempt))
+}
+
+// backoff vraca pauzu pre ponovne isporuke posle attempt neuspesnih
+// pokusaja: 1s, 2s, 4s... najvise 5 minuta.
+func backoff(attempt uint64) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+	if attempt > 9 {
+		return maxBackoff
+	}
+	return min(time.Second<<(attempt-1), maxBackoff)
+}
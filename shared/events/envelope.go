@@ -0,0 +1,24 @@
+// Package events sadrzi omotac u kome servisi objavljuju domenske dogadjaje,
+// objavljivanje u JetStream stream EVENTS i trajne consumer-e za njihovo
+// citanje. Go servisi objavljuju preko svojih outbox paketa, a
+// purchase-service pravi isti omotac rucno.
+package events
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Version se povecava kada se promeni oblik omotaca. Promena oblika payload-a
+// dobija novi tip dogadjaja.
+const Version = 1
+
+// Envelope je oblik u kome se svaki dogadjaj objavljuje na NATS.
+type Envelope struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	Version    int             `json:"version"`
+	OccurredAt time.Time       `json:"occurredAt"`
+	Producer   string          `json:"producer"`
+	Payload    json.RawMessage `json:"payload"`
+}
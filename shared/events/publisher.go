@@ -0,0 +1,71 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+const publishTimeout = 5 * time.Second
+
+// Publisher objavljuje omotace u stream EVENTS i ceka da ih JetStream
+// sacuva. Outbox relay oznacava dogadjaj kao objavljen tek kada Publish vrati
+// nil.
+type Publisher struct {
+	js jetstream.JetStream
+
+	mu    sync.Mutex
+	ready bool
+}
+
+// NewPublisher pravi Publisher nad postojecom NATS vezom.
+func NewPublisher(natsConn *nats.Conn) (*Publisher, error) {
+	js, err := jetstream.New(natsConn)
+	if err != nil {
+		return nil, err
+	}
+	return &Publisher{js: js}, nil
+}
+
+// Publish objavljuje omotac na Subject(envelope.Type). ID omotaca se salje
+// kao Nats-Msg-Id, pa JetStream odbacuje ponovljenu objavu istog dogadjaja
+// unutar Duplicates prozora.
+func (p *Publisher) Publish(ctx context.Context, envelope Envelope) error {
+	if err := p.ensureStream(ctx); err != nil {
+		return err
+	}
+	data, err := json.Marshal(envelope)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, publishTimeout)
+	defer cancel()
+	_, err = p.js.Publish(ctx, Subject(envelope.Type), data, jetstream.WithMsgID(envelope.ID))
+	if err != nil {
+		// Stream je mozda obrisan; pri sledecem pokusaju se pravi ponovo
+		p.mu.Lock()
+		p.ready = false
+		p.mu.Unlock()
+	}
+	return err
+}
+
+func (p *Publisher) ensureStream(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.ready {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, publishTimeout)
+	defer cancel()
+	if _, err := EnsureStream(ctx, p.js); err != nil {
+		return err
+	}
+	p.ready = true
+	return nil
+}
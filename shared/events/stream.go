@@ -0,0 +1,36 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// StreamName je JetStream stream u koji se objavljuju svi domenski dogadjaji.
+const StreamName = "EVENTS"
+
+const subjectPrefix = "events."
+
+// Subject vraca NATS subject na kome se objavljuje dogadjaj datog tipa.
+func Subject(eventType string) string {
+	return subjectPrefix + eventType
+}
+
+// EnsureStream pravi stream EVENTS ili ga azurira na trenutnu konfiguraciju.
+// Pozivaju ga i izdavaci i potrosaci, pa redosled pokretanja servisa nije
+// bitan. Poruka se brise kada je potvrde svi trajni consumer-i koji je
+// prate; dogadjaj objavljen pre nego sto je consumer prvi put napravljen se
+// za njega ne cuva. MaxAge ogranicava koliko dugo poruka ceka na consumer-a
+// koji je ne potvrdjuje, a Duplicates koliko dugo se ponovljena objava istog
+// dogadjaja (isti Nats-Msg-Id) odbacuje.
+func EnsureStream(ctx context.Context, js jetstream.JetStream) (jetstream.Stream, error) {
+	return js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:       StreamName,
+		Subjects:   []string{subjectPrefix + ">"},
+		Retention:  jetstream.InterestPolicy,
+		Storage:    jetstream.FileStorage,
+		MaxAge:     7 * 24 * time.Hour,
+		Duplicates: time.Hour,
+	})
+}
@@ -0,0 +1,185 @@
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+
+	"golang.org/x/image/draw"
+	"golang.org/x/image/webp"
+)
+
+const (
+	// Zastita od "decompression bomb" slika
+	MaxPixels = 40_000_000
+
+	jpegQuality = 85
+)
+
+// ErrInvalidImage obuhvata sve greske validacije; handleri ih vracaju kao 400.
+var ErrInvalidImage = errors.New("invalid image")
+
+var ErrNotAnImage = fmt.Errorf("%w: file is not a supported image (jpeg, png, gif, webp)", ErrInvalidImage)
+
+type VariantSpec struct {
+	Name string
+	// Najveca dimenzija (sirina ili visina) varijante
+	MaxSize int
+	// Square varijante se centralno iseku na kvadrat pre skaliranja
+	Square bool
+}
+
+var DefaultVariants = []VariantSpec{
+	{Name: "original", MaxSize: 2048},
+	{Name: "large", MaxSize: 1280},
+	{Name: "medium", MaxSize: 640},
+	{Name: "thumbnail", MaxSize: 200, Square: true},
+}
+
+type Variant struct {
+	Name        string
+	Data        []byte
+	ContentType string
+	Extension   string
+	Width       int
+	Height      int
+}
+
+// Sniff odredjuje tip slike iz sadrzaja fajla, bez obzira na ekstenziju ili
+// Content-Type koji je klijent poslao.
+func Sniff(data []byte) (string, error) {
+	contentType := http.DetectContentType(data)
+	switch contentType {
+	case "image/jpeg", "image/png", "image/gif", "image/webp":
+		return contentType, nil
+	default:
+		return "", ErrNotAnImage
+	}
+}
+
+// Process proverava da je sadrzaj zaista slika, ispravlja EXIF orijentaciju i
+// ponovo enkodira sliku u sve trazene varijante. Ponovno enkodiranje uklanja
+// sve metapodatke (EXIF, GPS lokaciju...) iz originala.
+func Process(data []byte, specs []VariantSpec) ([]Variant, error) {
+	contentType, err := Sniff(data)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := decodeConfig(contentType, data)
+	if err != nil {
+		return nil, ErrNotAnImage
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > MaxPixels {
+		return nil, fmt.Errorf("%w: image dimensions %dx%d are not allowed", ErrInvalidImage, cfg.Width, cfg.Height)
+	}
+
+	img, err := decode(contentType, data)
+	if err != nil {
+		return nil, ErrNotAnImage
+	}
+
+	if contentType == "image/jpeg" {
+		img = applyOrientation(img, jpegOrientation(data))
+	}
+
+	// JPEG za slike bez providnosti, PNG kada treba sacuvati alfa kanal
+	asPNG := contentType != "image/jpeg" && hasAlpha(img)
+
+	variants := make([]Variant, 0, len(specs))
+	for _, spec := range specs {
+		resized := resize(img, spec)
+
+		var buf bytes.Buffer
+		variant := Variant{Name: spec.Name, Width: resized.Bounds().Dx(), Height: resized.Bounds().Dy()}
+		if asPNG {
+			err = png.Encode(&buf, resized)
+			variant.ContentType, variant.Extension = "image/png", ".png"
+		} else {
+			err = jpeg.Encode(&buf, flatten(resized), &jpeg.Options{Quality: jpegQuality})
+			variant.ContentType, variant.Extension = "image/jpeg", ".jpg"
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode %s variant: %w", spec.Name, err)
+		}
+		variant.Data = buf.Bytes()
+		variants = append(variants, variant)
+	}
+
+	return variants, nil
+}
+
+func decodeConfig(contentType string, data []byte) (image.Config, error) {
+	r := bytes.NewReader(data)
+	switch contentType {
+	case "image/jpeg":
+		return jpeg.DecodeConfig(r)
+	case "image/png":
+		return png.DecodeConfig(r)
+	case "image/gif":
+		return gif.DecodeConfig(r)
+	default:
+		return webp.DecodeConfig(r)
+	}
+}
+
+func decode(contentType string, data []byte) (image.Image, error) {
+	r := bytes.NewReader(data)
+	switch contentType {
+	case "image/jpeg":
+		return jpeg.Decode(r)
+	case "image/png":
+		return png.Decode(r)
+	case "image/gif":
+		return gif.Decode(r)
+	default:
+		return webp.Decode(r)
+	}
+}
+
+func resize(img image.Image, spec VariantSpec) image.Image {
+	src := img.Bounds()
+
+	if spec.Square {
+		side := min(src.Dx(), src.Dy())
+		x0 := src.Min.X + (src.Dx()-side)/2
+		y0 := src.Min.Y + (src.Dy()-side)/2
+		src = image.Rect(x0, y0, x0+side, y0+side)
+	}
+
+	w, h := src.Dx(), src.Dy()
+	if w > spec.MaxSize || h > spec.MaxSize {
+		if w >= h {
+			h = max(1, h*spec.MaxSize/w)
+			w = spec.MaxSize
+		} else {
+			w = max(1, w*spec.MaxSize/h)
+			h = spec.MaxSize
+		}
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, src, draw.Src, nil)
+	return dst
+}
+
+// flatten postavlja sliku na belu pozadinu jer JPEG nema alfa kanal.
+func flatten(img image.Image) image.Image {
+	dst := image.NewRGBA(img.Bounds())
+	draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(dst, dst.Bounds(), img, img.Bounds().Min, draw.Over)
+	return dst
+}
+
+func hasAlpha(img image.Image) bool {
+	if o, ok := img.(interface{ Opaque() bool }); ok {
+		return !o.Opaque()
+	}
+	return true
+}
@@ -0,0 +1,230 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"strings"
+	"testing"
+)
+
+// tiffHeader pravi TIFF zaglavlje sa jednim IFD-om koji sadrzi samo
+// Orientation tag.
+func tiffHeader(order binary.ByteOrder, orientation uint16) []byte {
+	var buf bytes.Buffer
+	if order == binary.LittleEndian {
+		buf.WriteString("II")
+	} else {
+		buf.WriteString("MM")
+	}
+	binary.Write(&buf, order, uint16(42))
+	binary.Write(&buf, order, uint32(8)) // IFD odmah posle zaglavlja
+	binary.Write(&buf, order, uint16(1)) // jedan unos
+	binary.Write(&buf, order, uint16(0x0112))
+	binary.Write(&buf, order, uint16(3)) // SHORT
+	binary.Write(&buf, order, uint32(1))
+	binary.Write(&buf, order, orientation)
+	binary.Write(&buf, order, uint16(0))
+	binary.Write(&buf, order, uint32(0)) // nema sledeceg IFD-a
+	return buf.Bytes()
+}
+
+// withAPP1 umece APP1 segment sa datim sadrzajem odmah posle SOI markera.
+func withAPP1(jpegData, payload []byte) []byte {
+	segment := []byte{0xFF, 0xE1, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(len(payload)+2))
+	segment = append(segment, payload...)
+
+	out := append([]byte{}, jpegData[:2]...)
+	out = append(out, segment...)
+	return append(out, jpegData[2:]...)
+}
+
+func exifPayload(tiff []byte) []byte {
+	return append([]byte("Exif\x00\x00"), tiff...)
+}
+
+// testJPEG pravi JPEG cija je leva polovina crvena, a desna plava.
+func testJPEG(t *testing.T, w, h int) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			c := color.RGBA{R: 255, A: 255}
+			if x >= w/2 {
+				c = color.RGBA{B: 255, A: 255}
+			}
+			img.Set(x, y, c)
+		}
+	}
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 100}); err != nil {
+		t.Fatalf("jpeg.Encode: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestJPEGOrientation(t *testing.T) {
+	base := testJPEG(t, 16, 16)
+	valid := tiffHeader(binary.LittleEndian, 6)
+
+	tests := []struct {
+		name string
+		data []byte
+		want int
+	}{
+		{"no exif", base, 1},
+		{"little endian", withAPP1(base, exifPayload(tiffHeader(binary.LittleEndian, 6))), 6},
+		{"big endian", withAPP1(base, exifPayload(tiffHeader(binary.BigEndian, 8))), 8},
+		{"orientation out of range", withAPP1(base, exifPayload(tiffHeader(binary.BigEndian, 9))), 1},
+		{"orientation zero", withAPP1(base, exifPayload(tiffHeader(binary.BigEndian, 0))), 1},
+		{"not exif app1", withAPP1(base, append([]byte("http://ns.adobe.com/xap/1.0/\x00"), valid...)), 1},
+		{"unknown byte order", withAPP1(base, exifPayload(append([]byte("XX"), valid[2:]...))), 1},
+		{"tiff shorter than header", withAPP1(base, exifPayload(valid[:6])), 1},
+		{"ifd offset past end", withAPP1(base, exifPayload(append(valid[:4:4], 0xFF, 0xFF, 0, 0))), 1},
+		{"ifd entry truncated", withAPP1(base, exifPayload(valid[:16])), 1},
+		{"exif header only", withAPP1(base, exifPayload(nil)), 1},
+		{"not a jpeg", []byte("GIF89a"), 1},
+		{"empty", nil, 1},
+		// Velicina segmenta veca od ostatka fajla
+		{"segment size past end", []byte{0xFF, 0xD8, 0xFF, 0xE1, 0xFF, 0xFF, 'E', 'x'}, 1},
+		{"segment size too small", []byte{0xFF, 0xD8, 0xFF, 0xE1, 0x00, 0x01}, 1},
+		{"garbage after soi", []byte{0xFF, 0xD8, 0x00, 0x00, 0x00, 0x00}, 1},
+		{"truncated after soi", base[:3], 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := jpegOrientation(tt.data); got != tt.want {
+				t.Errorf("jpegOrientation = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// Neispravan EXIF ne sme da obori obradu; slika se samo ne rotira.
+func TestProcessIgnoresMalformedEXIF(t *testing.T) {
+	valid := tiffHeader(binary.LittleEndian, 6)
+	data := withAPP1(testJPEG(t, 32, 16), exifPayload(valid[:16]))
+
+	variants, err := Process(data, []VariantSpec{{Name: "original", MaxSize: 2048}})
+	if err != nil {
+		t.Fatalf("Process: %v", err)
+	}
+	if v := variants[0]; v.Width != 32 || v.Height != 16 {
+		t.Errorf("size = %dx%d, want 32x16", v.Width, v.Height)
+	}
+}
+
+func TestProcessAppliesOrientation(t *testing.T) {
+	tests := []struct {
+		orientation uint16
+		// Gde zavrsava crvena (leva) polovina originala
+		redOnTop bool
+	}{
+		{6, true},  // rotacija za 90 stepeni u smeru kazaljke
+		{8, false}, // rotacija za 90 stepeni suprotno od kazaljke
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("orientation %d", tt.orientation), func(t *testing.T) {
+			data := withAPP1(testJPEG(t, 32, 16), exifPayload(tiffHeader(binary.BigEndian, tt.orientation)))
+
+			variants, err := Process(data, []VariantSpec{{Name: "original", MaxSize: 2048}})
+			if err != nil {
+				t.Fatalf("Process: %v", err)
+			}
+			v := variants[0]
+			if v.Width != 16 || v.Height != 32 {
+				t.Fatalf("size = %dx%d, want 16x32", v.Width, v.Height)
+			}
+
+			img, err := jpeg.Decode(bytes.NewReader(v.Data))
+			if err != nil {
+				t.Fatalf("decode variant: %v", err)
+			}
+			top, bottom := isRed(img.At(8, 4)), isRed(img.At(8, 27))
+			if top != tt.redOnTop || bottom == tt.redOnTop {
+				t.Errorf("red on top = %v, red on bottom = %v, want red on top = %v", top, bottom, tt.redOnTop)
+			}
+		})
+	}
+}
+
+func isRed(c color.Color) bool {
+	r, _, b, _ := c.RGBA()
+	return r > 0xC000 && b < 0x4000
+}
+
+// pngHeader pravi PNG koji ima samo ispravan IHDR, bez podataka slike, pa
+// DecodeConfig uspeva a Decode ne.
+func pngHeader(width, height uint32) []byte {
+	ihdr := make([]byte, 13)
+	binary.BigEndian.PutUint32(ihdr[0:], width)
+	binary.BigEndian.PutUint32(ihdr[4:], height)
+	ihdr[8], ihdr[9] = 8, 2 // 8 bita, RGB
+
+	var buf bytes.Buffer
+	buf.WriteString("\x89PNG\r\n\x1a\n")
+	binary.Write(&buf, binary.BigEndian, uint32(len(ihdr)))
+	chunk := append([]byte("IHDR"), ihdr...)
+	buf.Write(chunk)
+	binary.Write(&buf, binary.BigEndian, crc32.ChecksumIEEE(chunk))
+	return buf.Bytes()
+}
+
+// jpegHeader pravi JFIF JPEG sa SOF0 segmentom datih dimenzija, bez podataka
+// slike. Bez JFIF segmenta DecodeConfig cita dalje od SOF-a.
+func jpegHeader(width, height uint16) []byte {
+	app0 := []byte{0xFF, 0xE0, 0x00, 0x10, 'J', 'F', 'I', 'F', 0, 1, 1, 0, 0, 1, 0, 1, 0, 0}
+	sof := []byte{0xFF, 0xC0, 0x00, 0x11, 8, 0, 0, 0, 0, 3,
+		1, 0x11, 0, 2, 0x11, 0, 3, 0x11, 0}
+	binary.BigEndian.PutUint16(sof[5:], height)
+	binary.BigEndian.PutUint16(sof[7:], width)
+	out := append([]byte{0xFF, 0xD8}, app0...)
+	return append(out, sof...)
+}
+
+func TestProcessRejectsOversizedBeforeDecode(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"png", pngHeader(10000, 10000)},
+		{"png too wide", pngHeader(MaxPixels+1, 1)},
+		{"jpeg", jpegHeader(65000, 65000)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Process(tt.data, DefaultVariants)
+			if !errors.Is(err, ErrInvalidImage) {
+				t.Fatalf("err = %v, want ErrInvalidImage", err)
+			}
+			// Fajl nema podatke slike, pa bi dekodiranje vratilo ErrNotAnImage
+			if errors.Is(err, ErrNotAnImage) || !strings.Contains(err.Error(), "dimensions") {
+				t.Errorf("err = %v, want dimensions error", err)
+			}
+		})
+	}
+}
+
+func TestProcessRejectsNonImage(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"text", []byte("ovo nije slika")},
+		{"html", []byte("<html><body>slika</body></html>")},
+		{"truncated png", pngHeader(10, 10)[:20]},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Process(tt.data, DefaultVariants); !errors.Is(err, ErrNotAnImage) {
+				t.Errorf("err = %v, want ErrNotAnImage", err)
+			}
+		})
+	}
+}
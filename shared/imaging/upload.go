@@ -0,0 +1,48 @@
+package imaging
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"shared/storage"
+)
+
+// MaxUploadSize je najveca dozvoljena velicina fajla koji se obradjuje.
+const MaxUploadSize = 10 * 1024 * 1024
+
+// ReadUpload cita ceo fajl u memoriju i odbija fajlove vece od MaxUploadSize.
+func ReadUpload(r io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r, MaxUploadSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > MaxUploadSize {
+		return nil, fmt.Errorf("%w: file is larger than %d bytes", ErrInvalidImage, MaxUploadSize)
+	}
+	return data, nil
+}
+
+// SaveVariants obradjuje sliku i cuva sve varijante u storage pod kljucevima
+// <baseKey><ext> (original) i <baseKey>_<varijanta><ext>. Vraca mapu naziva
+// varijante na javni URL.
+func SaveVariants(ctx context.Context, store storage.Storage, baseKey string, data []byte) (map[string]string, error) {
+	variants, err := Process(data, DefaultVariants)
+	if err != nil {
+		return nil, err
+	}
+
+	urls := make(map[string]string, len(variants))
+	for _, v := range variants {
+		key := baseKey + v.Extension
+		if v.Name != "original" {
+			key = baseKey + "_" + v.Name + v.Extension
+		}
+		if err := store.Put(ctx, key, bytes.NewReader(v.Data), int64(len(v.Data)), v.ContentType); err != nil {
+			return nil, err
+		}
+		urls[v.Name] = storage.URL(key)
+	}
+	return urls, nil
+}
@@ -0,0 +1,66 @@
+// Package outbox je transakcioni outbox servisa koji koriste GORM: dogadjaj se
+// upisuje u istoj transakciji kao i promena koju opisuje, a relay ga zatim
+// objavljuje u JetStream.
+package outbox
+
+import (
+	"encoding/json"
+	"time"
+
+	"shared/events"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// Event je domenski dogadjaj upisan u istoj transakciji kao i promena koju
+// opisuje. Relay ga objavljuje na NATS redosledom Seq i postavlja
+// PublishedAt; objavljeni dogadjaji se brisu posle perioda cuvanja.
+// ClaimToken/ClaimedAt oznacavaju seriju koju je preuzela jedna replika.
+type Event struct {
+	Seq         int64          `gorm:"primaryKey;autoIncrement" json:"seq"`
+	ID          uuid.UUID      `gorm:"type:uuid;uniqueIndex;not null" json:"id"`
+	Type        string         `gorm:"type:varchar(100);not null" json:"type"`
+	Version     int            `gorm:"not null" json:"version"`
+	Payload     datatypes.JSON `gorm:"type:jsonb;not null" json:"payload"`
+	OccurredAt  time.Time      `gorm:"not null" json:"occurredAt"`
+	PublishedAt *time.Time     `gorm:"index" json:"publishedAt"`
+	Attempts    int            `gorm:"not null;default:0" json:"attempts"`
+	LastError   string         `json:"lastError"`
+	ClaimToken  *string        `gorm:"type:varchar(32);index" json:"-"`
+	ClaimedAt   *time.Time     `json:"-"`
+}
+
+// TableName zadrzava tabelu koju su servisi koristili pre prelaska na
+// zajednicki paket.
+func (Event) TableName() string {
+	return "outbox_events"
+}
+
+// Add upisuje dogadjaj u outbox u okviru transakcije tx, tako da se dogadjaj
+// objavi ako i samo ako je promena koju opisuje sacuvana.
+func Add(tx *gorm.DB, eventType string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return tx.Create(&Event{
+		ID:         uuid.New(),
+		Type:       eventType,
+		Version:    events.Version,
+		Payload:    data,
+		OccurredAt: time.Now().UTC(),
+	}).Error
+}
+
+func envelopeFor(producer string, event Event) events.Envelope {
+	return events.Envelope{
+		ID:         event.ID.String(),
+		Type:       event.Type,
+		Version:    event.Version,
+		OccurredAt: event.OccurredAt,
+		Producer:   producer,
+		Payload:    json.RawMessage(event.Payload),
+	}
+}
@@ -0,0 +1,165 @@
+package outbox
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log"
+	"time"
+
+	"shared/env"
+	"shared/events"
+
+	"github.com/nats-io/nats.go"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Posle ovog vremena dogadjaj koji je preuzela replika koja je u
+// medjuvremenu pala moze da preuzme druga replika
+const claimTimeout = 30 * time.Second
+
+// Config odredjuje koliko cesto relay proverava outbox i koliko dugo se
+// objavljeni dogadjaji cuvaju.
+type Config struct {
+	Interval  time.Duration
+	Retention time.Duration
+	BatchSize int
+}
+
+// ConfigFromEnv cita OUTBOX_POLL_INTERVAL i OUTBOX_RETENTION.
+func ConfigFromEnv() Config {
+	return Config{
+		Interval:  env.PositiveDuration("OUTBOX_POLL_INTERVAL", time.Second),
+		Retention: env.PositiveDuration("OUTBOX_RETENTION", 24*time.Hour),
+		BatchSize: 100,
+	}
+}
+
+// StartRelay objavljuje neobjavljene dogadjaje u JetStream, sa producer-om
+// upisanim u omotac. Dogadjaj se oznacava kao objavljen tek kada ga JetStream
+// potvrdi, pa se moze objaviti i vise puta; JetStream odbacuje ponovljenu
+// objavu u okviru Duplicates prozora, a potrosaci i dalje moraju biti
+// idempotentni (npr. po Envelope.ID).
+func StartRelay(ctx context.Context, producer string, db *gorm.DB, natsConn *nats.Conn, cfg Config) {
+	publisher, err := events.NewPublisher(natsConn)
+	if err != nil {
+		log.Fatalf("[outbox] %s: %v", producer, err)
+	}
+	go func() {
+		ticker := time.NewTicker(cfg.Interval)
+		defer ticker.Stop()
+		lastPurge := time.Time{}
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			for {
+				n, err := relayBatch(ctx, producer, db, publisher, cfg.BatchSize)
+				if err != nil {
+					log.Printf("[outbox] %s: relay failed: %v", producer, err)
+					break
+				}
+				if n < cfg.BatchSize {
+					break
+				}
+			}
+
+			if time.Since(lastPurge) > time.Hour {
+				purge(producer, db, cfg.Retention)
+				lastPurge = time.Now()
+			}
+		}
+	}()
+}
+
+// relayBatch preuzima najvise batchSize dogadjaja oznakom claimToken u kratkoj
+// transakciji, objavljuje ih redom upisa van transakcije i oznacava
+// objavljene. Preuzeti a neobjavljeni dogadjaji se oslobadjaju. Redosled vazi
+// samo unutar jedne serije; replike objavljuju svoje serije nezavisno, pa
+// potrosaci ne smeju da se oslanjaju na globalni redosled.
+func relayBatch(ctx context.Context, producer string, db *gorm.DB, publisher *events.Publisher, batchSize int) (int, error) {
+	db = db.WithContext(ctx)
+	token, err := newClaimToken()
+	if err != nil {
+		return 0, err
+	}
+	if err := claim(db, token, batchSize); err != nil {
+		return 0, err
+	}
+
+	var pending []Event
+	if err := db.Where("claim_token = ?", token).Order("seq").Find(&pending).Error; err != nil {
+		return 0, err
+	}
+	if len(pending) == 0 {
+		return 0, nil
+	}
+
+	seqs := make([]int64, 0, len(pending))
+	var publishErr error
+	for _, event := range pending {
+		if err := publisher.Publish(ctx, envelopeFor(producer, event)); err != nil {
+			// Ostali dogadjaji cekaju, da bi se sacuvao redosled
+			publishErr = err
+			db.Model(&Event{}).Where("seq = ?", event.Seq).
+				Updates(map[string]any{"attempts": gorm.Expr("attempts + 1"), "last_error": err.Error()})
+			break
+		}
+		seqs = append(seqs, event.Seq)
+	}
+	if len(seqs) > 0 {
+		err := db.Model(&Event{}).Where("seq IN ?", seqs).Updates(map[string]any{
+			"published_at": time.Now().UTC(),
+			"claim_token":  nil,
+			"claimed_at":   nil,
+		}).Error
+		if err != nil {
+			return 0, err
+		}
+	}
+	release := map[string]any{"claim_token": nil, "claimed_at": nil}
+	if err := db.Model(&Event{}).Where("claim_token = ?", token).Updates(release).Error; err != nil {
+		return 0, err
+	}
+	return len(seqs), publishErr
+}
+
+// claim oznacava najvise batchSize neobjavljenih dogadjaja koje nijedna
+// replika ne drzi. SKIP LOCKED sprecava da dve replike preuzmu iste redove, a
+// zakljucavanje traje samo do kraja ove transakcije, ne tokom objavljivanja.
+func claim(db *gorm.DB, token string, batchSize int) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		var seqs []int64
+		err := tx.Model(&Event{}).
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("published_at IS NULL").
+			Where("(claimed_at IS NULL OR claimed_at < ?)", time.Now().UTC().Add(-claimTimeout)).
+			Order("seq").
+			Limit(batchSize).
+			Pluck("seq", &seqs).Error
+		if err != nil || len(seqs) == 0 {
+			return err
+		}
+		return tx.Model(&Event{}).Where("seq IN ?", seqs).
+			Updates(map[string]any{"claim_token": token, "claimed_at": time.Now().UTC()}).Error
+	})
+}
+
+func newClaimToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func purge(producer string, db *gorm.DB, retention time.Duration) {
+	res := db.Where("published_at < ?", time.Now().UTC().Add(-retention)).Delete(&Event{})
+	if res.Error != nil {
+		log.Printf("[outbox] %s: purge failed: %v", producer, res.Error)
+	}
+}
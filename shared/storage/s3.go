@@ -0,0 +1,154 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+type S3Config struct {
+	Endpoint  string
+	AccessKey string
+	SecretKey string
+	Bucket    string
+	Region    string
+	UseSSL    bool
+	// PublicEndpoint je adresa preko koje klijenti (browser) dolaze do S3,
+	// npr. "https://files.example.com" ili "localhost:9000". Potpisani URL-ovi
+	// se prave za nju, jer potpis vazi samo za host za koji je napravljen.
+	// Prazna vrednost koristi Endpoint.
+	PublicEndpoint string
+}
+
+// S3Storage radi sa bilo kojim S3 kompatibilnim servisom (AWS S3, MinIO...).
+type S3Storage struct {
+	client *minio.Client
+	// presigner potpisuje URL-ove za javnu adresu; za Endpoint je isti kao
+	// client
+	presigner *minio.Client
+	bucket    string
+}
+
+func NewS3Storage(cfg S3Config) (*S3Storage, error) {
+	if cfg.Endpoint == "" || cfg.Bucket == "" {
+		return nil, errors.New("S3_ENDPOINT and S3_BUCKET must be set")
+	}
+
+	client, err := minio.New(cfg.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.AccessKey, cfg.SecretKey, ""),
+		Secure: cfg.UseSSL,
+		Region: cfg.Region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	exists, err := client.BucketExists(ctx, cfg.Bucket)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check bucket %s: %w", cfg.Bucket, err)
+	}
+	if !exists {
+		if err := client.MakeBucket(ctx, cfg.Bucket, minio.MakeBucketOptions{Region: cfg.Region}); err != nil {
+			return nil, fmt.Errorf("failed to create bucket %s: %w", cfg.Bucket, err)
+		}
+	}
+
+	presigner := client
+	if cfg.PublicEndpoint != "" {
+		presigner, err = newPresigner(cfg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &S3Storage{client: client, presigner: presigner, bucket: cfg.Bucket}, nil
+}
+
+// newPresigner pravi klijenta za javnu adresu. Region mora biti poznat, jer bi
+// klijent inace pitao S3 za region bucket-a preko javne adrese, koja iz
+// kontejnera obicno nije dostupna; potpisivanje je inace lokalno.
+func newPresigner(cfg S3Config) (*minio.Client, error) {
+	host, secure := cfg.PublicEndpoint, cfg.UseSSL
+	if strings.Contains(host, "://") {
+		u, err := url.Parse(host)
+		if err != nil || u.Host == "" {
+			return nil, fmt.Errorf("invalid S3_PUBLIC_ENDPOINT %q", cfg.PublicEndpoint)
+		}
+		host, secure = u.Host, u.Scheme == "https"
+	}
+	region := cfg.Region
+	if region == "" {
+		region = "us-east-1"
+	}
+	return minio.New(host, &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.AccessKey, cfg.SecretKey, ""),
+		Secure: secure,
+		Region: region,
+	})
+}
+
+func (s *S3Storage) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+
+	_, err := s.client.PutObject(ctx, s.bucket, key, r, size, minio.PutObjectOptions{ContentType: contentType})
+	return err
+}
+
+func (s *S3Storage) Get(ctx context.Context, key string) (io.ReadCloser, ObjectInfo, error) {
+	if err := validateKey(key); err != nil {
+		return nil, ObjectInfo{}, err
+	}
+
+	obj, err := s.client.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, ObjectInfo{}, err
+	}
+
+	// GetObject je lenj, Stat otkriva da li objekat zapravo postoji
+	stat, err := obj.Stat()
+	if err != nil {
+		obj.Close()
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, ObjectInfo{}, ErrNotFound
+		}
+		return nil, ObjectInfo{}, err
+	}
+
+	return obj, ObjectInfo{
+		Key:          key,
+		Size:         stat.Size,
+		ContentType:  stat.ContentType,
+		LastModified: stat.LastModified,
+	}, nil
+}
+
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	return s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{})
+}
+
+func (s *S3Storage) SignedURL(ctx context.Context, key string, expiry time.Duration) (string, error) {
+	if err := validateKey(key); err != nil {
+		return "", err
+	}
+
+	u, err := s.presigner.PresignedGetObject(ctx, s.bucket, key, expiry, nil)
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
@@ -0,0 +1,102 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// URLPrefix je javna putanja preko koje gateway servira uploadovane fajlove.
+const URLPrefix = "/uploads/"
+
+var ErrNotFound = errors.New("object not found")
+
+type ObjectInfo struct {
+	Key          string
+	Size         int64
+	ContentType  string
+	LastModified time.Time
+}
+
+// Storage apstrahuje mesto gde servisi cuvaju uploadovane slike, tako da vise
+// replika istog servisa (i gateway) vide iste fajlove.
+type Storage interface {
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, ObjectInfo, error)
+	Delete(ctx context.Context, key string) error
+	// SignedURL vraca privremeni URL za direktan pristup objektu. Backend koji
+	// ne podrzava potpisivanje vraca stabilni URL.
+	SignedURL(ctx context.Context, key string, expiry time.Duration) (string, error)
+}
+
+// NewFromEnv bira backend na osnovu STORAGE_BACKEND (local ili s3). Za s3,
+// S3_PUBLIC_ENDPOINT je adresa za koju se potpisuju URL-ovi kada se razlikuje
+// od S3_ENDPOINT (npr. minio:9000 u docker mrezi).
+func NewFromEnv() (Storage, error) {
+	switch backend := os.Getenv("STORAGE_BACKEND"); backend {
+	case "", "local":
+		root := os.Getenv("STORAGE_LOCAL_ROOT")
+		if root == "" {
+			root = "static/uploads"
+		}
+		return NewLocalStorage(root)
+	case "s3":
+		return NewS3Storage(S3Config{
+			Endpoint:  os.Getenv("S3_ENDPOINT"),
+			AccessKey: os.Getenv("S3_ACCESS_KEY"),
+			SecretKey: os.Getenv("S3_SECRET_KEY"),
+			Bucket:    os.Getenv("S3_BUCKET"),
+			Region:    os.Getenv("S3_REGION"),
+			UseSSL:    os.Getenv("S3_USE_SSL") == "true",
+
+			PublicEndpoint: os.Getenv("S3_PUBLIC_ENDPOINT"),
+		})
+	default:
+		return nil, fmt.Errorf("unknown STORAGE_BACKEND %q", backend)
+	}
+}
+
+// URL vraca stabilni javni URL za kljuc objekta.
+func URL(key string) string {
+	return URLPrefix + strings.TrimPrefix(key, "/")
+}
+
+// KeyFromURL je inverz funkcije URL. Za URL-ove koji ne pripadaju storage-u
+// vraca false.
+func KeyFromURL(url string) (string, bool) {
+	url = "/" + strings.TrimPrefix(url, "/")
+	if !strings.HasPrefix(url, URLPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(url, URLPrefix), true
+}
+
+func validateKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "..") || strings.Contains(key, "\\") {
+		return fmt.Errorf("invalid object key %q", key)
+	}
+	return nil
+}
+
+// KeysFromURLs vraca kljuc originala i sortirane kljuceve svih varijanti
+// jednog uploada, iz mape koju vraca imaging.SaveVariants.
+func KeysFromURLs(urls map[string]string) (string, []string, error) {
+	key, ok := KeyFromURL(urls["original"])
+	if !ok {
+		return "", nil, errors.New("upload without original variant")
+	}
+
+	keys := make([]string, 0, len(urls))
+	for _, url := range urls {
+		if k, ok := KeyFromURL(url); ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return key, keys, nil
+}
@@ -0,0 +1,110 @@
+// Package uploadgc sadrzi podesavanja i izvestaj za brisanje uploadovanih
+// fajlova koje nijedan entitet ne koristi. Svaki servis evidentira uploade u
+// svojoj bazi, a ovde je zajednicki deo koji ne zavisi od baze.
+package uploadgc
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	"shared/env"
+)
+
+// Config odredjuje koliko cesto sweeper radi i koliko dugo upload sme da ostane
+// bez reference pre nego sto se obrise. U DryRun modu sweeper samo loguje
+// izvestaj o siroticima i nista ne brise.
+type Config struct {
+	Interval    time.Duration
+	GracePeriod time.Duration
+	DryRun      bool
+	BatchSize   int
+}
+
+// ConfigFromEnv cita UPLOAD_GC_INTERVAL, UPLOAD_GC_GRACE_PERIOD i
+// UPLOAD_GC_DRY_RUN. Interval 0 iskljucuje sweeper.
+func ConfigFromEnv() Config {
+	return Config{
+		Interval:    env.Duration("UPLOAD_GC_INTERVAL", time.Hour),
+		GracePeriod: env.Duration("UPLOAD_GC_GRACE_PERIOD", 24*time.Hour),
+		DryRun:      os.Getenv("UPLOAD_GC_DRY_RUN") == "true",
+		BatchSize:   500,
+	}
+}
+
+// Orphan je upload bez reference koji je stariji od grace perioda.
+type Orphan struct {
+	Key       string    `json:"key"`
+	OwnerID   string    `json:"ownerId"`
+	Keys      []string  `json:"keys"`
+	IdleSince time.Time `json:"idleSince"`
+}
+
+// Report je rezultat jednog prolaza sweeper-a. Found i Files broje sirotice i
+// njihove fajlove; u DryRun modu to je ono sto bi bilo obrisano.
+type Report struct {
+	DryRun  bool      `json:"dryRun"`
+	Cutoff  time.Time `json:"cutoff"`
+	Orphans []Orphan  `json:"orphans"`
+	Found   int       `json:"found"`
+	Files   int       `json:"files"`
+	Deleted int       `json:"deleted"`
+	Failed  int       `json:"failed"`
+}
+
+// Add dodaje siroce u izvestaj i azurira brojace.
+func (r *Report) Add(o Orphan) {
+	r.Orphans = append(r.Orphans, o)
+	r.Found++
+	r.Files += len(o.Keys)
+}
+
+// Log upisuje izvestaj jednog prolaza u log servisa. Sazetak sa brojevima se
+// upisuje uvek, i kada sirotica nema.
+func (r *Report) Log(service string) {
+	if r.DryRun {
+		for _, o := range r.Orphans {
+			log.Printf("[upload-gc] %s: would delete %s (owner %q, %d files, idle since %s)", service, o.Key, o.OwnerID, len(o.Keys), o.IdleSince.Format(time.RFC3339))
+		}
+		log.Printf("[upload-gc] %s: dry run: %d orphaned uploads (%d files) older than %s would be deleted", service, r.Found, r.Files, r.Cutoff.Format(time.RFC3339))
+		return
+	}
+	log.Printf("[upload-gc] %s: %d orphaned uploads (%d files) found, %d deleted, %d failed", service, r.Found, r.Files, r.Deleted, r.Failed)
+}
+
+// Start periodicno pokrece sweep dok se kontekst ne otkaze i loguje izvestaj
+// svakog prolaza.
+func Start(ctx context.Context, service string, cfg Config, sweep func(context.Context) (*Report, error)) {
+	if cfg.Interval <= 0 {
+		log.Printf("[upload-gc] %s: sweeper disabled", service)
+		return
+	}
+
+	run := func() {
+		report, err := sweep(ctx)
+		if err != nil {
+			log.Printf("[upload-gc] %s: sweep failed: %v", service, err)
+			return
+		}
+		report.Log(service)
+	}
+
+	go func() {
+		// U DryRun modu izvestaj je potreban odmah, a ne tek posle prvog
+		// intervala
+		if cfg.DryRun {
+			run()
+		}
+		ticker := time.NewTicker(cfg.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				run()
+			}
+		}
+	}()
+}
@@ -0,0 +1,151 @@
+// Package uploads evidentira uploadovane slike servisa koji koriste GORM, da
+// bi sweeper mogao da obrise one koje nijedan entitet ne koristi.
+package uploads
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"shared/storage"
+	"shared/uploadgc"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ErrNotOwner se vraca kada korisnik pokusa da veze tudji upload ili upload
+// koji vec koristi drugi entitet.
+var ErrNotOwner = errors.New("upload belongs to another user or entity")
+
+// Upload prati jednu uploadovanu sliku zajedno sa svim njenim varijantama.
+// Key je kljuc originala u storage-u, a RefType/RefID entitet koji sliku
+// koristi. Upload bez reference stariji od grace perioda brise sweeper.
+type Upload struct {
+	Key        string                      `gorm:"type:varchar(255);primaryKey" json:"key"`
+	OwnerID    string                      `gorm:"type:varchar(24);index" json:"ownerId"`
+	Keys       datatypes.JSONSlice[string] `json:"keys"`
+	RefType    string                      `gorm:"type:varchar(50)" json:"refType"`
+	RefID      string                      `gorm:"type:varchar(64)" json:"refId"`
+	CreatedAt  time.Time                   `gorm:"not null;default:now()" json:"createdAt"`
+	ReleasedAt *time.Time                  `json:"releasedAt"`
+}
+
+// Register upisuje novi upload bez reference. Ako entitet koji ga koristi
+// nikada ne bude sacuvan, sweeper ce ga obrisati nakon grace perioda. Prazan
+// ownerID znaci da vlasnik nije poznat; tada upload preuzima prvi korisnik
+// koji ga veze za svoj entitet.
+func Register(db *gorm.DB, ownerID string, urls map[string]string) error {
+	key, keys, err := storage.KeysFromURLs(urls)
+	if err != nil {
+		return err
+	}
+	return db.Create(&Upload{Key: key, OwnerID: ownerID, Keys: keys}).Error
+}
+
+// Attach vezuje upload za entitet korisnika ownerID. Upload drugog korisnika,
+// ili upload koji je vec vezan za drugi entitet, vraca ErrNotOwner. URL-ovi
+// koji nisu u storage-u ili nemaju zapis (stari uploadi) se ignorisu.
+func Attach(tx *gorm.DB, url, refType, refID, ownerID string) error {
+	key, ok := storage.KeyFromURL(url)
+	if !ok {
+		return nil
+	}
+
+	var upload Upload
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&upload, "key = ?", key).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if upload.OwnerID != "" && upload.OwnerID != ownerID {
+		return ErrNotOwner
+	}
+	if upload.RefType != "" && (upload.RefType != refType || upload.RefID != refID) {
+		return ErrNotOwner
+	}
+
+	return tx.Model(&Upload{}).Where("key = ?", key).Updates(map[string]any{
+		"ref_type":    refType,
+		"ref_id":      refID,
+		"owner_id":    ownerID,
+		"released_at": nil,
+	}).Error
+}
+
+// Release oslobadja upload kada entitet promeni ili obrise sliku. Fajlovi
+// ostaju do isteka grace perioda.
+func Release(tx *gorm.DB, url string) error {
+	key, ok := storage.KeyFromURL(url)
+	if !ok {
+		return nil
+	}
+	return tx.Model(&Upload{}).Where("key = ?", key).
+		Updates(map[string]any{"ref_type": "", "ref_id": "", "released_at": time.Now()}).Error
+}
+
+// Sweep pronalazi uploade bez reference starije od grace perioda i brise ih
+// zajedno sa svim varijantama. U DryRun modu samo vraca izvestaj.
+func Sweep(ctx context.Context, db *gorm.DB, store storage.Storage, cfg uploadgc.Config) (*uploadgc.Report, error) {
+	report := &uploadgc.Report{DryRun: cfg.DryRun, Cutoff: time.Now().Add(-cfg.GracePeriod)}
+
+	var candidates []Upload
+	err := db.WithContext(ctx).
+		Where("ref_type = ''").
+		Where("COALESCE(released_at, created_at) < ?", report.Cutoff).
+		Order("created_at asc").
+		Limit(cfg.BatchSize).
+		Find(&candidates).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, upload := range candidates {
+		idleSince := upload.CreatedAt
+		if upload.ReleasedAt != nil {
+			idleSince = *upload.ReleasedAt
+		}
+		report.Add(uploadgc.Orphan{Key: upload.Key, OwnerID: upload.OwnerID, Keys: upload.Keys, IdleSince: idleSince})
+		if cfg.DryRun {
+			continue
+		}
+
+		// Zapis se brise uslovno u istoj transakciji, pa upload koji je u
+		// medjuvremenu vezan za entitet ostaje netaknut
+		deleted := false
+		err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			res := tx.Where("key = ? AND ref_type = ''", upload.Key).Delete(&Upload{})
+			if res.Error != nil || res.RowsAffected == 0 {
+				return res.Error
+			}
+			deleted = true
+			for _, key := range upload.Keys {
+				if err := store.Delete(ctx, key); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			log.Printf("[upload-gc] failed to delete upload %s: %v", upload.Key, err)
+			report.Failed++
+			continue
+		}
+		if deleted {
+			report.Deleted++
+		}
+	}
+
+	return report, nil
+}
+
+// StartSweeper periodicno pokrece Sweep dok se kontekst ne otkaze.
+func StartSweeper(ctx context.Context, service string, db *gorm.DB, store storage.Storage, cfg uploadgc.Config) {
+	uploadgc.Start(ctx, service, cfg, func(ctx context.Context) (*uploadgc.Report, error) {
+		return Sweep(ctx, db, store, cfg)
+	})
+}
@@ -0,0 +1,510 @@
+package handlers
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"net/mail"
+	"os"
+	"stakeholders-service/models"
+	"stakeholders-service/outbox"
+	"strings"
+	"time"
+
+	stakeholdersutils "stakeholders-service/utils"
+
+	"github.com/golang-jwt/jwt"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"golang.org/x/crypto/bcrypt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	stakeproto "stakeholders-service/proto/stakeholders"
+)
+
+const (
+	SubjectEmailVerificationRequested = "stakeholders_email_verification_requested"
+	SubjectPasswordResetRequested     = "stakeholders_password_reset_requested"
+)
+
+const (
+	tokenPurposeVerifyEmail   = "verify_email"
+	tokenPurposeResetPassword = "reset_password"
+
+	verificationTokenTTL = 24 * time.Hour
+	resetTokenTTL        = time.Hour
+	// Novi token za istu svrhu se ne salje cesce od ovoga, da javne rute ne
+	// bi mogle da se koriste za slanje gomile email-ova.
+	tokenResendInterval = time.Minute
+
+	minPasswordLength = 8
+	maxEmailLength    = 254
+
+	emailIndexName = "email_unique"
+)
+
+var (
+	errInvalidAccountToken = errors.New("invalid or expired token")
+)
+
+// AuthConfig odredjuje da li nalog sa nepotvrdjenom email adresom sme da se
+// prijavi. Novi nalozi mogu da se prijavljuju bez potvrde tokom GracePeriod-a
+// od registracije.
+type AuthConfig struct {
+	RequireEmailVerification bool
+	GracePeriod              time.Duration
+}
+
+// AuthConfigFromEnv cita REQUIRE_EMAIL_VERIFICATION i
+// EMAIL_VERIFICATION_GRACE_PERIOD. Podrazumevano se prijava ne ogranicava.
+func AuthConfigFromEnv() AuthConfig {
+	cfg := AuthConfig{RequireEmailVerification: os.Getenv("REQUIRE_EMAIL_VERIFICATION") == "true"}
+	if value := os.Getenv("EMAIL_VERIFICATION_GRACE_PERIOD"); value != "" {
+		d, err := time.ParseDuration(value)
+		if err != nil || d < 0 {
+			log.Printf("Invalid EMAIL_VERIFICATION_GRACE_PERIOD %q, using 0", value)
+		} else {
+			cfg.GracePeriod = d
+		}
+	}
+	return cfg
+}
+
+// accountToken je jednokratni token za potvrdu email adrese ili reset
+// lozinke. Cuva se samo hash tokena, a Email je adresa na koju je token
+// poslat, pa token ne vazi ako se adresa u medjuvremenu promeni.
+type accountToken struct {
+	ID        string             `bson:"_id"`
+	UserID    primitive.ObjectID `bson:"user_id"`
+	Purpose   string             `bson:"purpose"`
+	Email     string             `bson:"email"`
+	CreatedAt time.Time          `bson:"created_at"`
+	ExpiresAt time.Time          `bson:"expires_at"`
+}
+
+// AccountTokenEvent se objavljuje kada korisniku treba poslati link za potvrdu
+// email adrese ili reset lozinke; email salje notification-service.
+type AccountTokenEvent struct {
+	UserID    string    `json:"userId"`
+	Username  string    `json:"username"`
+	Email     string    `json:"email"`
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+func usersCollection(mongoClient *mongo.Client) *mongo.Collection {
+	return mongoClient.Database("stakeholders").Collection("users")
+}
+
+func accountTokensCollection(mongoClient *mongo.Client) *mongo.Collection {
+	return mongoClient.Database("stakeholders").Collection("account_tokens")
+}
+
+// EnsureAccountIndexes svodi postojece email adrese na mala slova, oznacava
+// naloge nastale pre potvrde email-a kao potvrdjene i pravi jedinstveni indeks
+// na email-u i indekse za tokene. Jedinstvenost email-a garantuje samo
+// indeks, pa ako postojece adrese nisu jedinstvene, vraca se greska sa
+// spiskom duplikata koje treba razresiti pre pokretanja servisa.
+func EnsureAccountIndexes(ctx context.Context, mongoClient *mongo.Client) error {
+	users := usersCollection(mongoClient)
+
+	lowercase := bson.A{bson.M{"$set": bson.M{"email": bson.M{"$toLower": bson.M{"$trim": bson.M{"input": "$email"}}}}}}
+	if _, err := users.UpdateMany(ctx, bson.M{"email": bson.M{"$type": "string"}}, lowercase); err != nil {
+		return err
+	}
+	if _, err := users.UpdateMany(ctx, bson.M{"email_verified": bson.M{"$exists": false}}, bson.M{"$set": bson.M{"email_verified": true}}); err != nil {
+		return err
+	}
+
+	_, err := accountTokensCollection(mongoClient).Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "expires_at", Value: 1}}, Options: options.Index().SetExpireAfterSeconds(0)},
+		{Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "purpose", Value: 1}}},
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := checkDuplicateEmails(ctx, users); err != nil {
+		return err
+	}
+
+	// Stari nalozi mogu biti bez email adrese, pa indeks obuhvata samo
+	// neprazne adrese
+	_, err = users.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "email", Value: 1}},
+		Options: options.Index().
+			SetName(emailIndexName).
+			SetUnique(true).
+			SetPartialFilterExpression(bson.M{"email": bson.M{"$type": "string", "$gt": ""}}),
+	})
+	return err
+}
+
+// checkDuplicateEmails vraca gresku ako vise naloga koristi istu email
+// adresu i loguje svaku takvu adresu sa korisnickim imenima naloga.
+func checkDuplicateEmails(ctx context.Context, users *mongo.Collection) error {
+	cursor, err := users.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"email": bson.M{"$type": "string", "$gt": ""}}}},
+		{{Key: "$group", Value: bson.M{"_id": "$email", "count": bson.M{"$sum": 1}, "usernames": bson.M{"$push": "$username"}}}},
+		{{Key: "$match", Value: bson.M{"count": bson.M{"$gt": 1}}}},
+	})
+	if err != nil {
+		return err
+	}
+	var duplicates []struct {
+		Email     string   `bson:"_id"`
+		Usernames []string `bson:"usernames"`
+	}
+	if err := cursor.All(ctx, &duplicates); err != nil {
+		return err
+	}
+	if len(duplicates) == 0 {
+		return nil
+	}
+
+	for _, d := range duplicates {
+		log.Printf("Email %s is used by accounts: %s", d.Email, strings.Join(d.Usernames, ", "))
+	}
+	return fmt.Errorf("%d email addresses are used by more than one account; change them before index %s can be created", len(duplicates), emailIndexName)
+}
+
+// normalizeEmail vraca adresu malim slovima ako je to jedna adresa bez imena
+// (npr. "ana@example.com", a ne "Ana <ana@example.com>").
+func normalizeEmail(email string) (string, bool) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if email == "" || len(email) > maxEmailLength {
+		return "", false
+	}
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Name != "" || addr.Address != email {
+		return "", false
+	}
+	return email, true
+}
+
+func validatePassword(password string) error {
+	if len(password) < minPasswordLength {
+		return status.Errorf(codes.InvalidArgument, "password must be at least %d characters long", minPasswordLength)
+	}
+	return nil
+}
+
+func isDuplicateEmailError(err error) bool {
+	return mongo.IsDuplicateKeyError(err) && strings.Contains(err.Error(), "index: "+emailIndexName)
+}
+
+func hashAccountToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// canLogin proverava da li korisnik sme da se prijavi s obzirom na potvrdu
+// email adrese.
+func (s *StakeholdersServer) canLogin(user models.User) bool {
+	if !s.auth.RequireEmailVerification || user.EmailVerified {
+		return true
+	}
+	return time.Since(user.ID.Timestamp()) < s.auth.GracePeriod
+}
+
+// issuedAfterPasswordChange proverava da li je token izdat posle poslednje
+// promene lozinke. Vreme promene se cuva u sekundama kao i iat, pa token
+// izdat u istoj sekundi kao promena i dalje vazi.
+func issuedAfterPasswordChange(claims jwt.MapClaims, passwordChangedAt *time.Time) bool {
+	if passwordChangedAt == nil {
+		return true
+	}
+	iat, ok := claims["iat"].(float64)
+	return ok && int64(iat) >= passwordChangedAt.Unix()
+}
+
+// issueAccountToken pravi novi token za korisnika, ponistava prethodne tokene
+// iste svrhe i upisuje dogadjaj za slanje email-a u outbox. sc mora biti
+// SessionContext transakcije.
+func (s *StakeholdersServer) issueAccountToken(sc mongo.SessionContext, user models.User, purpose string) error {
+	subject, ttl := SubjectEmailVerificationRequested, verificationTokenTTL
+	if purpose == tokenPurposeResetPassword {
+		subject, ttl = SubjectPasswordResetRequested, resetTokenTTL
+	}
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return err
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+
+	now := time.Now().UTC()
+	record := accountToken{
+		ID:        hashAccountToken(token),
+		UserID:    user.ID,
+		Purpose:   purpose,
+		Email:     user.Email,
+		CreatedAt: now,
+		ExpiresAt: now.Add(ttl),
+	}
+
+	tokens := accountTokensCollection(s.mongoClient)
+	if _, err := tokens.DeleteMany(sc, bson.M{"user_id": user.ID, "purpose": purpose}); err != nil {
+		return err
+	}
+	if _, err := tokens.InsertOne(sc, record); err != nil {
+		return err
+	}
+
+	// Token ne sme da ostane u outbox-u posle objavljivanja
+	return outbox.AddSensitive(sc, s.mongoClient, subject, AccountTokenEvent{
+		UserID:    user.ID.Hex(),
+		Username:  user.Username,
+		Email:     user.Email,
+		Token:     token,
+		ExpiresAt: record.ExpiresAt,
+	})
+}
+
+// sendAccountToken salje token korisniku koji odgovara filteru. Ako takav
+// korisnik ne postoji ili je token nedavno poslat, ne radi nista, da odgovor
+// ne bi otkrio da li nalog postoji.
+func (s *StakeholdersServer) sendAccountToken(ctx context.Context, filter bson.M, purpose string) error {
+	var user models.User
+	err := usersCollection(s.mongoClient).FindOne(ctx, filter).Decode(&user)
+	if err == mongo.ErrNoDocuments {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	recent, err := accountTokensCollection(s.mongoClient).CountDocuments(ctx, bson.M{
+		"user_id":    user.ID,
+		"purpose":    purpose,
+		"created_at": bson.M{"$gt": time.Now().UTC().Add(-tokenResendInterval)},
+	})
+	if err != nil {
+		return err
+	}
+	if recent > 0 {
+		return nil
+	}
+
+	return s.withTransaction(ctx, func(sc mongo.SessionContext) error {
+		return s.issueAccountToken(sc, user, purpose)
+	})
+}
+
+// consumeAccountToken brise token i vraca ga ako je vazeci. TTL indeks brise
+// istekle tokene sa zakasnjenjem, pa se istek proverava i ovde.
+func (s *StakeholdersServer) consumeAccountToken(sc mongo.SessionContext, token, purpose string) (accountToken, error) {
+	var record accountToken
+	err := accountTokensCollection(s.mongoClient).FindOneAndDelete(sc, bson.M{"_id": hashAccountToken(token), "purpose": purpose}).Decode(&record)
+	if err == mongo.ErrNoDocuments {
+		return record, errInvalidAccountToken
+	}
+	if err != nil {
+		return record, err
+	}
+	if time.Now().After(record.ExpiresAt) {
+		return record, errInvalidAccountToken
+	}
+	return record, nil
+}
+
+func (s *StakeholdersServer) VerifyEmail(ctx context.Context, req *stakeproto.VerifyEmailRequest) (*stakeproto.VerifyEmailResponse, error) {
+	if req.Token == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "token is required")
+	}
+
+	err := s.withTransaction(ctx, func(sc mongo.SessionContext) error {
+		record, err := s.consumeAccountToken(sc, req.Token, tokenPurposeVerifyEmail)
+		if err != nil {
+			return err
+		}
+		result, err := usersCollection(s.mongoClient).UpdateOne(sc,
+			bson.M{"_id": record.UserID, "email": record.Email},
+			bson.M{"$set": bson.M{"email_verified": true, "email_verified_at": time.Now().UTC()}},
+		)
+		if err != nil {
+			return err
+		}
+		if result.MatchedCount == 0 {
+			return errInvalidAccountToken
+		}
+		return nil
+	})
+	if errors.Is(err, errInvalidAccountToken) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid or expired token")
+	}
+	if err != nil {
+		log.Printf("Failed to verify email: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to verify email")
+	}
+
+	return &stakeproto.VerifyEmailResponse{Status: "email verified"}, nil
+}
+
+func (s *StakeholdersServer) ResendVerificationEmail(ctx context.Context, req *stakeproto.ResendVerificationEmailRequest) (*stakeproto.ResendVerificationEmailResponse, error) {
+	email, ok := normalizeEmail(req.Email)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "a valid email address is required")
+	}
+
+	err := s.sendAccountToken(ctx, bson.M{"email": email, "email_verified": false, "is_blocked": false}, tokenPurposeVerifyEmail)
+	if err != nil {
+		log.Printf("Failed to resend verification email: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to send verification email")
+	}
+
+	return &stakeproto.ResendVerificationEmailResponse{
+		Status: "if an unverified account with this email exists, a verification email has been sent",
+	}, nil
+}
+
+func (s *StakeholdersServer) RequestPasswordReset(ctx context.Context, req *stakeproto.RequestPasswordResetRequest) (*stakeproto.RequestPasswordResetResponse, error) {
+	email, ok := normalizeEmail(req.Email)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "a valid email address is required")
+	}
+
+	err := s.sendAccountToken(ctx, bson.M{"email": email, "is_blocked": false}, tokenPurposeResetPassword)
+	if err != nil {
+		log.Printf("Failed to request password reset: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to request password reset")
+	}
+
+	return &stakeproto.RequestPasswordResetResponse{
+		Status: "if an account with this email exists, a password reset email has been sent",
+	}, nil
+}
+
+// ResetPassword postavlja novu lozinku uz token iz email-a. Posto token
+// dokazuje da korisnik ima pristup adresi, adresa se oznacava kao potvrdjena.
+func (s *StakeholdersServer) ResetPassword(ctx context.Context, req *stakeproto.ResetPasswordRequest) (*stakeproto.ResetPasswordResponse, error) {
+	if req.Token == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "token is required")
+	}
+	if err := validatePassword(req.NewPassword); err != nil {
+		return nil, err
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), 10)
+	if err != nil {
+		log.Printf("Failed to hash password: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to reset password")
+	}
+
+	err = s.withTransaction(ctx, func(sc mongo.SessionContext) error {
+		record, err := s.consumeAccountToken(sc, req.Token, tokenPurposeResetPassword)
+		if err != nil {
+			return err
+		}
+
+		// Update je pipeline zbog $ifNull, pa hash (koji pocinje sa "$") mora
+		// biti $literal da se ne bi citao kao ime polja
+		now := time.Now().UTC()
+		update := bson.A{bson.M{"$set": bson.M{
+			"password":            bson.M{"$literal": string(hashedPassword)},
+			"password_changed_at": now.Truncate(time.Second),
+			"email_verified":      true,
+			"email_verified_at":   bson.M{"$ifNull": bson.A{"$email_verified_at", now}},
+		}}}
+		result, err := usersCollection(s.mongoClient).UpdateOne(sc, bson.M{"_id": record.UserID, "email": record.Email}, update)
+		if err != nil {
+			return err
+		}
+		if result.MatchedCount == 0 {
+			return errInvalidAccountToken
+		}
+
+		_, err = accountTokensCollection(s.mongoClient).DeleteMany(sc, bson.M{"user_id": record.UserID})
+		return err
+	})
+	if errors.Is(err, errInvalidAccountToken) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid or expired token")
+	}
+	if err != nil {
+		log.Printf("Failed to reset password: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to reset password")
+	}
+
+	return &stakeproto.ResetPasswordResponse{Status: "password has been reset"}, nil
+}
+
+// ChangePassword menja lozinku ulogovanog korisnika. Tokeni izdati pre
+// promene prestaju da vaze, pa se vraca novi token.
+func (s *StakeholdersServer) ChangePassword(ctx context.Context, req *stakeproto.ChangePasswordRequest) (*stakeproto.ChangePasswordResponse, error) {
+	claims, err := stakeholdersutils.GetClaimsFromContext2Args(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %v", err)
+	}
+
+	userIdStr, _ := claims["userId"].(string)
+	userID, err := primitive.ObjectIDFromHex(userIdStr)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid token claims: userId not found")
+	}
+
+	if req.CurrentPassword == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "current password is required")
+	}
+	if err := validatePassword(req.NewPassword); err != nil {
+		return nil, err
+	}
+	if req.NewPassword == req.CurrentPassword {
+		return nil, status.Errorf(codes.InvalidArgument, "new password must be different from the current one")
+	}
+
+	collection := usersCollection(s.mongoClient)
+
+	var user models.User
+	err = collection.FindOne(ctx, bson.M{"_id": userID, "is_blocked": false}).Decode(&user)
+	if err == mongo.ErrNoDocuments {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		log.Printf("MongoDB find error: %v", err)
+		return nil, status.Errorf(codes.Internal, "database error")
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.CurrentPassword)); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "current password is incorrect")
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), 10)
+	if err != nil {
+		log.Printf("Failed to hash password: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to change password")
+	}
+
+	err = s.withTransaction(ctx, func(sc mongo.SessionContext) error {
+		_, err := collection.UpdateOne(sc, bson.M{"_id": userID}, bson.M{"$set": bson.M{
+			"password":            string(hashedPassword),
+			"password_changed_at": time.Now().UTC().Truncate(time.Second),
+		}})
+		if err != nil {
+			return err
+		}
+		_, err = accountTokensCollection(s.mongoClient).DeleteMany(sc, bson.M{"user_id": userID, "purpose": tokenPurposeResetPassword})
+		return err
+	})
+	if err != nil {
+		log.Printf("Failed to change password for %s: %v", user.Username, err)
+		return nil, status.Errorf(codes.Internal, "failed to change password")
+	}
+
+	token, err := stakeholdersutils.GenerateJWT(user.Username, string(user.Role), user.ID)
+	if err != nil {
+		log.Printf("Failed to generate JWT: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to generate token")
+	}
+
+	log.Printf("User changed password: %s", user.Username)
+	return &stakeproto.ChangePasswordResponse{AccessToken: token}, nil
+}
@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"math"
+	"sort"
+
+	"stakeholders-service/models"
+	stakeproto "stakeholders-service/proto/stakeholders"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultNearbyRadiusKm = 25.0
+	maxNearbyRadiusKm     = 200.0
+	defaultNearbyLimit    = 20
+	maxNearbyLimit        = 100
+
+	earthRadiusKm = 6371.0
+)
+
+// GetNearbyUsers vraca korisnike cija je poslednja sacuvana pozicija u
+// zadatom radijusu od pozicije korisnika, od najblizeg. Blokirani korisnici,
+// administratori i korisnici bez pozicije se preskacu.
+func (s *StakeholdersServer) GetNearbyUsers(ctx context.Context, req *stakeproto.GetNearbyUsersRequest) (*stakeproto.GetNearbyUsersResponse, error) {
+	if req.Username == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "username is required")
+	}
+	radius := req.RadiusKm
+	if radius <= 0 {
+		radius = defaultNearbyRadiusKm
+	}
+	radius = min(radius, maxNearbyRadiusKm)
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultNearbyLimit
+	}
+	limit = min(limit, maxNearbyLimit)
+
+	collection := s.mongoClient.Database("stakeholders").Collection("users")
+
+	var me models.User
+	err := collection.FindOne(ctx, bson.M{"username": req.Username}).Decode(&me)
+	if err == mongo.ErrNoDocuments {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	} else if err != nil {
+		log.Printf("MongoDB find error: %v", err)
+		return nil, status.Errorf(codes.Internal, "database error")
+	}
+	if !hasPosition(me.Position) {
+		return &stakeproto.GetNearbyUsersResponse{}, nil
+	}
+
+	// Grubi pravougaonik oko pozicije suzava upit, a tacna udaljenost se
+	// racuna ispod.
+	latDelta := radius / 111.0
+	lngDelta := radius / (111.0 * math.Max(math.Cos(me.Position.Lat*math.Pi/180), 0.01))
+	filter := bson.M{
+		"username":     bson.M{"$ne": me.Username},
+		"is_blocked":   bson.M{"$ne": true},
+		"role":         bson.M{"$ne": models.RoleAdmin},
+		"position.lat": bson.M{"$gte": me.Position.Lat - latDelta, "$lte": me.Position.Lat + latDelta},
+		"position.lng": bson.M{"$gte": me.Position.Lng - lngDelta, "$lte": me.Position.Lng + lngDelta},
+	}
+	projection := bson.M{"username": 1, "position": 1}
+
+	cursor, err := collection.Find(ctx, filter, options.Find().SetProjection(projection))
+	if err != nil {
+		log.Printf("MongoDB find error: %v", err)
+		return nil, status.Errorf(codes.Internal, "could not find nearby users")
+	}
+	defer cursor.Close(ctx)
+
+	var candidates []models.User
+	if err = cursor.All(ctx, &candidates); err != nil {
+		log.Printf("Error decoding users: %v", err)
+		return nil, status.Errorf(codes.Internal, "error decoding users")
+	}
+
+	nearby := make([]*stakeproto.NearbyUser, 0)
+	for _, u := range candidates {
+		if !hasPosition(u.Position) {
+			continue
+		}
+		distance := haversineKm(me.Position, u.Position)
+		if distance <= radius {
+			nearby = append(nearby, &stakeproto.NearbyUser{Username: u.Username, DistanceKm: distance})
+		}
+	}
+	sort.Slice(nearby, func(i, j int) bool {
+		return nearby[i].DistanceKm < nearby[j].DistanceKm
+	})
+	if len(nearby) > limit {
+		nearby = nearby[:limit]
+	}
+
+	return &stakeproto.GetNearbyUsersResponse{Users: nearby}, nil
+}
+
+// hasPosition: pozicija (0, 0) znaci da korisnik nikada nije postavio poziciju.
+func hasPosition(p models.Position) bool {
+	return p.Lat != 0 || p.Lng != 0
+}
+
+func haversineKm(a, b models.Position) float64 {
+	lat1 := a.Lat * math.Pi / 180
+	lat2 := b.Lat * math.Pi / 180
+	dLat := lat2 - lat1
+	dLng := (b.Lng - a.Lng) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(h))
+}
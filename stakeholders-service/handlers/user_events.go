@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"context"
+	"stakeholders-service/outbox"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	SubjectUserRegistered = "stakeholders_user_registered"
+	SubjectUserBlocked    = "stakeholders_user_blocked"
+	SubjectProfileUpdated = "stakeholders_profile_updated"
+)
+
+// UserEvent se objavljuje kada se korisnik registruje, kada ga administrator
+// blokira ili odblokira i kada izmeni profil, da bi drugi servisi (npr.
+// follower-service) mogli da azuriraju svoje kopije podataka o korisniku.
+type UserEvent struct {
+	Username       string `json:"username"`
+	UserID         string `json:"userId,omitempty"`
+	Role           string `json:"role,omitempty"`
+	Blocked        bool   `json:"blocked"`
+	FirstName      string `json:"firstName,omitempty"`
+	LastName       string `json:"lastName,omitempty"`
+	ProfilePicture string `json:"profilePicture,omitempty"`
+}
+
+// withUserEvent izvrsava write i upisuje dogadjaj koji on vrati u outbox u
+// istoj transakciji. Ako write vrati gresku, nista se ne cuva.
+func (s *StakeholdersServer) withUserEvent(ctx context.Context, subject string, write func(sc mongo.SessionContext) (UserEvent, error)) error {
+	return s.withTransaction(ctx, func(sc mongo.SessionContext) error {
+		event, err := write(sc)
+		if err != nil {
+			return err
+		}
+		return outbox.Add(sc, s.mongoClient, subject, event)
+	})
+}
+
+// withTransaction izvrsava fn u transakciji; za upise koji sami dodaju
+// dogadjaje u outbox.
+func (s *StakeholdersServer) withTransaction(ctx context.Context, fn func(sc mongo.SessionContext) error) error {
+	session, err := s.mongoClient.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sc mongo.SessionContext) (any, error) {
+		return nil, fn(sc)
+	})
+	return err
+}
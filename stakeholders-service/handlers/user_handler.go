@@ -3,11 +3,14 @@ package handlers
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"log"
 	"os"
-	"path/filepath"
+	"shared/imaging"
+	"shared/storage"
 	"stakeholders-service/models"
+	"stakeholders-service/uploads"
 	"strings"
 	"time"
 
@@ -31,11 +34,17 @@ import (
 type StakeholdersServer struct {
 	stakeproto.UnimplementedStakeholdersServiceServer
 	mongoClient *mongo.Client
+	fileStorage storage.Storage
+	uploads     *uploads.Tracker
+	auth        AuthConfig
 }
 
-func NewStakeholdersServer(mongoClient *mongo.Client) *StakeholdersServer {
+func NewStakeholdersServer(mongoClient *mongo.Client, fileStorage storage.Storage, tracker *uploads.Tracker, auth AuthConfig) *StakeholdersServer {
 	return &StakeholdersServer{
 		mongoClient: mongoClient,
+		fileStorage: fileStorage,
+		uploads:     tracker,
+		auth:        auth,
 	}
 }
 
@@ -98,6 +107,12 @@ func (s *StakeholdersServer) ValidateToken(ctx context.Context, req *stakeproto.
 		return nil, status.Errorf(codes.Internal, "database error during validation")
 	}
 
+	// Tokeni izdati pre promene lozinke vise ne vaze
+	if !issuedAfterPasswordChange(claims, user.PasswordChangedAt) {
+		log.Printf("Token for user %s was issued before the last password change", username)
+		return &stakeproto.ValidateTokenResponse{IsValid: false}, nil
+	}
+
 	// Ako su sve provere prošle, token je validan
 	log.Printf("Token validated successfully for user: %s", username)
 	return &stakeproto.ValidateTokenResponse{
@@ -120,6 +135,16 @@ func (s *StakeholdersServer) Register(ctx context.Context, req *stakeproto.Regis
 		return nil, status.Errorf(codes.InvalidArgument, "username and password are required")
 	}
 
+	email, ok := normalizeEmail(input.Email)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "a valid email address is required")
+	}
+	input.Email = email
+
+	if err := validatePassword(input.Password); err != nil {
+		return nil, err
+	}
+
 	if input.Role != models.RoleTourist && input.Role != models.RoleGuide {
 		return nil, status.Errorf(codes.InvalidArgument, "role must be tourist or guide")
 	}
@@ -133,8 +158,24 @@ func (s *StakeholdersServer) Register(ctx context.Context, req *stakeproto.Regis
 
 	collection := s.mongoClient.Database("stakeholders").Collection("users")
 
-	_, err = collection.InsertOne(ctx, input)
+	err = s.withUserEvent(ctx, SubjectUserRegistered, func(sc mongo.SessionContext) (UserEvent, error) {
+		result, err := collection.InsertOne(sc, input)
+		if err != nil {
+			return UserEvent{}, err
+		}
+		if id, ok := result.InsertedID.(primitive.ObjectID); ok {
+			input.ID = id
+		}
+		if err := s.issueAccountToken(sc, input, tokenPurposeVerifyEmail); err != nil {
+			return UserEvent{}, err
+		}
+		return UserEvent{Username: input.Username, UserID: input.ID.Hex(), Role: string(input.Role)}, nil
+	})
 	if err != nil {
+		if isDuplicateEmailError(err) {
+			log.Printf("Registration failed for username '%s': email already in use", input.Username)
+			return nil, status.Errorf(codes.AlreadyExists, "email already in use")
+		}
 		if mongo.IsDuplicateKeyError(err) {
 			log.Printf("Registration failed for username '%s': user already exists (duplicate key error)", input.Username)
 			return nil, status.Errorf(codes.AlreadyExists, "user already exists")
@@ -170,6 +211,10 @@ func (s *StakeholdersServer) Login(ctx context.Context, req *stakeproto.LoginReq
 		return nil, status.Errorf(codes.Unauthenticated, "invalid credentials")
 	}
 
+	if !s.canLogin(user) {
+		return nil, status.Errorf(codes.FailedPrecondition, "email address is not verified")
+	}
+
 	token, err := stakeholdersutils.GenerateJWT(user.Username, string(user.Role), user.ID)
 	if err != nil {
 		log.Printf("Failed to generate JWT: %v", err)
@@ -251,8 +296,18 @@ func (s *StakeholdersServer) BlockUser(ctx context.Context, req *stakeproto.Bloc
 	update := bson.M{"$set": bson.M{"is_blocked": req.Block}}
 	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
 
-	var updatedUser models.User
-	err = collection.FindOneAndUpdate(ctx, bson.M{"_id": objID}, update, opts).Decode(&updatedUser)
+	err = s.withUserEvent(ctx, SubjectUserBlocked, func(sc mongo.SessionContext) (UserEvent, error) {
+		var updatedUser models.User
+		if err := collection.FindOneAndUpdate(sc, bson.M{"_id": objID}, update, opts).Decode(&updatedUser); err != nil {
+			return UserEvent{}, err
+		}
+		return UserEvent{
+			Username: updatedUser.Username,
+			UserID:   updatedUser.ID.Hex(),
+			Role:     string(updatedUser.Role),
+			Blocked:  updatedUser.IsBlocked,
+		}, nil
+	})
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
 			return nil, status.Errorf(codes.NotFound, "user not found")
@@ -264,6 +319,99 @@ func (s *StakeholdersServer) BlockUser(ctx context.Context, req *stakeproto.Bloc
 	return &stakeproto.BlockUserResponse{Status: "User blocked/unblocked successfully"}, nil
 }
 
+// CheckUsers vraca status za svako trazeno korisnicko ime, u istom redosledu.
+// Nepostojeci korisnici imaju exists=false.
+func (s *StakeholdersServer) CheckUsers(ctx context.Context, req *stakeproto.CheckUsersRequest) (*stakeproto.CheckUsersResponse, error) {
+	if len(req.Usernames) == 0 {
+		return &stakeproto.CheckUsersResponse{}, nil
+	}
+	if len(req.Usernames) > 1000 {
+		return nil, status.Errorf(codes.InvalidArgument, "at most 1000 usernames can be checked at once")
+	}
+
+	collection := s.mongoClient.Database("stakeholders").Collection("users")
+	projection := bson.M{"_id": 1, "username": 1, "role": 1, "is_blocked": 1}
+
+	cursor, err := collection.Find(ctx, bson.M{"username": bson.M{"$in": req.Usernames}}, options.Find().SetProjection(projection))
+	if err != nil {
+		log.Printf("MongoDB find error: %v", err)
+		return nil, status.Errorf(codes.Internal, "could not check users")
+	}
+	defer cursor.Close(ctx)
+
+	var users []models.User
+	if err = cursor.All(ctx, &users); err != nil {
+		log.Printf("Error decoding users: %v", err)
+		return nil, status.Errorf(codes.Internal, "error decoding users")
+	}
+
+	byUsername := make(map[string]models.User, len(users))
+	for _, u := range users {
+		byUsername[u.Username] = u
+	}
+
+	statuses := make([]*stakeproto.UserStatus, len(req.Usernames))
+	for i, username := range req.Usernames {
+		userStatus := &stakeproto.UserStatus{Username: username}
+		if u, ok := byUsername[username]; ok {
+			userStatus.Exists = true
+			userStatus.IsBlocked = u.IsBlocked
+			userStatus.UserId = u.ID.Hex()
+			userStatus.Role = string(u.Role)
+		}
+		statuses[i] = userStatus
+	}
+
+	return &stakeproto.CheckUsersResponse{Users: statuses}, nil
+}
+
+func (s *StakeholdersServer) GetUserContacts(ctx context.Context, req *stakeproto.GetUserContactsRequest) (*stakeproto.GetUserContactsResponse, error) {
+	if len(req.UserIds) == 0 {
+		return &stakeproto.GetUserContactsResponse{}, nil
+	}
+	if len(req.UserIds) > 1000 {
+		return nil, status.Errorf(codes.InvalidArgument, "at most 1000 users can be requested at once")
+	}
+
+	objIDs := make([]primitive.ObjectID, 0, len(req.UserIds))
+	for _, id := range req.UserIds {
+		objID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid userId format: %s", id)
+		}
+		objIDs = append(objIDs, objID)
+	}
+
+	collection := s.mongoClient.Database("stakeholders").Collection("users")
+	projection := bson.M{"_id": 1, "username": 1, "email": 1, "is_blocked": 1, "profile.first_name": 1}
+
+	cursor, err := collection.Find(ctx, bson.M{"_id": bson.M{"$in": objIDs}}, options.Find().SetProjection(projection))
+	if err != nil {
+		log.Printf("MongoDB find error: %v", err)
+		return nil, status.Errorf(codes.Internal, "could not fetch users")
+	}
+	defer cursor.Close(ctx)
+
+	var users []models.User
+	if err = cursor.All(ctx, &users); err != nil {
+		log.Printf("Error decoding users: %v", err)
+		return nil, status.Errorf(codes.Internal, "error decoding users")
+	}
+
+	contacts := make([]*stakeproto.UserContact, 0, len(users))
+	for _, u := range users {
+		contacts = append(contacts, &stakeproto.UserContact{
+			UserId:    u.ID.Hex(),
+			Username:  u.Username,
+			Email:     u.Email,
+			FirstName: u.Profile.FirstName,
+			IsBlocked: u.IsBlocked,
+		})
+	}
+
+	return &stakeproto.GetUserContactsResponse{Users: contacts}, nil
+}
+
 func (s *StakeholdersServer) GetProfile(ctx context.Context, req *stakeproto.GetProfileRequest) (*stakeproto.UserProfileResponse, error) {
 	claims, err := stakeholdersutils.GetClaimsFromContext2Args(ctx)
 	if err != nil {
@@ -355,22 +503,52 @@ func (s *StakeholdersServer) UpdateProfile(ctx context.Context, req *stakeproto.
 		return nil, status.Errorf(codes.InvalidArgument, "profile data is required")
 	}
 
+	userId, _ := claims["userId"].(string)
+
+	var pictureVariants map[string]string
 	if updatedProfile.ProfilePicture != "" && strings.HasPrefix(updatedProfile.ProfilePicture, "data:image/") {
-		imageURL, err := saveBase64Image(updatedProfile.ProfilePicture)
+		variants, err := s.saveBase64Image(ctx, userId, updatedProfile.ProfilePicture)
 		if err != nil {
 			log.Printf("Error saving image: %v", err)
+			if errors.Is(err, imaging.ErrInvalidImage) {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid profile picture: %v", err)
+			}
+			return nil, status.Errorf(codes.Internal, "failed to save image: %v", err)
+		}
+		updatedProfile.ProfilePicture = variants["original"]
+		pictureVariants = variants
+
+		// Nova slika se vezuje pre upisa profila da je sweeper ne bi obrisao
+		if err := s.uploads.Attach(ctx, updatedProfile.ProfilePicture, models.UploadRefProfilePicture, username); err != nil {
+			log.Printf("Failed to attach profile picture upload: %v", err)
 			return nil, status.Errorf(codes.Internal, "failed to save image: %v", err)
 		}
-		updatedProfile.ProfilePicture = imageURL
 	}
 
 	collection := s.mongoClient.Database("stakeholders").Collection("users")
 	update := bson.M{"$set": bson.M{"profile": updatedProfile}}
-	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.Before)
 
-	var userAfterUpdate models.User
-	err = collection.FindOneAndUpdate(ctx, bson.M{"username": username}, update, opts).Decode(&userAfterUpdate)
+	var userBeforeUpdate models.User
+	err = s.withUserEvent(ctx, SubjectProfileUpdated, func(sc mongo.SessionContext) (UserEvent, error) {
+		if err := collection.FindOneAndUpdate(sc, bson.M{"username": username}, update, opts).Decode(&userBeforeUpdate); err != nil {
+			return UserEvent{}, err
+		}
+		return UserEvent{
+			Username:       username,
+			UserID:         userId,
+			Blocked:        userBeforeUpdate.IsBlocked,
+			FirstName:      updatedProfile.FirstName,
+			LastName:       updatedProfile.LastName,
+			ProfilePicture: updatedProfile.ProfilePicture,
+		}, nil
+	})
 	if err != nil {
+		if pictureVariants != nil {
+			if err := s.uploads.Release(ctx, updatedProfile.ProfilePicture); err != nil {
+				log.Printf("Failed to release profile picture upload: %v", err)
+			}
+		}
 		if err == mongo.ErrNoDocuments {
 			return nil, status.Errorf(codes.NotFound, "user not found")
 		}
@@ -378,51 +556,50 @@ func (s *StakeholdersServer) UpdateProfile(ctx context.Context, req *stakeproto.
 		return nil, status.Errorf(codes.Internal, "failed to update profile: %v", err)
 	}
 
-	return &stakeproto.UpdateProfileResponse{Status: "Profile updated successfully"}, nil
+	if previous := userBeforeUpdate.Profile.ProfilePicture; previous != "" && previous != updatedProfile.ProfilePicture {
+		if err := s.uploads.Release(ctx, previous); err != nil {
+			log.Printf("Failed to release previous profile picture upload: %v", err)
+		}
+	}
+
+	return &stakeproto.UpdateProfileResponse{
+		Status:                 "Profile updated successfully",
+		ProfilePictureVariants: pictureVariants,
+	}, nil
 }
 
-func saveBase64Image(base64String string) (string, error) {
+// saveBase64Image cuva sliku iz data URI-ja zajedno sa umanjenim varijantama i
+// vraca mapu naziva varijante na URL. Tip slike se odredjuje iz sadrzaja, a ne
+// iz prefiksa data URI-ja.
+func (s *StakeholdersServer) saveBase64Image(ctx context.Context, ownerID, base64String string) (map[string]string, error) {
 	parts := strings.SplitN(base64String, ",", 2)
 	if len(parts) != 2 {
-		return "", fmt.Errorf("invalid base64 string format")
+		return nil, fmt.Errorf("%w: invalid base64 string format", imaging.ErrInvalidImage)
 	}
 
-	meta := parts[0]
-	data := parts[1]
-
-	contentType := strings.TrimPrefix(meta, "data:")
-	contentType = strings.SplitN(contentType, ";", 2)[0]
-
-	var extension string
-	switch contentType {
-	case "image/png":
-		extension = ".png"
-	case "image/jpeg", "image/jpg":
-		extension = ".jpg"
-	case "image/gif":
-		extension = ".gif"
-	default:
-		return "", fmt.Errorf("unsupported image content type: %s", contentType)
-	}
-
-	decodedData, err := base64.StdEncoding.DecodeString(data)
+	decodedData, err := base64.StdEncoding.DecodeString(parts[1])
 	if err != nil {
-		return "", fmt.Errorf("failed to decode base64 string: %w", err)
+		return nil, fmt.Errorf("%w: failed to decode base64 string", imaging.ErrInvalidImage)
 	}
 
-	fileName := uuid.New().String() + extension
-	filePath := filepath.Join("static", "uploads", fileName)
-
-	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
-		return "", fmt.Errorf("failed to create directories: %w", err)
+	if len(decodedData) > imaging.MaxUploadSize {
+		return nil, fmt.Errorf("%w: file is larger than %d bytes", imaging.ErrInvalidImage, imaging.MaxUploadSize)
 	}
 
-	err = os.WriteFile(filePath, decodedData, 0644)
+	variants, err := imaging.SaveVariants(ctx, s.fileStorage, "stakeholders/"+uuid.New().String(), decodedData)
 	if err != nil {
-		return "", fmt.Errorf("failed to save image file: %w", err)
+		return nil, err
 	}
 
-	return fmt.Sprintf("/uploads/%s", fileName), nil
+	if err := s.uploads.Register(ctx, ownerID, variants); err != nil {
+		for _, url := range variants {
+			if key, ok := storage.KeyFromURL(url); ok {
+				s.fileStorage.Delete(ctx, key)
+			}
+		}
+		return nil, err
+	}
+	return variants, nil
 }
 
 func (s *StakeholdersServer) SetPosition(ctx context.Context, req *stakeproto.PositionRequest) (*emptypb.Empty, error) {
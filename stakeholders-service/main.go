@@ -8,7 +8,8 @@ import (
 	"os"
 	"stakeholders-service/db"
 	"stakeholders-service/handlers"
-	"time"
+	"stakeholders-service/outbox"
+	"stakeholders-service/uploads"
 
 	"github.com/joho/godotenv"
 	"google.golang.org/grpc"
@@ -16,7 +17,9 @@ import (
 
 	stakeproto "stakeholders-service/proto/stakeholders"
 
-	"github.com/nats-io/nats.go"
+	"shared/events"
+	"shared/storage"
+	"shared/uploadgc"
 )
 
 func main() {
@@ -43,18 +46,9 @@ func main() {
 	
 	// fmt.Println("NATS: ", natsURL)
 
-	var natsConn *nats.Conn
-	natsURL := os.Getenv("NATS_URL")
-	for i := 0; i < 10; i++ {
-			natsConn, err = nats.Connect(natsURL)
-			if err == nil {
-					break
-			}
-			log.Printf("Waiting for NATS to be ready... (%v)", err)
-			time.Sleep(2 * time.Second)
-	}
+	natsConn, err := events.Connect(os.Getenv("NATS_URL"), "stakeholders-service")
 	if err != nil {
-			log.Fatalf("Failed to connect to NATS after retries: %v", err)
+		log.Fatalf("Failed to configure NATS connection: %v", err)
 	}
 
 	defer natsConn.Close()
@@ -92,7 +86,26 @@ func main() {
 
 	grpcServer := grpc.NewServer()
 
-	stakeholdersServer := handlers.NewStakeholdersServer(mongoClient)
+	fileStorage, err := storage.NewFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to initialize file storage: %v", err)
+	}
+
+	uploadTracker := uploads.NewTracker(mongoClient)
+	uploadTracker.StartSweeper(context.Background(), fileStorage, uploadgc.ConfigFromEnv())
+
+	if err := outbox.EnsureIndexes(context.Background(), mongoClient); err != nil {
+		log.Printf("Failed to create outbox indexes: %v", err)
+	}
+	outbox.StartRelay(context.Background(), mongoClient, natsConn, outbox.ConfigFromEnv())
+
+	// Bez jedinstvenog indeksa dva naloga mogu dobiti istu email adresu, pa
+	// servis ne radi dok se duplikati ne razrese
+	if err := handlers.EnsureAccountIndexes(context.Background(), mongoClient); err != nil {
+		log.Fatalf("Failed to prepare account indexes: %v", err)
+	}
+
+	stakeholdersServer := handlers.NewStakeholdersServer(mongoClient, fileStorage, uploadTracker, handlers.AuthConfigFromEnv())
 
 	handlers.SubscribePurchaseCheckout(natsConn, stakeholdersServer)
 
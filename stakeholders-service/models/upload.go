@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// Upload prati jednu uploadovanu sliku zajedno sa svim njenim varijantama.
+// Upload bez reference stariji od grace perioda brise sweeper.
+type Upload struct {
+	Key        string     `bson:"_id" json:"key"`
+	OwnerID    string     `bson:"owner_id" json:"ownerId"`
+	Keys       []string   `bson:"keys" json:"keys"`
+	RefType    string     `bson:"ref_type" json:"refType"`
+	RefID      string     `bson:"ref_id" json:"refId"`
+	CreatedAt  time.Time  `bson:"created_at" json:"createdAt"`
+	ReleasedAt *time.Time `bson:"released_at,omitempty" json:"releasedAt"`
+}
+
+const UploadRefProfilePicture = "profile_picture"
@@ -1,6 +1,10 @@
 package models
 
-import "go.mongodb.org/mongo-driver/bson/primitive"
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
 
 type Role string
 
@@ -17,6 +21,10 @@ type User struct {
 	Password  string             `bson:"password,omitempty" json:"password,omitempty"`
 	Role      Role               `bson:"role" json:"role"`
 	IsBlocked bool               `bson:"is_blocked" json:"isBlocked"`
+
+	EmailVerified     bool       `bson:"email_verified" json:"emailVerified"`
+	EmailVerifiedAt   *time.Time `bson:"email_verified_at,omitempty" json:"emailVerifiedAt,omitempty"`
+	PasswordChangedAt *time.Time `bson:"password_changed_at,omitempty" json:"-"`
 	
 	Profile  UserProfile `bson:"profile" json:"profile"`
 	Position Position    `bson:"position,omitempty" json:"position,omitempty"`
@@ -0,0 +1,83 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"shared/events"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Producer se upisuje u omotac svakog dogadjaja ovog servisa.
+const Producer = "stakeholders-service"
+
+type event struct {
+	ID          string     `bson:"_id"`
+	Type        string     `bson:"type"`
+	Version     int        `bson:"version"`
+	Payload     string     `bson:"payload"`
+	OccurredAt  time.Time  `bson:"occurredAt"`
+	PublishedAt *time.Time `bson:"publishedAt"`
+	Attempts    int        `bson:"attempts"`
+	LastError   string     `bson:"lastError,omitempty"`
+	// Osetljivi dogadjaji (npr. sa tokenom za reset lozinke) se brisu cim se
+	// objave, umesto da cekaju purge
+	Sensitive bool `bson:"sensitive,omitempty"`
+}
+
+func collection(mongoClient *mongo.Client) *mongo.Collection {
+	return mongoClient.Database("stakeholders").Collection("outbox")
+}
+
+// EnsureIndexes pravi indekse koje koristi relay.
+func EnsureIndexes(ctx context.Context, mongoClient *mongo.Client) error {
+	_, err := collection(mongoClient).Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "publishedAt", Value: 1}, {Key: "occurredAt", Value: 1}}},
+		{Keys: bson.D{{Key: "claimToken", Value: 1}}, Options: options.Index().SetSparse(true)},
+	})
+	return err
+}
+
+// Add upisuje dogadjaj u outbox. ctx treba da bude mongo.SessionContext
+// transakcije u kojoj se cuva promena, tako da se dogadjaj objavi ako i samo
+// ako je promena sacuvana.
+func Add(ctx context.Context, mongoClient *mongo.Client, eventType string, payload any) error {
+	return add(ctx, mongoClient, eventType, payload, false)
+}
+
+// AddSensitive je kao Add, ali se dogadjaj brise iz outbox-a odmah posle
+// objavljivanja, jer payload sadrzi tajnu koja ne sme da ostane u bazi.
+func AddSensitive(ctx context.Context, mongoClient *mongo.Client, eventType string, payload any) error {
+	return add(ctx, mongoClient, eventType, payload, true)
+}
+
+func add(ctx context.Context, mongoClient *mongo.Client, eventType string, payload any, sensitive bool) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	_, err = collection(mongoClient).InsertOne(ctx, event{
+		ID:         uuid.NewString(),
+		Type:       eventType,
+		Version:    events.Version,
+		Payload:    string(data),
+		OccurredAt: time.Now().UTC(),
+		Sensitive:  sensitive,
+	})
+	return err
+}
+
+func envelopeFor(e event) events.Envelope {
+	return events.Envelope{
+		ID:         e.ID,
+		Type:       e.Type,
+		Version:    e.Version,
+		OccurredAt: e.OccurredAt,
+		Producer:   Producer,
+		Payload:    json.RawMessage(e.Payload),
+	}
+}
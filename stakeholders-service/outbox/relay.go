@@ -0,0 +1,174 @@
+package outbox
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log"
+	"shared/env"
+	"shared/events"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Posle ovog vremena dogadjaj koji je preuzela replika koja je u
+// medjuvremenu pala moze da preuzme druga replika
+const claimTimeout = 30 * time.Second
+
+// Config odredjuje koliko cesto relay proverava outbox i koliko dugo se
+// objavljeni dogadjaji cuvaju.
+type Config struct {
+	Interval  time.Duration
+	Retention time.Duration
+	BatchSize int
+}
+
+// ConfigFromEnv cita OUTBOX_POLL_INTERVAL i OUTBOX_RETENTION.
+func ConfigFromEnv() Config {
+	return Config{
+		Interval:  env.PositiveDuration("OUTBOX_POLL_INTERVAL", time.Second),
+		Retention: env.PositiveDuration("OUTBOX_RETENTION", 24*time.Hour),
+		BatchSize: 100,
+	}
+}
+
+// StartRelay objavljuje neobjavljene dogadjaje u JetStream. Dogadjaj se
+// oznacava kao objavljen tek kada ga JetStream potvrdi, pa se moze objaviti i
+// vise puta; JetStream odbacuje ponovljenu objavu u okviru Duplicates prozora,
+// a potrosaci i dalje moraju biti idempotentni (npr. po Envelope.ID).
+func StartRelay(ctx context.Context, mongoClient *mongo.Client, natsConn *nats.Conn, cfg Config) {
+	publisher, err := events.NewPublisher(natsConn)
+	if err != nil {
+		log.Fatalf("[outbox] %s: %v", Producer, err)
+	}
+	coll := collection(mongoClient)
+	go func() {
+		ticker := time.NewTicker(cfg.Interval)
+		defer ticker.Stop()
+		lastPurge := time.Time{}
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			for {
+				n, err := relayBatch(ctx, coll, publisher, cfg.BatchSize)
+				if err != nil {
+					log.Printf("[outbox] %s: relay failed: %v", Producer, err)
+					break
+				}
+				if n < cfg.BatchSize {
+					break
+				}
+			}
+
+			if time.Since(lastPurge) > time.Hour {
+				purge(ctx, coll, cfg.Retention)
+				lastPurge = time.Now()
+			}
+		}
+	}()
+}
+
+// relayBatch preuzima najvise batchSize dogadjaja oznakom claimToken, tako da
+// ih druga replika ne objavi istovremeno, objavljuje ih redom upisa i oznacava
+// objavljene. Preuzeti a neobjavljeni dogadjaji se oslobadjaju. Redosled vazi
+// samo unutar jedne serije; replike objavljuju svoje serije nezavisno.
+func relayBatch(ctx context.Context, coll *mongo.Collection, publisher *events.Publisher, batchSize int) (int, error) {
+	unclaimed := bson.M{
+		"publishedAt": nil,
+		"$or": bson.A{
+			bson.M{"claimedAt": bson.M{"$exists": false}},
+			bson.M{"claimedAt": bson.M{"$lt": time.Now().UTC().Add(-claimTimeout)}},
+		},
+	}
+	byOccurredAt := bson.D{{Key: "occurredAt", Value: 1}, {Key: "_id", Value: 1}}
+
+	findOpts := options.Find().SetSort(byOccurredAt).SetLimit(int64(batchSize)).SetProjection(bson.M{"_id": 1})
+	cursor, err := coll.Find(ctx, unclaimed, findOpts)
+	if err != nil {
+		return 0, err
+	}
+	var candidates []struct {
+		ID string `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &candidates); err != nil {
+		return 0, err
+	}
+	if len(candidates) == 0 {
+		return 0, nil
+	}
+	ids := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		ids = append(ids, c.ID)
+	}
+
+	token, err := newClaimToken()
+	if err != nil {
+		return 0, err
+	}
+	claimFilter := bson.M{"_id": bson.M{"$in": ids}, "publishedAt": nil, "$or": unclaimed["$or"]}
+	claim := bson.M{"$set": bson.M{"claimToken": token, "claimedAt": time.Now().UTC()}}
+	if _, err := coll.UpdateMany(ctx, claimFilter, claim); err != nil {
+		return 0, err
+	}
+
+	cursor, err = coll.Find(ctx, bson.M{"claimToken": token}, options.Find().SetSort(byOccurredAt))
+	if err != nil {
+		return 0, err
+	}
+	var pending []event
+	if err := cursor.All(ctx, &pending); err != nil {
+		return 0, err
+	}
+
+	published := make([]string, 0, len(pending))
+	var publishErr error
+	for _, e := range pending {
+		if err := publisher.Publish(ctx, envelopeFor(e)); err != nil {
+			// Ostali dogadjaji cekaju, da bi se sacuvao redosled
+			publishErr = err
+			coll.UpdateByID(ctx, e.ID, bson.M{"$inc": bson.M{"attempts": 1}, "$set": bson.M{"lastError": err.Error()}})
+			break
+		}
+		published = append(published, e.ID)
+	}
+	if len(published) > 0 {
+		done := bson.M{
+			"$set":   bson.M{"publishedAt": time.Now().UTC()},
+			"$unset": bson.M{"claimToken": "", "claimedAt": ""},
+		}
+		if _, err := coll.UpdateMany(ctx, bson.M{"_id": bson.M{"$in": published}}, done); err != nil {
+			return 0, err
+		}
+		if _, err := coll.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": published}, "sensitive": true}); err != nil {
+			return 0, err
+		}
+	}
+	release := bson.M{"$unset": bson.M{"claimToken": "", "claimedAt": ""}}
+	if _, err := coll.UpdateMany(ctx, bson.M{"claimToken": token}, release); err != nil {
+		return 0, err
+	}
+	return len(published), publishErr
+}
+
+func newClaimToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func purge(ctx context.Context, coll *mongo.Collection, retention time.Duration) {
+	_, err := coll.DeleteMany(ctx, bson.M{"publishedAt": bson.M{"$lt": time.Now().UTC().Add(-retention)}})
+	if err != nil {
+		log.Printf("[outbox] %s: purge failed: %v", Producer, err)
+	}
+}
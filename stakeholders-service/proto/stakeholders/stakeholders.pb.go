@@ -343,26 +343,27 @@ func (x *LoginResponse) GetAccessToken() string {
 	return ""
 }
 
-type GetAllUsersRequest struct {
+type VerifyEmailRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllUsersRequest) Reset() {
-	*x = GetAllUsersRequest{}
+func (x *VerifyEmailRequest) Reset() {
+	*x = VerifyEmailRequest{}
 	mi := &file_stakeholders_stakeholders_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllUsersRequest) String() string {
+func (x *VerifyEmailRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllUsersRequest) ProtoMessage() {}
+func (*VerifyEmailRequest) ProtoMessage() {}
 
-func (x *GetAllUsersRequest) ProtoReflect() protoreflect.Message {
+func (x *VerifyEmailRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_stakeholders_stakeholders_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -374,32 +375,39 @@ func (x *GetAllUsersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllUsersRequest.ProtoReflect.Descriptor instead.
-func (*GetAllUsersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use VerifyEmailRequest.ProtoReflect.Descriptor instead.
+func (*VerifyEmailRequest) Descriptor() ([]byte, []int) {
 	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{6}
 }
 
-type GetAllUsersResponse struct {
+func (x *VerifyEmailRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type VerifyEmailResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllUsersResponse) Reset() {
-	*x = GetAllUsersResponse{}
+func (x *VerifyEmailResponse) Reset() {
+	*x = VerifyEmailResponse{}
 	mi := &file_stakeholders_stakeholders_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllUsersResponse) String() string {
+func (x *VerifyEmailResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllUsersResponse) ProtoMessage() {}
+func (*VerifyEmailResponse) ProtoMessage() {}
 
-func (x *GetAllUsersResponse) ProtoReflect() protoreflect.Message {
+func (x *VerifyEmailResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_stakeholders_stakeholders_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -411,40 +419,39 @@ func (x *GetAllUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllUsersResponse.ProtoReflect.Descriptor instead.
-func (*GetAllUsersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use VerifyEmailResponse.ProtoReflect.Descriptor instead.
+func (*VerifyEmailResponse) Descriptor() ([]byte, []int) {
 	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetAllUsersResponse) GetUsers() []*User {
+func (x *VerifyEmailResponse) GetStatus() string {
 	if x != nil {
-		return x.Users
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-type BlockUserRequest struct {
+type ResendVerificationEmailRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	Block         bool                   `protobuf:"varint,2,opt,name=block,proto3" json:"block,omitempty"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BlockUserRequest) Reset() {
-	*x = BlockUserRequest{}
+func (x *ResendVerificationEmailRequest) Reset() {
+	*x = ResendVerificationEmailRequest{}
 	mi := &file_stakeholders_stakeholders_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BlockUserRequest) String() string {
+func (x *ResendVerificationEmailRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockUserRequest) ProtoMessage() {}
+func (*ResendVerificationEmailRequest) ProtoMessage() {}
 
-func (x *BlockUserRequest) ProtoReflect() protoreflect.Message {
+func (x *ResendVerificationEmailRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_stakeholders_stakeholders_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -456,46 +463,39 @@ func (x *BlockUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockUserRequest.ProtoReflect.Descriptor instead.
-func (*BlockUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResendVerificationEmailRequest.ProtoReflect.Descriptor instead.
+func (*ResendVerificationEmailRequest) Descriptor() ([]byte, []int) {
 	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *BlockUserRequest) GetUserId() string {
+func (x *ResendVerificationEmailRequest) GetEmail() string {
 	if x != nil {
-		return x.UserId
+		return x.Email
 	}
 	return ""
 }
 
-func (x *BlockUserRequest) GetBlock() bool {
-	if x != nil {
-		return x.Block
-	}
-	return false
-}
-
-type BlockUserResponse struct {
+type ResendVerificationEmailResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BlockUserResponse) Reset() {
-	*x = BlockUserResponse{}
+func (x *ResendVerificationEmailResponse) Reset() {
+	*x = ResendVerificationEmailResponse{}
 	mi := &file_stakeholders_stakeholders_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BlockUserResponse) String() string {
+func (x *ResendVerificationEmailResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockUserResponse) ProtoMessage() {}
+func (*ResendVerificationEmailResponse) ProtoMessage() {}
 
-func (x *BlockUserResponse) ProtoReflect() protoreflect.Message {
+func (x *ResendVerificationEmailResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_stakeholders_stakeholders_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -507,39 +507,39 @@ func (x *BlockUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockUserResponse.ProtoReflect.Descriptor instead.
-func (*BlockUserResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResendVerificationEmailResponse.ProtoReflect.Descriptor instead.
+func (*ResendVerificationEmailResponse) Descriptor() ([]byte, []int) {
 	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *BlockUserResponse) GetStatus() string {
+func (x *ResendVerificationEmailResponse) GetStatus() string {
 	if x != nil {
 		return x.Status
 	}
 	return ""
 }
 
-type GetProfileByUsernameRequest struct {
+type RequestPasswordResetRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProfileByUsernameRequest) Reset() {
-	*x = GetProfileByUsernameRequest{}
+func (x *RequestPasswordResetRequest) Reset() {
+	*x = RequestPasswordResetRequest{}
 	mi := &file_stakeholders_stakeholders_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProfileByUsernameRequest) String() string {
+func (x *RequestPasswordResetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProfileByUsernameRequest) ProtoMessage() {}
+func (*RequestPasswordResetRequest) ProtoMessage() {}
 
-func (x *GetProfileByUsernameRequest) ProtoReflect() protoreflect.Message {
+func (x *RequestPasswordResetRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_stakeholders_stakeholders_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -551,38 +551,39 @@ func (x *GetProfileByUsernameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProfileByUsernameRequest.ProtoReflect.Descriptor instead.
-func (*GetProfileByUsernameRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
 	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetProfileByUsernameRequest) GetUsername() string {
+func (x *RequestPasswordResetRequest) GetEmail() string {
 	if x != nil {
-		return x.Username
+		return x.Email
 	}
 	return ""
 }
 
-type GetProfileRequest struct {
+type RequestPasswordResetResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProfileRequest) Reset() {
-	*x = GetProfileRequest{}
+func (x *RequestPasswordResetResponse) Reset() {
+	*x = RequestPasswordResetResponse{}
 	mi := &file_stakeholders_stakeholders_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProfileRequest) String() string {
+func (x *RequestPasswordResetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProfileRequest) ProtoMessage() {}
+func (*RequestPasswordResetResponse) ProtoMessage() {}
 
-func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
+func (x *RequestPasswordResetResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_stakeholders_stakeholders_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -594,32 +595,40 @@ func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProfileRequest.ProtoReflect.Descriptor instead.
-func (*GetProfileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestPasswordResetResponse.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetResponse) Descriptor() ([]byte, []int) {
 	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{11}
 }
 
-type UpdateProfileRequest struct {
+func (x *RequestPasswordResetResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ResetPasswordRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Profile       *UserProfile           `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,2,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProfileRequest) Reset() {
-	*x = UpdateProfileRequest{}
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
 	mi := &file_stakeholders_stakeholders_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProfileRequest) String() string {
+func (x *ResetPasswordRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProfileRequest) ProtoMessage() {}
+func (*ResetPasswordRequest) ProtoMessage() {}
 
-func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_stakeholders_stakeholders_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -631,39 +640,46 @@ func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
-func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
 	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *UpdateProfileRequest) GetProfile() *UserProfile {
+func (x *ResetPasswordRequest) GetToken() string {
 	if x != nil {
-		return x.Profile
+		return x.Token
 	}
-	return nil
+	return ""
 }
 
-type UpdateProfileResponse struct {
+func (x *ResetPasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ResetPasswordResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProfileResponse) Reset() {
-	*x = UpdateProfileResponse{}
+func (x *ResetPasswordResponse) Reset() {
+	*x = ResetPasswordResponse{}
 	mi := &file_stakeholders_stakeholders_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProfileResponse) String() string {
+func (x *ResetPasswordResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProfileResponse) ProtoMessage() {}
+func (*ResetPasswordResponse) ProtoMessage() {}
 
-func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
+func (x *ResetPasswordResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_stakeholders_stakeholders_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -675,44 +691,41 @@ func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProfileResponse.ProtoReflect.Descriptor instead.
-func (*UpdateProfileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResetPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ResetPasswordResponse) Descriptor() ([]byte, []int) {
 	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *UpdateProfileResponse) GetStatus() string {
+func (x *ResetPasswordResponse) GetStatus() string {
 	if x != nil {
 		return x.Status
 	}
 	return ""
 }
 
-type UserProfileResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Username       string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	FirstName      string                 `protobuf:"bytes,2,opt,name=firstName,proto3" json:"firstName,omitempty"`
-	LastName       string                 `protobuf:"bytes,3,opt,name=lastName,proto3" json:"lastName,omitempty"`
-	ProfilePicture string                 `protobuf:"bytes,4,opt,name=profilePicture,proto3" json:"profilePicture,omitempty"`
-	Biography      string                 `protobuf:"bytes,5,opt,name=biography,proto3" json:"biography,omitempty"`
-	Motto          string                 `protobuf:"bytes,6,opt,name=motto,proto3" json:"motto,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// Posle promene lozinke stari tokeni vise ne vaze, pa odgovor sadrzi novi.
+type ChangePasswordRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CurrentPassword string                 `protobuf:"bytes,1,opt,name=currentPassword,proto3" json:"currentPassword,omitempty"`
+	NewPassword     string                 `protobuf:"bytes,2,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *UserProfileResponse) Reset() {
-	*x = UserProfileResponse{}
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
 	mi := &file_stakeholders_stakeholders_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserProfileResponse) String() string {
+func (x *ChangePasswordRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserProfileResponse) ProtoMessage() {}
+func (*ChangePasswordRequest) ProtoMessage() {}
 
-func (x *UserProfileResponse) ProtoReflect() protoreflect.Message {
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_stakeholders_stakeholders_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -724,75 +737,46 @@ func (x *UserProfileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserProfileResponse.ProtoReflect.Descriptor instead.
-func (*UserProfileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
 	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *UserProfileResponse) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
-}
-
-func (x *UserProfileResponse) GetFirstName() string {
-	if x != nil {
-		return x.FirstName
-	}
-	return ""
-}
-
-func (x *UserProfileResponse) GetLastName() string {
-	if x != nil {
-		return x.LastName
-	}
-	return ""
-}
-
-func (x *UserProfileResponse) GetProfilePicture() string {
-	if x != nil {
-		return x.ProfilePicture
-	}
-	return ""
-}
-
-func (x *UserProfileResponse) GetBiography() string {
+func (x *ChangePasswordRequest) GetCurrentPassword() string {
 	if x != nil {
-		return x.Biography
+		return x.CurrentPassword
 	}
 	return ""
 }
 
-func (x *UserProfileResponse) GetMotto() string {
+func (x *ChangePasswordRequest) GetNewPassword() string {
 	if x != nil {
-		return x.Motto
+		return x.NewPassword
 	}
 	return ""
 }
 
-type PositionRequest struct {
+type ChangePasswordResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Lat           float64                `protobuf:"fixed64,1,opt,name=lat,proto3" json:"lat,omitempty"`
-	Lng           float64                `protobuf:"fixed64,2,opt,name=lng,proto3" json:"lng,omitempty"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PositionRequest) Reset() {
-	*x = PositionRequest{}
+func (x *ChangePasswordResponse) Reset() {
+	*x = ChangePasswordResponse{}
 	mi := &file_stakeholders_stakeholders_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PositionRequest) String() string {
+func (x *ChangePasswordResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PositionRequest) ProtoMessage() {}
+func (*ChangePasswordResponse) ProtoMessage() {}
 
-func (x *PositionRequest) ProtoReflect() protoreflect.Message {
+func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_stakeholders_stakeholders_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -804,47 +788,38 @@ func (x *PositionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PositionRequest.ProtoReflect.Descriptor instead.
-func (*PositionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
+func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
 	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *PositionRequest) GetLat() float64 {
-	if x != nil {
-		return x.Lat
-	}
-	return 0
-}
-
-func (x *PositionRequest) GetLng() float64 {
+func (x *ChangePasswordResponse) GetAccessToken() string {
 	if x != nil {
-		return x.Lng
+		return x.AccessToken
 	}
-	return 0
+	return ""
 }
 
-type PositionResponse struct {
+type GetAllUsersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Lat           float64                `protobuf:"fixed64,1,opt,name=lat,proto3" json:"lat,omitempty"`
-	Lng           float64                `protobuf:"fixed64,2,opt,name=lng,proto3" json:"lng,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PositionResponse) Reset() {
-	*x = PositionResponse{}
+func (x *GetAllUsersRequest) Reset() {
+	*x = GetAllUsersRequest{}
 	mi := &file_stakeholders_stakeholders_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PositionResponse) String() string {
+func (x *GetAllUsersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PositionResponse) ProtoMessage() {}
+func (*GetAllUsersRequest) ProtoMessage() {}
 
-func (x *PositionResponse) ProtoReflect() protoreflect.Message {
+func (x *GetAllUsersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_stakeholders_stakeholders_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -856,50 +831,32 @@ func (x *PositionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PositionResponse.ProtoReflect.Descriptor instead.
-func (*PositionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAllUsersRequest.ProtoReflect.Descriptor instead.
+func (*GetAllUsersRequest) Descriptor() ([]byte, []int) {
 	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *PositionResponse) GetLat() float64 {
-	if x != nil {
-		return x.Lat
-	}
-	return 0
-}
-
-func (x *PositionResponse) GetLng() float64 {
-	if x != nil {
-		return x.Lng
-	}
-	return 0
-}
-
-type UserProfile struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	FirstName      string                 `protobuf:"bytes,1,opt,name=firstName,proto3" json:"firstName,omitempty"`
-	LastName       string                 `protobuf:"bytes,2,opt,name=lastName,proto3" json:"lastName,omitempty"`
-	ProfilePicture string                 `protobuf:"bytes,3,opt,name=profilePicture,proto3" json:"profilePicture,omitempty"`
-	Biography      string                 `protobuf:"bytes,4,opt,name=biography,proto3" json:"biography,omitempty"`
-	Motto          string                 `protobuf:"bytes,5,opt,name=motto,proto3" json:"motto,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type GetAllUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserProfile) Reset() {
-	*x = UserProfile{}
+func (x *GetAllUsersResponse) Reset() {
+	*x = GetAllUsersResponse{}
 	mi := &file_stakeholders_stakeholders_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserProfile) String() string {
+func (x *GetAllUsersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserProfile) ProtoMessage() {}
+func (*GetAllUsersResponse) ProtoMessage() {}
 
-func (x *UserProfile) ProtoReflect() protoreflect.Message {
+func (x *GetAllUsersResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_stakeholders_stakeholders_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -911,73 +868,944 @@ func (x *UserProfile) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserProfile.ProtoReflect.Descriptor instead.
-func (*UserProfile) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAllUsersResponse.ProtoReflect.Descriptor instead.
+func (*GetAllUsersResponse) Descriptor() ([]byte, []int) {
 	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *UserProfile) GetFirstName() string {
+func (x *GetAllUsersResponse) GetUsers() []*User {
 	if x != nil {
-		return x.FirstName
+		return x.Users
 	}
-	return ""
+	return nil
 }
 
-func (x *UserProfile) GetLastName() string {
-	if x != nil {
-		return x.LastName
+type BlockUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Block         bool                   `protobuf:"varint,2,opt,name=block,proto3" json:"block,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockUserRequest) Reset() {
+	*x = BlockUserRequest{}
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserRequest) ProtoMessage() {}
+
+func (x *BlockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserRequest.ProtoReflect.Descriptor instead.
+func (*BlockUserRequest) Descriptor() ([]byte, []int) {
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BlockUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BlockUserRequest) GetBlock() bool {
+	if x != nil {
+		return x.Block
+	}
+	return false
+}
+
+type BlockUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockUserResponse) Reset() {
+	*x = BlockUserResponse{}
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserResponse) ProtoMessage() {}
+
+func (x *BlockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserResponse.ProtoReflect.Descriptor instead.
+func (*BlockUserResponse) Descriptor() ([]byte, []int) {
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BlockUserResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GetProfileByUsernameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileByUsernameRequest) Reset() {
+	*x = GetProfileByUsernameRequest{}
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileByUsernameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileByUsernameRequest) ProtoMessage() {}
+
+func (x *GetProfileByUsernameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileByUsernameRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileByUsernameRequest) Descriptor() ([]byte, []int) {
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetProfileByUsernameRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type GetProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileRequest) Reset() {
+	*x = GetProfileRequest{}
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileRequest) ProtoMessage() {}
+
+func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileRequest) Descriptor() ([]byte, []int) {
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{21}
+}
+
+type UpdateProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *UserProfile           `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileRequest) Reset() {
+	*x = UpdateProfileRequest{}
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileRequest) ProtoMessage() {}
+
+func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateProfileRequest) GetProfile() *UserProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type UpdateProfileResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Status                 string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ProfilePictureVariants map[string]string      `protobuf:"bytes,2,rep,name=profilePictureVariants,proto3" json:"profilePictureVariants,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UpdateProfileResponse) Reset() {
+	*x = UpdateProfileResponse{}
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileResponse) ProtoMessage() {}
+
+func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProfileResponse) Descriptor() ([]byte, []int) {
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateProfileResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpdateProfileResponse) GetProfilePictureVariants() map[string]string {
+	if x != nil {
+		return x.ProfilePictureVariants
+	}
+	return nil
+}
+
+type UserProfileResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Username       string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	FirstName      string                 `protobuf:"bytes,2,opt,name=firstName,proto3" json:"firstName,omitempty"`
+	LastName       string                 `protobuf:"bytes,3,opt,name=lastName,proto3" json:"lastName,omitempty"`
+	ProfilePicture string                 `protobuf:"bytes,4,opt,name=profilePicture,proto3" json:"profilePicture,omitempty"`
+	Biography      string                 `protobuf:"bytes,5,opt,name=biography,proto3" json:"biography,omitempty"`
+	Motto          string                 `protobuf:"bytes,6,opt,name=motto,proto3" json:"motto,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UserProfileResponse) Reset() {
+	*x = UserProfileResponse{}
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserProfileResponse) ProtoMessage() {}
+
+func (x *UserProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserProfileResponse.ProtoReflect.Descriptor instead.
+func (*UserProfileResponse) Descriptor() ([]byte, []int) {
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UserProfileResponse) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UserProfileResponse) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *UserProfileResponse) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *UserProfileResponse) GetProfilePicture() string {
+	if x != nil {
+		return x.ProfilePicture
+	}
+	return ""
+}
+
+func (x *UserProfileResponse) GetBiography() string {
+	if x != nil {
+		return x.Biography
+	}
+	return ""
+}
+
+func (x *UserProfileResponse) GetMotto() string {
+	if x != nil {
+		return x.Motto
+	}
+	return ""
+}
+
+type PositionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lat           float64                `protobuf:"fixed64,1,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lng           float64                `protobuf:"fixed64,2,opt,name=lng,proto3" json:"lng,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PositionRequest) Reset() {
+	*x = PositionRequest{}
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PositionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PositionRequest) ProtoMessage() {}
+
+func (x *PositionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PositionRequest.ProtoReflect.Descriptor instead.
+func (*PositionRequest) Descriptor() ([]byte, []int) {
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PositionRequest) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *PositionRequest) GetLng() float64 {
+	if x != nil {
+		return x.Lng
+	}
+	return 0
+}
+
+type PositionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lat           float64                `protobuf:"fixed64,1,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lng           float64                `protobuf:"fixed64,2,opt,name=lng,proto3" json:"lng,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PositionResponse) Reset() {
+	*x = PositionResponse{}
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PositionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PositionResponse) ProtoMessage() {}
+
+func (x *PositionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PositionResponse.ProtoReflect.Descriptor instead.
+func (*PositionResponse) Descriptor() ([]byte, []int) {
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PositionResponse) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *PositionResponse) GetLng() float64 {
+	if x != nil {
+		return x.Lng
+	}
+	return 0
+}
+
+type UserProfile struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	FirstName      string                 `protobuf:"bytes,1,opt,name=firstName,proto3" json:"firstName,omitempty"`
+	LastName       string                 `protobuf:"bytes,2,opt,name=lastName,proto3" json:"lastName,omitempty"`
+	ProfilePicture string                 `protobuf:"bytes,3,opt,name=profilePicture,proto3" json:"profilePicture,omitempty"`
+	Biography      string                 `protobuf:"bytes,4,opt,name=biography,proto3" json:"biography,omitempty"`
+	Motto          string                 `protobuf:"bytes,5,opt,name=motto,proto3" json:"motto,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UserProfile) Reset() {
+	*x = UserProfile{}
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserProfile) ProtoMessage() {}
+
+func (x *UserProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserProfile.ProtoReflect.Descriptor instead.
+func (*UserProfile) Descriptor() ([]byte, []int) {
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UserProfile) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *UserProfile) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *UserProfile) GetProfilePicture() string {
+	if x != nil {
+		return x.ProfilePicture
+	}
+	return ""
+}
+
+func (x *UserProfile) GetBiography() string {
+	if x != nil {
+		return x.Biography
+	}
+	return ""
+}
+
+func (x *UserProfile) GetMotto() string {
+	if x != nil {
+		return x.Motto
+	}
+	return ""
+}
+
+type User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Password      string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	Role          string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	IsBlocked     bool                   `protobuf:"varint,6,opt,name=isBlocked,proto3" json:"isBlocked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *User) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *User) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *User) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *User) GetIsBlocked() bool {
+	if x != nil {
+		return x.IsBlocked
+	}
+	return false
+}
+
+type UpdateBalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Command       string                 `protobuf:"bytes,3,opt,name=command,proto3" json:"command,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBalanceRequest) Reset() {
+	*x = UpdateBalanceRequest{}
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBalanceRequest) ProtoMessage() {}
+
+func (x *UpdateBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBalanceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UpdateBalanceRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateBalanceRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *UpdateBalanceRequest) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+type UpdateBalanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBalanceResponse) Reset() {
+	*x = UpdateBalanceResponse{}
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBalanceResponse) ProtoMessage() {}
+
+func (x *UpdateBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBalanceResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateBalanceResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateBalanceResponse) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *UpdateBalanceResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type CheckUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Usernames     []string               `protobuf:"bytes,1,rep,name=usernames,proto3" json:"usernames,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckUsersRequest) Reset() {
+	*x = CheckUsersRequest{}
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckUsersRequest) ProtoMessage() {}
+
+func (x *CheckUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckUsersRequest.ProtoReflect.Descriptor instead.
+func (*CheckUsersRequest) Descriptor() ([]byte, []int) {
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CheckUsersRequest) GetUsernames() []string {
+	if x != nil {
+		return x.Usernames
 	}
-	return ""
+	return nil
 }
 
-func (x *UserProfile) GetProfilePicture() string {
+type CheckUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*UserStatus          `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckUsersResponse) Reset() {
+	*x = CheckUsersResponse{}
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckUsersResponse) ProtoMessage() {}
+
+func (x *CheckUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[32]
 	if x != nil {
-		return x.ProfilePicture
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckUsersResponse.ProtoReflect.Descriptor instead.
+func (*CheckUsersResponse) Descriptor() ([]byte, []int) {
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CheckUsersResponse) GetUsers() []*UserStatus {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type UserStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Exists        bool                   `protobuf:"varint,2,opt,name=exists,proto3" json:"exists,omitempty"`
+	IsBlocked     bool                   `protobuf:"varint,3,opt,name=isBlocked,proto3" json:"isBlocked,omitempty"`
+	UserId        string                 `protobuf:"bytes,4,opt,name=userId,proto3" json:"userId,omitempty"`
+	Role          string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserStatus) Reset() {
+	*x = UserStatus{}
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserStatus) ProtoMessage() {}
+
+func (x *UserStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserStatus.ProtoReflect.Descriptor instead.
+func (*UserStatus) Descriptor() ([]byte, []int) {
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UserStatus) GetUsername() string {
+	if x != nil {
+		return x.Username
 	}
 	return ""
 }
 
-func (x *UserProfile) GetBiography() string {
+func (x *UserStatus) GetExists() bool {
 	if x != nil {
-		return x.Biography
+		return x.Exists
+	}
+	return false
+}
+
+func (x *UserStatus) GetIsBlocked() bool {
+	if x != nil {
+		return x.IsBlocked
+	}
+	return false
+}
+
+func (x *UserStatus) GetUserId() string {
+	if x != nil {
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *UserProfile) GetMotto() string {
+func (x *UserStatus) GetRole() string {
 	if x != nil {
-		return x.Motto
+		return x.Role
 	}
 	return ""
 }
 
-type User struct {
+type GetUserContactsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	Password      string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
-	Role          string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
-	IsBlocked     bool                   `protobuf:"varint,6,opt,name=isBlocked,proto3" json:"isBlocked,omitempty"`
+	UserIds       []string               `protobuf:"bytes,1,rep,name=userIds,proto3" json:"userIds,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *User) Reset() {
-	*x = User{}
-	mi := &file_stakeholders_stakeholders_proto_msgTypes[18]
+func (x *GetUserContactsRequest) Reset() {
+	*x = GetUserContactsRequest{}
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *User) String() string {
+func (x *GetUserContactsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*User) ProtoMessage() {}
+func (*GetUserContactsRequest) ProtoMessage() {}
 
-func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_stakeholders_stakeholders_proto_msgTypes[18]
+func (x *GetUserContactsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -988,77 +1816,162 @@ func (x *User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use User.ProtoReflect.Descriptor instead.
-func (*User) Descriptor() ([]byte, []int) {
-	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use GetUserContactsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserContactsRequest) Descriptor() ([]byte, []int) {
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *User) GetId() string {
+func (x *GetUserContactsRequest) GetUserIds() []string {
 	if x != nil {
-		return x.Id
+		return x.UserIds
 	}
-	return ""
+	return nil
 }
 
-func (x *User) GetUsername() string {
+type GetUserContactsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*UserContact         `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserContactsResponse) Reset() {
+	*x = GetUserContactsResponse{}
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserContactsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserContactsResponse) ProtoMessage() {}
+
+func (x *GetUserContactsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[35]
 	if x != nil {
-		return x.Username
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserContactsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserContactsResponse) Descriptor() ([]byte, []int) {
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetUserContactsResponse) GetUsers() []*UserContact {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type UserContact struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	FirstName     string                 `protobuf:"bytes,4,opt,name=firstName,proto3" json:"firstName,omitempty"`
+	IsBlocked     bool                   `protobuf:"varint,5,opt,name=isBlocked,proto3" json:"isBlocked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserContact) Reset() {
+	*x = UserContact{}
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserContact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserContact) ProtoMessage() {}
+
+func (x *UserContact) ProtoReflect() protoreflect.Message {
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserContact.ProtoReflect.Descriptor instead.
+func (*UserContact) Descriptor() ([]byte, []int) {
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UserContact) GetUserId() string {
+	if x != nil {
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *User) GetEmail() string {
+func (x *UserContact) GetUsername() string {
 	if x != nil {
-		return x.Email
+		return x.Username
 	}
 	return ""
 }
 
-func (x *User) GetPassword() string {
+func (x *UserContact) GetEmail() string {
 	if x != nil {
-		return x.Password
+		return x.Email
 	}
 	return ""
 }
 
-func (x *User) GetRole() string {
+func (x *UserContact) GetFirstName() string {
 	if x != nil {
-		return x.Role
+		return x.FirstName
 	}
 	return ""
 }
 
-func (x *User) GetIsBlocked() bool {
+func (x *UserContact) GetIsBlocked() bool {
 	if x != nil {
 		return x.IsBlocked
 	}
 	return false
 }
 
-type UpdateBalanceRequest struct {
+type GetNearbyUsersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Command       string                 `protobuf:"bytes,3,opt,name=command,proto3" json:"command,omitempty"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	RadiusKm      float64                `protobuf:"fixed64,2,opt,name=radiusKm,proto3" json:"radiusKm,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateBalanceRequest) Reset() {
-	*x = UpdateBalanceRequest{}
-	mi := &file_stakeholders_stakeholders_proto_msgTypes[19]
+func (x *GetNearbyUsersRequest) Reset() {
+	*x = GetNearbyUsersRequest{}
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateBalanceRequest) String() string {
+func (x *GetNearbyUsersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBalanceRequest) ProtoMessage() {}
+func (*GetNearbyUsersRequest) ProtoMessage() {}
 
-func (x *UpdateBalanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stakeholders_stakeholders_proto_msgTypes[19]
+func (x *GetNearbyUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1069,56 +1982,54 @@ func (x *UpdateBalanceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBalanceRequest.ProtoReflect.Descriptor instead.
-func (*UpdateBalanceRequest) Descriptor() ([]byte, []int) {
-	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GetNearbyUsersRequest.ProtoReflect.Descriptor instead.
+func (*GetNearbyUsersRequest) Descriptor() ([]byte, []int) {
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *UpdateBalanceRequest) GetUserId() string {
+func (x *GetNearbyUsersRequest) GetUsername() string {
 	if x != nil {
-		return x.UserId
+		return x.Username
 	}
 	return ""
 }
 
-func (x *UpdateBalanceRequest) GetAmount() float64 {
+func (x *GetNearbyUsersRequest) GetRadiusKm() float64 {
 	if x != nil {
-		return x.Amount
+		return x.RadiusKm
 	}
 	return 0
 }
 
-func (x *UpdateBalanceRequest) GetCommand() string {
+func (x *GetNearbyUsersRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Command
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-type UpdateBalanceResponse struct {
+type GetNearbyUsersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Users         []*NearbyUser          `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateBalanceResponse) Reset() {
-	*x = UpdateBalanceResponse{}
-	mi := &file_stakeholders_stakeholders_proto_msgTypes[20]
+func (x *GetNearbyUsersResponse) Reset() {
+	*x = GetNearbyUsersResponse{}
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateBalanceResponse) String() string {
+func (x *GetNearbyUsersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBalanceResponse) ProtoMessage() {}
+func (*GetNearbyUsersResponse) ProtoMessage() {}
 
-func (x *UpdateBalanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stakeholders_stakeholders_proto_msgTypes[20]
+func (x *GetNearbyUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1129,32 +2040,70 @@ func (x *UpdateBalanceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBalanceResponse.ProtoReflect.Descriptor instead.
-func (*UpdateBalanceResponse) Descriptor() ([]byte, []int) {
-	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use GetNearbyUsersResponse.ProtoReflect.Descriptor instead.
+func (*GetNearbyUsersResponse) Descriptor() ([]byte, []int) {
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *UpdateBalanceResponse) GetUserId() string {
+func (x *GetNearbyUsersResponse) GetUsers() []*NearbyUser {
 	if x != nil {
-		return x.UserId
+		return x.Users
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateBalanceResponse) GetAmount() float64 {
+type NearbyUser struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	DistanceKm    float64                `protobuf:"fixed64,2,opt,name=distanceKm,proto3" json:"distanceKm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NearbyUser) Reset() {
+	*x = NearbyUser{}
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NearbyUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearbyUser) ProtoMessage() {}
+
+func (x *NearbyUser) ProtoReflect() protoreflect.Message {
+	mi := &file_stakeholders_stakeholders_proto_msgTypes[39]
 	if x != nil {
-		return x.Amount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateBalanceResponse) GetStatus() string {
+// Deprecated: Use NearbyUser.ProtoReflect.Descriptor instead.
+func (*NearbyUser) Descriptor() ([]byte, []int) {
+	return file_stakeholders_stakeholders_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *NearbyUser) GetUsername() string {
 	if x != nil {
-		return x.Status
+		return x.Username
 	}
 	return ""
 }
 
+func (x *NearbyUser) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
 var File_stakeholders_stakeholders_proto protoreflect.FileDescriptor
 
 const file_stakeholders_stakeholders_proto_rawDesc = "" +
@@ -1178,6 +2127,28 @@ const file_stakeholders_stakeholders_proto_rawDesc = "" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\"1\n" +
 	"\rLoginResponse\x12 \n" +
+	"\vaccessToken\x18\x01 \x01(\tR\vaccessToken\"*\n" +
+	"\x12VerifyEmailRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"-\n" +
+	"\x13VerifyEmailResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"6\n" +
+	"\x1eResendVerificationEmailRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"9\n" +
+	"\x1fResendVerificationEmailResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"3\n" +
+	"\x1bRequestPasswordResetRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"6\n" +
+	"\x1cRequestPasswordResetResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"N\n" +
+	"\x14ResetPasswordRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12 \n" +
+	"\vnewPassword\x18\x02 \x01(\tR\vnewPassword\"/\n" +
+	"\x15ResetPasswordResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"c\n" +
+	"\x15ChangePasswordRequest\x12(\n" +
+	"\x0fcurrentPassword\x18\x01 \x01(\tR\x0fcurrentPassword\x12 \n" +
+	"\vnewPassword\x18\x02 \x01(\tR\vnewPassword\":\n" +
+	"\x16ChangePasswordResponse\x12 \n" +
 	"\vaccessToken\x18\x01 \x01(\tR\vaccessToken\"\x14\n" +
 	"\x12GetAllUsersRequest\"?\n" +
 	"\x13GetAllUsersResponse\x12(\n" +
@@ -1191,9 +2162,13 @@ const file_stakeholders_stakeholders_proto_rawDesc = "" +
 	"\busername\x18\x01 \x01(\tR\busername\"\x13\n" +
 	"\x11GetProfileRequest\"K\n" +
 	"\x14UpdateProfileRequest\x123\n" +
-	"\aprofile\x18\x01 \x01(\v2\x19.stakeholders.UserProfileR\aprofile\"/\n" +
+	"\aprofile\x18\x01 \x01(\v2\x19.stakeholders.UserProfileR\aprofile\"\xf3\x01\n" +
 	"\x15UpdateProfileResponse\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\tR\x06status\"\xc7\x01\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12w\n" +
+	"\x16profilePictureVariants\x18\x02 \x03(\v2?.stakeholders.UpdateProfileResponse.ProfilePictureVariantsEntryR\x16profilePictureVariants\x1aI\n" +
+	"\x1bProfilePictureVariantsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc7\x01\n" +
 	"\x13UserProfileResponse\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1c\n" +
 	"\tfirstName\x18\x02 \x01(\tR\tfirstName\x12\x1a\n" +
@@ -1227,8 +2202,40 @@ const file_stakeholders_stakeholders_proto_rawDesc = "" +
 	"\x15UpdateBalanceResponse\x12\x16\n" +
 	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
 	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x16\n" +
-	"\x06status\x18\x03 \x01(\tR\x06status2\x88\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\"1\n" +
+	"\x11CheckUsersRequest\x12\x1c\n" +
+	"\tusernames\x18\x01 \x03(\tR\tusernames\"D\n" +
+	"\x12CheckUsersResponse\x12.\n" +
+	"\x05users\x18\x01 \x03(\v2\x18.stakeholders.UserStatusR\x05users\"\x8a\x01\n" +
+	"\n" +
+	"UserStatus\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x16\n" +
+	"\x06exists\x18\x02 \x01(\bR\x06exists\x12\x1c\n" +
+	"\tisBlocked\x18\x03 \x01(\bR\tisBlocked\x12\x16\n" +
+	"\x06userId\x18\x04 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x05 \x01(\tR\x04role\"2\n" +
+	"\x16GetUserContactsRequest\x12\x18\n" +
+	"\auserIds\x18\x01 \x03(\tR\auserIds\"J\n" +
+	"\x17GetUserContactsResponse\x12/\n" +
+	"\x05users\x18\x01 \x03(\v2\x19.stakeholders.UserContactR\x05users\"\x93\x01\n" +
+	"\vUserContact\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1c\n" +
+	"\tfirstName\x18\x04 \x01(\tR\tfirstName\x12\x1c\n" +
+	"\tisBlocked\x18\x05 \x01(\bR\tisBlocked\"e\n" +
+	"\x15GetNearbyUsersRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bradiusKm\x18\x02 \x01(\x01R\bradiusKm\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"H\n" +
+	"\x16GetNearbyUsersResponse\x12.\n" +
+	"\x05users\x18\x01 \x03(\v2\x18.stakeholders.NearbyUserR\x05users\"H\n" +
 	"\n" +
+	"NearbyUser\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1e\n" +
+	"\n" +
+	"distanceKm\x18\x02 \x01(\x01R\n" +
+	"distanceKm2\xd1\x11\n" +
 	"\x13StakeholdersService\x12h\n" +
 	"\bRegister\x12\x1d.stakeholders.RegisterRequest\x1a\x1e.stakeholders.RegisterResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/auth/register\x12\\\n" +
 	"\x05Login\x12\x1a.stakeholders.LoginRequest\x1a\x1b.stakeholders.LoginResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/api/auth/login\x12l\n" +
@@ -1239,11 +2246,20 @@ const file_stakeholders_stakeholders_proto_rawDesc = "" +
 	"GetProfile\x12\x1f.stakeholders.GetProfileRequest\x1a!.stakeholders.UserProfileResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/user/profile\x12v\n" +
 	"\rUpdateProfile\x12\".stakeholders.UpdateProfileRequest\x1a#.stakeholders.UpdateProfileResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\x1a\x11/api/user/profile\x12f\n" +
 	"\vSetPosition\x12\x1d.stakeholders.PositionRequest\x1a\x16.google.protobuf.Empty\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/tourist/position\x12d\n" +
-	"\vGetPosition\x12\x16.google.protobuf.Empty\x1a\x1e.stakeholders.PositionResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/tourist/position\x12X\n" +
+	"\vGetPosition\x12\x16.google.protobuf.Empty\x1a\x1e.stakeholders.PositionResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/tourist/position\x12u\n" +
+	"\vVerifyEmail\x12 .stakeholders.VerifyEmailRequest\x1a!.stakeholders.VerifyEmailResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/auth/verify-email\x12\xa0\x01\n" +
+	"\x17ResendVerificationEmail\x12,.stakeholders.ResendVerificationEmailRequest\x1a-.stakeholders.ResendVerificationEmailResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/auth/verify-email/resend\x12\x92\x01\n" +
+	"\x14RequestPasswordReset\x12).stakeholders.RequestPasswordResetRequest\x1a*.stakeholders.RequestPasswordResetResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/auth/password-reset\x12\x85\x01\n" +
+	"\rResetPassword\x12\".stakeholders.ResetPasswordRequest\x1a#.stakeholders.ResetPasswordResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/auth/password-reset/confirm\x12\x81\x01\n" +
+	"\x0eChangePassword\x12#.stakeholders.ChangePasswordRequest\x1a$.stakeholders.ChangePasswordResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/auth/change-password\x12X\n" +
 	"\rValidateToken\x12\".stakeholders.ValidateTokenRequest\x1a#.stakeholders.ValidateTokenResponse\x12U\n" +
 	"\n" +
 	"AddBalance\x12\".stakeholders.UpdateBalanceRequest\x1a#.stakeholders.UpdateBalanceResponse\x12Z\n" +
-	"\x0fSubtractBalance\x12\".stakeholders.UpdateBalanceRequest\x1a#.stakeholders.UpdateBalanceResponseB4Z2soa-team-5/stakeholders-service/proto/stakeholdersb\x06proto3"
+	"\x0fSubtractBalance\x12\".stakeholders.UpdateBalanceRequest\x1a#.stakeholders.UpdateBalanceResponse\x12O\n" +
+	"\n" +
+	"CheckUsers\x12\x1f.stakeholders.CheckUsersRequest\x1a .stakeholders.CheckUsersResponse\x12[\n" +
+	"\x0eGetNearbyUsers\x12#.stakeholders.GetNearbyUsersRequest\x1a$.stakeholders.GetNearbyUsersResponse\x12^\n" +
+	"\x0fGetUserContacts\x12$.stakeholders.GetUserContactsRequest\x1a%.stakeholders.GetUserContactsResponseB4Z2soa-team-5/stakeholders-service/proto/stakeholdersb\x06proto3"
 
 var (
 	file_stakeholders_stakeholders_proto_rawDescOnce sync.Once
@@ -1257,63 +2273,103 @@ func file_stakeholders_stakeholders_proto_rawDescGZIP() []byte {
 	return file_stakeholders_stakeholders_proto_rawDescData
 }
 
-var file_stakeholders_stakeholders_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_stakeholders_stakeholders_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
 var file_stakeholders_stakeholders_proto_goTypes = []any{
-	(*ValidateTokenRequest)(nil),        // 0: stakeholders.ValidateTokenRequest
-	(*ValidateTokenResponse)(nil),       // 1: stakeholders.ValidateTokenResponse
-	(*RegisterRequest)(nil),             // 2: stakeholders.RegisterRequest
-	(*RegisterResponse)(nil),            // 3: stakeholders.RegisterResponse
-	(*LoginRequest)(nil),                // 4: stakeholders.LoginRequest
-	(*LoginResponse)(nil),               // 5: stakeholders.LoginResponse
-	(*GetAllUsersRequest)(nil),          // 6: stakeholders.GetAllUsersRequest
-	(*GetAllUsersResponse)(nil),         // 7: stakeholders.GetAllUsersResponse
-	(*BlockUserRequest)(nil),            // 8: stakeholders.BlockUserRequest
-	(*BlockUserResponse)(nil),           // 9: stakeholders.BlockUserResponse
-	(*GetProfileByUsernameRequest)(nil), // 10: stakeholders.GetProfileByUsernameRequest
-	(*GetProfileRequest)(nil),           // 11: stakeholders.GetProfileRequest
-	(*UpdateProfileRequest)(nil),        // 12: stakeholders.UpdateProfileRequest
-	(*UpdateProfileResponse)(nil),       // 13: stakeholders.UpdateProfileResponse
-	(*UserProfileResponse)(nil),         // 14: stakeholders.UserProfileResponse
-	(*PositionRequest)(nil),             // 15: stakeholders.PositionRequest
-	(*PositionResponse)(nil),            // 16: stakeholders.PositionResponse
-	(*UserProfile)(nil),                 // 17: stakeholders.UserProfile
-	(*User)(nil),                        // 18: stakeholders.User
-	(*UpdateBalanceRequest)(nil),        // 19: stakeholders.UpdateBalanceRequest
-	(*UpdateBalanceResponse)(nil),       // 20: stakeholders.UpdateBalanceResponse
-	(*emptypb.Empty)(nil),               // 21: google.protobuf.Empty
+	(*ValidateTokenRequest)(nil),            // 0: stakeholders.ValidateTokenRequest
+	(*ValidateTokenResponse)(nil),           // 1: stakeholders.ValidateTokenResponse
+	(*RegisterRequest)(nil),                 // 2: stakeholders.RegisterRequest
+	(*RegisterResponse)(nil),                // 3: stakeholders.RegisterResponse
+	(*LoginRequest)(nil),                    // 4: stakeholders.LoginRequest
+	(*LoginResponse)(nil),                   // 5: stakeholders.LoginResponse
+	(*VerifyEmailRequest)(nil),              // 6: stakeholders.VerifyEmailRequest
+	(*VerifyEmailResponse)(nil),             // 7: stakeholders.VerifyEmailResponse
+	(*ResendVerificationEmailRequest)(nil),  // 8: stakeholders.ResendVerificationEmailRequest
+	(*ResendVerificationEmailResponse)(nil), // 9: stakeholders.ResendVerificationEmailResponse
+	(*RequestPasswordResetRequest)(nil),     // 10: stakeholders.RequestPasswordResetRequest
+	(*RequestPasswordResetResponse)(nil),    // 11: stakeholders.RequestPasswordResetResponse
+	(*ResetPasswordRequest)(nil),            // 12: stakeholders.ResetPasswordRequest
+	(*ResetPasswordResponse)(nil),           // 13: stakeholders.ResetPasswordResponse
+	(*ChangePasswordRequest)(nil),           // 14: stakeholders.ChangePasswordRequest
+	(*ChangePasswordResponse)(nil),          // 15: stakeholders.ChangePasswordResponse
+	(*GetAllUsersRequest)(nil),              // 16: stakeholders.GetAllUsersRequest
+	(*GetAllUsersResponse)(nil),             // 17: stakeholders.GetAllUsersResponse
+	(*BlockUserRequest)(nil),                // 18: stakeholders.BlockUserRequest
+	(*BlockUserResponse)(nil),               // 19: stakeholders.BlockUserResponse
+	(*GetProfileByUsernameRequest)(nil),     // 20: stakeholders.GetProfileByUsernameRequest
+	(*GetProfileRequest)(nil),               // 21: stakeholders.GetProfileRequest
+	(*UpdateProfileRequest)(nil),            // 22: stakeholders.UpdateProfileRequest
+	(*UpdateProfileResponse)(nil),           // 23: stakeholders.UpdateProfileResponse
+	(*UserProfileResponse)(nil),             // 24: stakeholders.UserProfileResponse
+	(*PositionRequest)(nil),                 // 25: stakeholders.PositionRequest
+	(*PositionResponse)(nil),                // 26: stakeholders.PositionResponse
+	(*UserProfile)(nil),                     // 27: stakeholders.UserProfile
+	(*User)(nil),                            // 28: stakeholders.User
+	(*UpdateBalanceRequest)(nil),            // 29: stakeholders.UpdateBalanceRequest
+	(*UpdateBalanceResponse)(nil),           // 30: stakeholders.UpdateBalanceResponse
+	(*CheckUsersRequest)(nil),               // 31: stakeholders.CheckUsersRequest
+	(*CheckUsersResponse)(nil),              // 32: stakeholders.CheckUsersResponse
+	(*UserStatus)(nil),                      // 33: stakeholders.UserStatus
+	(*GetUserContactsRequest)(nil),          // 34: stakeholders.GetUserContactsRequest
+	(*GetUserContactsResponse)(nil),         // 35: stakeholders.GetUserContactsResponse
+	(*UserContact)(nil),                     // 36: stakeholders.UserContact
+	(*GetNearbyUsersRequest)(nil),           // 37: stakeholders.GetNearbyUsersRequest
+	(*GetNearbyUsersResponse)(nil),          // 38: stakeholders.GetNearbyUsersResponse
+	(*NearbyUser)(nil),                      // 39: stakeholders.NearbyUser
+	nil,                                     // 40: stakeholders.UpdateProfileResponse.ProfilePictureVariantsEntry
+	(*emptypb.Empty)(nil),                   // 41: google.protobuf.Empty
 }
 var file_stakeholders_stakeholders_proto_depIdxs = []int32{
-	18, // 0: stakeholders.GetAllUsersResponse.users:type_name -> stakeholders.User
-	17, // 1: stakeholders.UpdateProfileRequest.profile:type_name -> stakeholders.UserProfile
-	2,  // 2: stakeholders.StakeholdersService.Register:input_type -> stakeholders.RegisterRequest
-	4,  // 3: stakeholders.StakeholdersService.Login:input_type -> stakeholders.LoginRequest
-	6,  // 4: stakeholders.StakeholdersService.GetAllUsers:input_type -> stakeholders.GetAllUsersRequest
-	8,  // 5: stakeholders.StakeholdersService.BlockUser:input_type -> stakeholders.BlockUserRequest
-	10, // 6: stakeholders.StakeholdersService.GetProfileByUsername:input_type -> stakeholders.GetProfileByUsernameRequest
-	11, // 7: stakeholders.StakeholdersService.GetProfile:input_type -> stakeholders.GetProfileRequest
-	12, // 8: stakeholders.StakeholdersService.UpdateProfile:input_type -> stakeholders.UpdateProfileRequest
-	15, // 9: stakeholders.StakeholdersService.SetPosition:input_type -> stakeholders.PositionRequest
-	21, // 10: stakeholders.StakeholdersService.GetPosition:input_type -> google.protobuf.Empty
-	0,  // 11: stakeholders.StakeholdersService.ValidateToken:input_type -> stakeholders.ValidateTokenRequest
-	19, // 12: stakeholders.StakeholdersService.AddBalance:input_type -> stakeholders.UpdateBalanceRequest
-	19, // 13: stakeholders.StakeholdersService.SubtractBalance:input_type -> stakeholders.UpdateBalanceRequest
-	3,  // 14: stakeholders.StakeholdersService.Register:output_type -> stakeholders.RegisterResponse
-	5,  // 15: stakeholders.StakeholdersService.Login:output_type -> stakeholders.LoginResponse
-	7,  // 16: stakeholders.StakeholdersService.GetAllUsers:output_type -> stakeholders.GetAllUsersResponse
-	9,  // 17: stakeholders.StakeholdersService.BlockUser:output_type -> stakeholders.BlockUserResponse
-	14, // 18: stakeholders.StakeholdersService.GetProfileByUsername:output_type -> stakeholders.UserProfileResponse
-	14, // 19: stakeholders.StakeholdersService.GetProfile:output_type -> stakeholders.UserProfileResponse
-	13, // 20: stakeholders.StakeholdersService.UpdateProfile:output_type -> stakeholders.UpdateProfileResponse
-	21, // 21: stakeholders.StakeholdersService.SetPosition:output_type -> google.protobuf.Empty
-	16, // 22: stakeholders.StakeholdersService.GetPosition:output_type -> stakeholders.PositionResponse
-	1,  // 23: stakeholders.StakeholdersService.ValidateToken:output_type -> stakeholders.ValidateTokenResponse
-	20, // 24: stakeholders.StakeholdersService.AddBalance:output_type -> stakeholders.UpdateBalanceResponse
-	20, // 25: stakeholders.StakeholdersService.SubtractBalance:output_type -> stakeholders.UpdateBalanceResponse
-	14, // [14:26] is the sub-list for method output_type
-	2,  // [2:14] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	28, // 0: stakeholders.GetAllUsersResponse.users:type_name -> stakeholders.User
+	27, // 1: stakeholders.UpdateProfileRequest.profile:type_name -> stakeholders.UserProfile
+	40, // 2: stakeholders.UpdateProfileResponse.profilePictureVariants:type_name -> stakeholders.UpdateProfileResponse.ProfilePictureVariantsEntry
+	33, // 3: stakeholders.CheckUsersResponse.users:type_name -> stakeholders.UserStatus
+	36, // 4: stakeholders.GetUserContactsResponse.users:type_name -> stakeholders.UserContact
+	39, // 5: stakeholders.GetNearbyUsersResponse.users:type_name -> stakeholders.NearbyUser
+	2,  // 6: stakeholders.StakeholdersService.Register:input_type -> stakeholders.RegisterRequest
+	4,  // 7: stakeholders.StakeholdersService.Login:input_type -> stakeholders.LoginRequest
+	16, // 8: stakeholders.StakeholdersService.GetAllUsers:input_type -> stakeholders.GetAllUsersRequest
+	18, // 9: stakeholders.StakeholdersService.BlockUser:input_type -> stakeholders.BlockUserRequest
+	20, // 10: stakeholders.StakeholdersService.GetProfileByUsername:input_type -> stakeholders.GetProfileByUsernameRequest
+	21, // 11: stakeholders.StakeholdersService.GetProfile:input_type -> stakeholders.GetProfileRequest
+	22, // 12: stakeholders.StakeholdersService.UpdateProfile:input_type -> stakeholders.UpdateProfileRequest
+	25, // 13: stakeholders.StakeholdersService.SetPosition:input_type -> stakeholders.PositionRequest
+	41, // 14: stakeholders.StakeholdersService.GetPosition:input_type -> google.protobuf.Empty
+	6,  // 15: stakeholders.StakeholdersService.VerifyEmail:input_type -> stakeholders.VerifyEmailRequest
+	8,  // 16: stakeholders.StakeholdersService.ResendVerificationEmail:input_type -> stakeholders.ResendVerificationEmailRequest
+	10, // 17: stakeholders.StakeholdersService.RequestPasswordReset:input_type -> stakeholders.RequestPasswordResetRequest
+	12, // 18: stakeholders.StakeholdersService.ResetPassword:input_type -> stakeholders.ResetPasswordRequest
+	14, // 19: stakeholders.StakeholdersService.ChangePassword:input_type -> stakeholders.ChangePasswordRequest
+	0,  // 20: stakeholders.StakeholdersService.ValidateToken:input_type -> stakeholders.ValidateTokenRequest
+	29, // 21: stakeholders.StakeholdersService.AddBalance:input_type -> stakeholders.UpdateBalanceRequest
+	29, // 22: stakeholders.StakeholdersService.SubtractBalance:input_type -> stakeholders.UpdateBalanceRequest
+	31, // 23: stakeholders.StakeholdersService.CheckUsers:input_type -> stakeholders.CheckUsersRequest
+	37, // 24: stakeholders.StakeholdersService.GetNearbyUsers:input_type -> stakeholders.GetNearbyUsersRequest
+	34, // 25: stakeholders.StakeholdersService.GetUserContacts:input_type -> stakeholders.GetUserContactsRequest
+	3,  // 26: stakeholders.StakeholdersService.Register:output_type -> stakeholders.RegisterResponse
+	5,  // 27: stakeholders.StakeholdersService.Login:output_type -> stakeholders.LoginResponse
+	17, // 28: stakeholders.StakeholdersService.GetAllUsers:output_type -> stakeholders.GetAllUsersResponse
+	19, // 29: stakeholders.StakeholdersService.BlockUser:output_type -> stakeholders.BlockUserResponse
+	24, // 30: stakeholders.StakeholdersService.GetProfileByUsername:output_type -> stakeholders.UserProfileResponse
+	24, // 31: stakeholders.StakeholdersService.GetProfile:output_type -> stakeholders.UserProfileResponse
+	23, // 32: stakeholders.StakeholdersService.UpdateProfile:output_type -> stakeholders.UpdateProfileResponse
+	41, // 33: stakeholders.StakeholdersService.SetPosition:output_type -> google.protobuf.Empty
+	26, // 34: stakeholders.StakeholdersService.GetPosition:output_type -> stakeholders.PositionResponse
+	7,  // 35: stakeholders.StakeholdersService.VerifyEmail:output_type -> stakeholders.VerifyEmailResponse
+	9,  // 36: stakeholders.StakeholdersService.ResendVerificationEmail:output_type -> stakeholders.ResendVerificationEmailResponse
+	11, // 37: stakeholders.StakeholdersService.RequestPasswordReset:output_type -> stakeholders.RequestPasswordResetResponse
+	13, // 38: stakeholders.StakeholdersService.ResetPassword:output_type -> stakeholders.ResetPasswordResponse
+	15, // 39: stakeholders.StakeholdersService.ChangePassword:output_type -> stakeholders.ChangePasswordResponse
+	1,  // 40: stakeholders.StakeholdersService.ValidateToken:output_type -> stakeholders.ValidateTokenResponse
+	30, // 41: stakeholders.StakeholdersService.AddBalance:output_type -> stakeholders.UpdateBalanceResponse
+	30, // 42: stakeholders.StakeholdersService.SubtractBalance:output_type -> stakeholders.UpdateBalanceResponse
+	32, // 43: stakeholders.StakeholdersService.CheckUsers:output_type -> stakeholders.CheckUsersResponse
+	38, // 44: stakeholders.StakeholdersService.GetNearbyUsers:output_type -> stakeholders.GetNearbyUsersResponse
+	35, // 45: stakeholders.StakeholdersService.GetUserContacts:output_type -> stakeholders.GetUserContactsResponse
+	26, // [26:46] is the sub-list for method output_type
+	6,  // [6:26] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_stakeholders_stakeholders_proto_init() }
@@ -1327,7 +2383,7 @@ func file_stakeholders_stakeholders_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_stakeholders_stakeholders_proto_rawDesc), len(file_stakeholders_stakeholders_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   21,
+			NumMessages:   41,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,18 +20,26 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	StakeholdersService_Register_FullMethodName             = "/stakeholders.StakeholdersService/Register"
-	StakeholdersService_Login_FullMethodName                = "/stakeholders.StakeholdersService/Login"
-	StakeholdersService_GetAllUsers_FullMethodName          = "/stakeholders.StakeholdersService/GetAllUsers"
-	StakeholdersService_BlockUser_FullMethodName            = "/stakeholders.StakeholdersService/BlockUser"
-	StakeholdersService_GetProfileByUsername_FullMethodName = "/stakeholders.StakeholdersService/GetProfileByUsername"
-	StakeholdersService_GetProfile_FullMethodName           = "/stakeholders.StakeholdersService/GetProfile"
-	StakeholdersService_UpdateProfile_FullMethodName        = "/stakeholders.StakeholdersService/UpdateProfile"
-	StakeholdersService_SetPosition_FullMethodName          = "/stakeholders.StakeholdersService/SetPosition"
-	StakeholdersService_GetPosition_FullMethodName          = "/stakeholders.StakeholdersService/GetPosition"
-	StakeholdersService_ValidateToken_FullMethodName        = "/stakeholders.StakeholdersService/ValidateToken"
-	StakeholdersService_AddBalance_FullMethodName           = "/stakeholders.StakeholdersService/AddBalance"
-	StakeholdersService_SubtractBalance_FullMethodName      = "/stakeholders.StakeholdersService/SubtractBalance"
+	StakeholdersService_Register_FullMethodName                = "/stakeholders.StakeholdersService/Register"
+	StakeholdersService_Login_FullMethodName                   = "/stakeholders.StakeholdersService/Login"
+	StakeholdersService_GetAllUsers_FullMethodName             = "/stakeholders.StakeholdersService/GetAllUsers"
+	StakeholdersService_BlockUser_FullMethodName               = "/stakeholders.StakeholdersService/BlockUser"
+	StakeholdersService_GetProfileByUsername_FullMethodName    = "/stakeholders.StakeholdersService/GetProfileByUsername"
+	StakeholdersService_GetProfile_FullMethodName              = "/stakeholders.StakeholdersService/GetProfile"
+	StakeholdersService_UpdateProfile_FullMethodName           = "/stakeholders.StakeholdersService/UpdateProfile"
+	StakeholdersService_SetPosition_FullMethodName             = "/stakeholders.StakeholdersService/SetPosition"
+	StakeholdersService_GetPosition_FullMethodName             = "/stakeholders.StakeholdersService/GetPosition"
+	StakeholdersService_VerifyEmail_FullMethodName             = "/stakeholders.StakeholdersService/VerifyEmail"
+	StakeholdersService_ResendVerificationEmail_FullMethodName = "/stakeholders.StakeholdersService/ResendVerificationEmail"
+	StakeholdersService_RequestPasswordReset_FullMethodName    = "/stakeholders.StakeholdersService/RequestPasswordReset"
+	StakeholdersService_ResetPassword_FullMethodName           = "/stakeholders.StakeholdersService/ResetPassword"
+	StakeholdersService_ChangePassword_FullMethodName          = "/stakeholders.StakeholdersService/ChangePassword"
+	StakeholdersService_ValidateToken_FullMethodName           = "/stakeholders.StakeholdersService/ValidateToken"
+	StakeholdersService_AddBalance_FullMethodName              = "/stakeholders.StakeholdersService/AddBalance"
+	StakeholdersService_SubtractBalance_FullMethodName         = "/stakeholders.StakeholdersService/SubtractBalance"
+	StakeholdersService_CheckUsers_FullMethodName              = "/stakeholders.StakeholdersService/CheckUsers"
+	StakeholdersService_GetNearbyUsers_FullMethodName          = "/stakeholders.StakeholdersService/GetNearbyUsers"
+	StakeholdersService_GetUserContacts_FullMethodName         = "/stakeholders.StakeholdersService/GetUserContacts"
 )
 
 // StakeholdersServiceClient is the client API for StakeholdersService service.
@@ -47,9 +55,25 @@ type StakeholdersServiceClient interface {
 	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error)
 	SetPosition(ctx context.Context, in *PositionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetPosition(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PositionResponse, error)
+	// Javne rute za potvrdu email adrese i zaboravljenu lozinku. Odgovor na
+	// zahteve sa email adresom je isti bez obzira na to da li nalog postoji.
+	VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error)
+	ResendVerificationEmail(ctx context.Context, in *ResendVerificationEmailRequest, opts ...grpc.CallOption) (*ResendVerificationEmailResponse, error)
+	RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetResponse, error)
+	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error)
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
 	ValidateToken(ctx context.Context, in *ValidateTokenRequest, opts ...grpc.CallOption) (*ValidateTokenResponse, error)
 	AddBalance(ctx context.Context, in *UpdateBalanceRequest, opts ...grpc.CallOption) (*UpdateBalanceResponse, error)
 	SubtractBalance(ctx context.Context, in *UpdateBalanceRequest, opts ...grpc.CallOption) (*UpdateBalanceResponse, error)
+	// Interni poziv kojim drugi servisi proveravaju da korisnici postoje i da
+	// nisu blokirani.
+	CheckUsers(ctx context.Context, in *CheckUsersRequest, opts ...grpc.CallOption) (*CheckUsersResponse, error)
+	// Interni poziv za preporuke: korisnici cija je poslednja pozicija blizu
+	// pozicije datog korisnika.
+	GetNearbyUsers(ctx context.Context, in *GetNearbyUsersRequest, opts ...grpc.CallOption) (*GetNearbyUsersResponse, error)
+	// Interni poziv za slanje email-ova: adrese korisnika sa datim ID-jevima.
+	// Nepostojeci korisnici se izostavljaju iz odgovora.
+	GetUserContacts(ctx context.Context, in *GetUserContactsRequest, opts ...grpc.CallOption) (*GetUserContactsResponse, error)
 }
 
 type stakeholdersServiceClient struct {
@@ -150,6 +174,56 @@ func (c *stakeholdersServiceClient) GetPosition(ctx context.Context, in *emptypb
 	return out, nil
 }
 
+func (c *stakeholdersServiceClient) VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyEmailResponse)
+	err := c.cc.Invoke(ctx, StakeholdersService_VerifyEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stakeholdersServiceClient) ResendVerificationEmail(ctx context.Context, in *ResendVerificationEmailRequest, opts ...grpc.CallOption) (*ResendVerificationEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResendVerificationEmailResponse)
+	err := c.cc.Invoke(ctx, StakeholdersService_ResendVerificationEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stakeholdersServiceClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestPasswordResetResponse)
+	err := c.cc.Invoke(ctx, StakeholdersService_RequestPasswordReset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stakeholdersServiceClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetPasswordResponse)
+	err := c.cc.Invoke(ctx, StakeholdersService_ResetPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stakeholdersServiceClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangePasswordResponse)
+	err := c.cc.Invoke(ctx, StakeholdersService_ChangePassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *stakeholdersServiceClient) ValidateToken(ctx context.Context, in *ValidateTokenRequest, opts ...grpc.CallOption) (*ValidateTokenResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ValidateTokenResponse)
@@ -180,6 +254,36 @@ func (c *stakeholdersServiceClient) SubtractBalance(ctx context.Context, in *Upd
 	return out, nil
 }
 
+func (c *stakeholdersServiceClient) CheckUsers(ctx context.Context, in *CheckUsersRequest, opts ...grpc.CallOption) (*CheckUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckUsersResponse)
+	err := c.cc.Invoke(ctx, StakeholdersService_CheckUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stakeholdersServiceClient) GetNearbyUsers(ctx context.Context, in *GetNearbyUsersRequest, opts ...grpc.CallOption) (*GetNearbyUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNearbyUsersResponse)
+	err := c.cc.Invoke(ctx, StakeholdersService_GetNearbyUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stakeholdersServiceClient) GetUserContacts(ctx context.Context, in *GetUserContactsRequest, opts ...grpc.CallOption) (*GetUserContactsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserContactsResponse)
+	err := c.cc.Invoke(ctx, StakeholdersService_GetUserContacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StakeholdersServiceServer is the server API for StakeholdersService service.
 // All implementations must embed UnimplementedStakeholdersServiceServer
 // for forward compatibility.
@@ -193,9 +297,25 @@ type StakeholdersServiceServer interface {
 	UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error)
 	SetPosition(context.Context, *PositionRequest) (*emptypb.Empty, error)
 	GetPosition(context.Context, *emptypb.Empty) (*PositionResponse, error)
+	// Javne rute za potvrdu email adrese i zaboravljenu lozinku. Odgovor na
+	// zahteve sa email adresom je isti bez obzira na to da li nalog postoji.
+	VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error)
+	ResendVerificationEmail(context.Context, *ResendVerificationEmailRequest) (*ResendVerificationEmailResponse, error)
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetResponse, error)
+	ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error)
+	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
 	ValidateToken(context.Context, *ValidateTokenRequest) (*ValidateTokenResponse, error)
 	AddBalance(context.Context, *UpdateBalanceRequest) (*UpdateBalanceResponse, error)
 	SubtractBalance(context.Context, *UpdateBalanceRequest) (*UpdateBalanceResponse, error)
+	// Interni poziv kojim drugi servisi proveravaju da korisnici postoje i da
+	// nisu blokirani.
+	CheckUsers(context.Context, *CheckUsersRequest) (*CheckUsersResponse, error)
+	// Interni poziv za preporuke: korisnici cija je poslednja pozicija blizu
+	// pozicije datog korisnika.
+	GetNearbyUsers(context.Context, *GetNearbyUsersRequest) (*GetNearbyUsersResponse, error)
+	// Interni poziv za slanje email-ova: adrese korisnika sa datim ID-jevima.
+	// Nepostojeci korisnici se izostavljaju iz odgovora.
+	GetUserContacts(context.Context, *GetUserContactsRequest) (*GetUserContactsResponse, error)
 	mustEmbedUnimplementedStakeholdersServiceServer()
 }
 
@@ -233,6 +353,21 @@ func (UnimplementedStakeholdersServiceServer) SetPosition(context.Context, *Posi
 func (UnimplementedStakeholdersServiceServer) GetPosition(context.Context, *emptypb.Empty) (*PositionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPosition not implemented")
 }
+func (UnimplementedStakeholdersServiceServer) VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyEmail not implemented")
+}
+func (UnimplementedStakeholdersServiceServer) ResendVerificationEmail(context.Context, *ResendVerificationEmailRequest) (*ResendVerificationEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResendVerificationEmail not implemented")
+}
+func (UnimplementedStakeholdersServiceServer) RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (UnimplementedStakeholdersServiceServer) ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (UnimplementedStakeholdersServiceServer) ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
 func (UnimplementedStakeholdersServiceServer) ValidateToken(context.Context, *ValidateTokenRequest) (*ValidateTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidateToken not implemented")
 }
@@ -242,6 +377,15 @@ func (UnimplementedStakeholdersServiceServer) AddBalance(context.Context, *Updat
 func (UnimplementedStakeholdersServiceServer) SubtractBalance(context.Context, *UpdateBalanceRequest) (*UpdateBalanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubtractBalance not implemented")
 }
+func (UnimplementedStakeholdersServiceServer) CheckUsers(context.Context, *CheckUsersRequest) (*CheckUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckUsers not implemented")
+}
+func (UnimplementedStakeholdersServiceServer) GetNearbyUsers(context.Context, *GetNearbyUsersRequest) (*GetNearbyUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNearbyUsers not implemented")
+}
+func (UnimplementedStakeholdersServiceServer) GetUserContacts(context.Context, *GetUserContactsRequest) (*GetUserContactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserContacts not implemented")
+}
 func (UnimplementedStakeholdersServiceServer) mustEmbedUnimplementedStakeholdersServiceServer() {}
 func (UnimplementedStakeholdersServiceServer) testEmbeddedByValue()                             {}
 
@@ -425,6 +569,96 @@ func _StakeholdersService_GetPosition_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StakeholdersService_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StakeholdersServiceServer).VerifyEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StakeholdersService_VerifyEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StakeholdersServiceServer).VerifyEmail(ctx, req.(*VerifyEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StakeholdersService_ResendVerificationEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResendVerificationEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StakeholdersServiceServer).ResendVerificationEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StakeholdersService_ResendVerificationEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StakeholdersServiceServer).ResendVerificationEmail(ctx, req.(*ResendVerificationEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StakeholdersService_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StakeholdersServiceServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StakeholdersService_RequestPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StakeholdersServiceServer).RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StakeholdersService_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StakeholdersServiceServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StakeholdersService_ResetPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StakeholdersServiceServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StakeholdersService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StakeholdersServiceServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StakeholdersService_ChangePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StakeholdersServiceServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StakeholdersService_ValidateToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ValidateTokenRequest)
 	if err := dec(in); err != nil {
@@ -479,6 +713,60 @@ func _StakeholdersService_SubtractBalance_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StakeholdersService_CheckUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StakeholdersServiceServer).CheckUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StakeholdersService_CheckUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StakeholdersServiceServer).CheckUsers(ctx, req.(*CheckUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StakeholdersService_GetNearbyUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNearbyUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StakeholdersServiceServer).GetNearbyUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StakeholdersService_GetNearbyUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StakeholdersServiceServer).GetNearbyUsers(ctx, req.(*GetNearbyUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StakeholdersService_GetUserContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserContactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StakeholdersServiceServer).GetUserContacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StakeholdersService_GetUserContacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StakeholdersServiceServer).GetUserContacts(ctx, req.(*GetUserContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StakeholdersService_ServiceDesc is the grpc.ServiceDesc for StakeholdersService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -522,6 +810,26 @@ var StakeholdersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPosition",
 			Handler:    _StakeholdersService_GetPosition_Handler,
 		},
+		{
+			MethodName: "VerifyEmail",
+			Handler:    _StakeholdersService_VerifyEmail_Handler,
+		},
+		{
+			MethodName: "ResendVerificationEmail",
+			Handler:    _StakeholdersService_ResendVerificationEmail_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _StakeholdersService_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _StakeholdersService_ResetPassword_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _StakeholdersService_ChangePassword_Handler,
+		},
 		{
 			MethodName: "ValidateToken",
 			Handler:    _StakeholdersService_ValidateToken_Handler,
@@ -534,6 +842,18 @@ var StakeholdersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubtractBalance",
 			Handler:    _StakeholdersService_SubtractBalance_Handler,
 		},
+		{
+			MethodName: "CheckUsers",
+			Handler:    _StakeholdersService_CheckUsers_Handler,
+		},
+		{
+			MethodName: "GetNearbyUsers",
+			Handler:    _StakeholdersService_GetNearbyUsers_Handler,
+		},
+		{
+			MethodName: "GetUserContacts",
+			Handler:    _StakeholdersService_GetUserContacts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stakeholders/stakeholders.proto",
@@ -0,0 +1,142 @@
+package uploads
+
+import (
+	"context"
+	"log"
+	"shared/storage"
+	"shared/uploadgc"
+	"stakeholders-service/models"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Tracker evidentira uploade u kolekciji stakeholders.uploads.
+type Tracker struct {
+	collection *mongo.Collection
+}
+
+func NewTracker(mongoClient *mongo.Client) *Tracker {
+	return &Tracker{collection: mongoClient.Database("stakeholders").Collection("uploads")}
+}
+
+// Register upisuje novi upload bez reference.
+func (t *Tracker) Register(ctx context.Context, ownerID string, urls map[string]string) error {
+	key, keys, err := storage.KeysFromURLs(urls)
+	if err != nil {
+		return err
+	}
+
+	_, err = t.collection.InsertOne(ctx, models.Upload{
+		Key:       key,
+		OwnerID:   ownerID,
+		Keys:      keys,
+		CreatedAt: time.Now(),
+	})
+	return err
+}
+
+// Attach vezuje upload za entitet koji ga koristi. URL-ovi koji nisu u
+// storage-u (stari uploadi) se ignorisu.
+func (t *Tracker) Attach(ctx context.Context, url, refType, refID string) error {
+	key, ok := storage.KeyFromURL(url)
+	if !ok {
+		return nil
+	}
+	_, err := t.collection.UpdateByID(ctx, key, bson.M{
+		"$set":   bson.M{"ref_type": refType, "ref_id": refID},
+		"$unset": bson.M{"released_at": ""},
+	})
+	return err
+}
+
+// Release oslobadja upload kada korisnik promeni ili ukloni sliku. Fajlovi
+// ostaju do isteka grace perioda.
+func (t *Tracker) Release(ctx context.Context, url string) error {
+	key, ok := storage.KeyFromURL(url)
+	if !ok {
+		return nil
+	}
+	_, err := t.collection.UpdateByID(ctx, key, bson.M{
+		"$set": bson.M{"ref_type": "", "ref_id": "", "released_at": time.Now()},
+	})
+	return err
+}
+
+// Sweep pronalazi uploade bez reference starije od grace perioda i brise ih
+// zajedno sa svim varijantama. U DryRun modu samo vraca izvestaj.
+func (t *Tracker) Sweep(ctx context.Context, store storage.Storage, cfg uploadgc.Config) (*uploadgc.Report, error) {
+	report := &uploadgc.Report{DryRun: cfg.DryRun, Cutoff: time.Now().Add(-cfg.GracePeriod)}
+
+	filter := bson.M{
+		"ref_type": "",
+		"$or": []bson.M{
+			{"released_at": bson.M{"$lt": report.Cutoff}},
+			{"released_at": bson.M{"$exists": false}, "created_at": bson.M{"$lt": report.Cutoff}},
+		},
+	}
+	opts := options.Find().SetSort(bson.M{"created_at": 1}).SetLimit(int64(cfg.BatchSize))
+
+	cursor, err := t.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	var candidates []models.Upload
+	if err := cursor.All(ctx, &candidates); err != nil {
+		return nil, err
+	}
+
+	for _, upload := range candidates {
+		idleSince := upload.CreatedAt
+		if upload.ReleasedAt != nil {
+			idleSince = *upload.ReleasedAt
+		}
+		report.Add(uploadgc.Orphan{Key: upload.Key, OwnerID: upload.OwnerID, Keys: upload.Keys, IdleSince: idleSince})
+		if cfg.DryRun {
+			continue
+		}
+
+		// Zapis se brise uslovno, pa upload koji je u medjuvremenu vezan za
+		// profil ostaje netaknut
+		res, err := t.collection.DeleteOne(ctx, bson.M{"_id": upload.Key, "ref_type": ""})
+		if err != nil {
+			log.Printf("[upload-gc] failed to delete upload %s: %v", upload.Key, err)
+			report.Failed++
+			continue
+		}
+		if res.DeletedCount == 0 {
+			continue
+		}
+
+		if err := deleteKeys(ctx, store, upload.Keys); err != nil {
+			log.Printf("[upload-gc] failed to delete upload %s: %v", upload.Key, err)
+			report.Failed++
+			// Vracamo zapis da bi sledeci prolaz pokusao ponovo
+			if _, err := t.collection.InsertOne(ctx, upload); err != nil {
+				log.Printf("[upload-gc] failed to restore upload %s: %v", upload.Key, err)
+			}
+			continue
+		}
+		report.Deleted++
+	}
+
+	return report, nil
+}
+
+func deleteKeys(ctx context.Context, store storage.Storage, keys []string) error {
+	for _, key := range keys {
+		if err := store.Delete(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StartSweeper periodicno pokrece Sweep dok se kontekst ne otkaze.
+func (t *Tracker) StartSweeper(ctx context.Context, store storage.Storage, cfg uploadgc.Config) {
+	uploadgc.Start(ctx, "stakeholders-service", cfg, func(ctx context.Context) (*uploadgc.Report, error) {
+		return t.Sweep(ctx, store, cfg)
+	})
+}
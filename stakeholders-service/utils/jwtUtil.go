@@ -13,11 +13,13 @@ import (
 )
 
 func GenerateJWT(username string, role string, userId primitive.ObjectID) (string, error) {
+	now := time.Now()
 	claims := jwt.MapClaims{
 		"username": username,
 		"role":     role,
 		"userId":   userId,
-		"exp":      time.Now().Add(time.Hour * 24).Unix(),
+		"iat":      now.Unix(),
+		"exp":      now.Add(time.Hour * 24).Unix(),
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
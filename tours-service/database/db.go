@@ -2,8 +2,11 @@ package database
 
 import (
 	"log"
+	"shared/outbox"
+	"shared/uploads"
 	"tours-service/models"
 
+	"github.com/google/uuid"
 	"github.com/uptrace/opentelemetry-go-extra/otelgorm"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -15,18 +18,108 @@ func Connect(connStr string) {
 	if connStr == "" {
 		log.Fatal("TOUR_DATABASE_URL is not set")
 	}
-	db, err := gorm.Open(postgres.Open(connStr), &gorm.Config{})
+	db, err := gorm.Open(postgres.Open(connStr), &gorm.Config{TranslateError: true})
 	if err != nil {
 		log.Fatal("Failed to connect to database: ", err)
 	}
 
-	if err := db.AutoMigrate(&models.Tour{}, &models.KeyPoint{}, &models.Review{}, &models.ReviewImage{}, &models.TourExecution{}, &models.RequiredTime{}, &models.CompletedKeyPoint{}); err != nil {
+	removedReviews, err := dedupReviews(db)
+	if err != nil {
+		log.Fatal("Failed to remove duplicate reviews: ", err)
+	}
+	// Agregati ocena su dodati kada je vec bilo recenzija, pa se racunaju
+	// jednom za sve ture; isto vazi i kada su uklonjene duple recenzije
+	backfillRatings := removedReviews > 0 || !db.Migrator().HasColumn(&models.Tour{}, "RatingCount")
+
+	if err := db.AutoMigrate(&models.Tour{}, &models.KeyPoint{}, &models.Review{}, &models.ReviewImage{}, &models.TourExecution{}, &models.RequiredTime{}, &models.CompletedKeyPoint{}, &models.ReviewReport{}, &models.ReviewReply{}, &uploads.Upload{}, &outbox.Event{}, &models.Backfill{}); err != nil {
 		log.Fatal("Failed to migrate database: ", err)
 	}
 
+	if backfillRatings {
+		if err := backfillTourRatings(db); err != nil {
+			log.Fatal("Failed to backfill tour ratings: ", err)
+		}
+	}
+
 	if err := db.Use(otelgorm.NewPlugin()); err != nil {
 		log.Fatal("Failed to use otelgorm: ", err)
 	}
 
 	GORM_DB = db
 }
+
+// dedupReviews ostavlja samo poslednju recenziju svakog turiste za turu, da bi
+// se mogao napraviti jedinstveni indeks idx_review_tour_tourist. Pre tog
+// indeksa turista je mogao da oceni istu turu vise puta. Slike, odgovori i
+// prijave uklonjenih recenzija se brisu zajedno sa njima.
+func dedupReviews(db *gorm.DB) (int, error) {
+	if !db.Migrator().HasTable(&models.Review{}) || db.Migrator().HasIndex(&models.Review{}, "idx_review_tour_tourist") {
+		return 0, nil
+	}
+	// Kolona updated_at nastaje tek u AutoMigrate, pa je stare baze nemaju
+	order := "submission_date DESC, id DESC"
+	if db.Migrator().HasColumn(&models.Review{}, "UpdatedAt") {
+		order = "COALESCE(updated_at, submission_date) DESC, " + order
+	}
+	removed := 0
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var duplicates []uuid.UUID
+		err := tx.Raw(`
+			SELECT id FROM (
+				SELECT id, ROW_NUMBER() OVER (
+					PARTITION BY tour_id, tourist_id
+					ORDER BY ` + order + `
+				) AS rn
+				FROM reviews
+			) ranked
+			WHERE rn > 1`).Scan(&duplicates).Error
+		if err != nil || len(duplicates) == 0 {
+			return err
+		}
+
+		for _, child := range []any{&models.ReviewImage{}, &models.ReviewReply{}, &models.ReviewReport{}} {
+			if !tx.Migrator().HasTable(child) {
+				continue
+			}
+			if err := tx.Where("review_id IN ?", duplicates).Delete(child).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Where("id IN ?", duplicates).Delete(&models.Review{}).Error; err != nil {
+			return err
+		}
+		log.Printf("Removed %d duplicate reviews before creating idx_review_tour_tourist", len(duplicates))
+		removed = len(duplicates)
+		return nil
+	})
+	return removed, err
+}
+
+// backfillTourRatings racuna prosek, broj i histogram ocena svih tura iz
+// vidljivih recenzija, isto kao services.RefreshTourRating za jednu turu.
+func backfillTourRatings(db *gorm.DB) error {
+	res := db.Exec(`
+		UPDATE tours t SET
+			average_rating = COALESCE(r.average, 0),
+			rating_count = r.count,
+			rating_histogram = jsonb_build_object('1', r.c1, '2', r.c2, '3', r.c3, '4', r.c4, '5', r.c5)
+		FROM (
+			SELECT t2.id,
+				ROUND(AVG(rv.rating)::numeric, 2) AS average,
+				COUNT(rv.id) AS count,
+				COUNT(rv.id) FILTER (WHERE rv.rating = 1) AS c1,
+				COUNT(rv.id) FILTER (WHERE rv.rating = 2) AS c2,
+				COUNT(rv.id) FILTER (WHERE rv.rating = 3) AS c3,
+				COUNT(rv.id) FILTER (WHERE rv.rating = 4) AS c4,
+				COUNT(rv.id) FILTER (WHERE rv.rating = 5) AS c5
+			FROM tours t2
+			LEFT JOIN reviews rv ON rv.tour_id = t2.id AND rv.hidden = false
+			GROUP BY t2.id
+		) r
+		WHERE t.id = r.id`)
+	if res.Error != nil {
+		return res.Error
+	}
+	log.Printf("Backfilled rating aggregates for %d tours", res.RowsAffected)
+	return nil
+}
@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"shared/outbox"
+	"time"
+	"tours-service/database"
+	"tours-service/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	SubjectActivityImported     = "tours_activity_imported"
+	SubjectNotificationImported = "tours_notification_imported"
+
+	backfillTourActivity  = "tour_activity_events"
+	backfillNotifications = "notifications_to_notification_service"
+)
+
+// ImportedActivityEvent opisuje zavrsenu turu ili recenziju nastalu pre nego
+// sto je servis objavljivao dogadjaje. Poseban tip dogadjaja se koristi da
+// notification-service ne bi slao notifikacije za stare recenzije.
+type ImportedActivityEvent struct {
+	TourActivityEvent
+	Activity string    `json:"activity"` // completed ili reviewed
+	At       time.Time `json:"at"`
+}
+
+// BackfillTourActivity jednom objavljuje sve postojece zavrsene ture i
+// recenzije, da bi follower-service mogao da preporucuje korisnike i na
+// osnovu aktivnosti nastale pre dogadjaja. Dogadjaji i oznaka da je posao
+// zavrsen upisuju se u istoj transakciji.
+func BackfillTourActivity() error {
+	err := database.GORM_DB.First(&models.Backfill{}, "name = ?", backfillTourActivity).Error
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+
+	imported := 0
+	err = database.GORM_DB.Transaction(func(tx *gorm.DB) error {
+		var completed []models.TourExecution
+		if err := tx.Where("status = ?", models.StatusCompleted).Find(&completed).Error; err != nil {
+			return err
+		}
+		for _, execution := range completed {
+			if err := addImportedActivity(tx, "completed", execution.UserID, "", execution.TourID, execution.LastActivityAt); err != nil {
+				return err
+			}
+		}
+
+		var reviews []models.Review
+		if err := tx.Select("tour_id", "tourist_id", "username", "submission_date").Find(&reviews).Error; err != nil {
+			return err
+		}
+		for _, review := range reviews {
+			if err := addImportedActivity(tx, "reviewed", review.TouristID, review.Username, review.TourID, review.SubmissionDate); err != nil {
+				return err
+			}
+		}
+
+		imported = len(completed) + len(reviews)
+		return tx.Create(&models.Backfill{Name: backfillTourActivity, CompletedAt: time.Now()}).Error
+	})
+	if err != nil {
+		return err
+	}
+	log.Printf("Queued %d historical tour activity events", imported)
+	return nil
+}
+
+// addImportedActivity preskace aktivnosti na turama koje su u medjuvremenu
+// obrisane.
+func addImportedActivity(tx *gorm.DB, activity, userId, username string, tourID uuid.UUID, at time.Time) error {
+	event, err := tourActivity(tx, userId, username, tourID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return outbox.Add(tx, SubjectActivityImported, ImportedActivityEvent{TourActivityEvent: event, Activity: activity, At: at})
+}
+
+// ImportedNotificationEvent je notifikacija o odgovoru na recenziju koju je
+// tours-service cuvao pre nego sto je notifikacije preuzeo
+// notification-service. Prenose se i vreme nastanka i da li je procitana.
+type ImportedNotificationEvent struct {
+	ID            string    `json:"id"`
+	RecipientID   string    `json:"recipientId"`
+	Type          string    `json:"type"`
+	Message       string    `json:"message"`
+	ActorUsername string    `json:"actorUsername,omitempty"`
+	TourID        string    `json:"tourId"`
+	Read          bool      `json:"read"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+// BackfillNotifications jednom prenosi notifikacije iz stare tabele
+// notifications u notification-service i zatim brise tabelu. Dogadjaji,
+// brisanje tabele i oznaka da je posao zavrsen upisuju se u istoj
+// transakciji, pa se notifikacije ne gube ako servis padne usred prenosa.
+func BackfillNotifications() error {
+	if !database.GORM_DB.Migrator().HasTable("notifications") {
+		return nil
+	}
+
+	var rows []struct {
+		ID            uuid.UUID
+		RecipientID   string
+		Type          string
+		Message       string
+		TourID        uuid.UUID
+		Read          bool
+		CreatedAt     time.Time
+		ActorUsername string
+	}
+	err := database.GORM_DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Raw(`
+			SELECT n.id, n.recipient_id, n.type, n.message, n.tour_id, n.read, n.created_at,
+				COALESCE(rr.username, '') AS actor_username
+			FROM notifications n
+			LEFT JOIN review_replies rr ON rr.review_id = n.review_id`).Scan(&rows).Error
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			err := outbox.Add(tx, SubjectNotificationImported, ImportedNotificationEvent{
+				ID:            row.ID.String(),
+				RecipientID:   row.RecipientID,
+				Type:          row.Type,
+				Message:       row.Message,
+				ActorUsername: row.ActorUsername,
+				TourID:        row.TourID.String(),
+				Read:          row.Read,
+				CreatedAt:     row.CreatedAt,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if err := tx.Migrator().DropTable("notifications"); err != nil {
+			return err
+		}
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&models.Backfill{Name: backfillNotifications, CompletedAt: time.Now()}).Error
+	})
+	if err != nil {
+		return err
+	}
+	log.Printf("Queued %d notifications for notification-service", len(rows))
+	return nil
+}
@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"shared/outbox"
+	"tours-service/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	SubjectTourPublished      = "tours_tour_published"
+	SubjectTourArchived       = "tours_tour_archived"
+	SubjectExecutionStarted   = "tours_execution_started"
+	SubjectExecutionCompleted = "tours_execution_completed"
+	SubjectReviewCreated      = "tours_review_created"
+	SubjectReviewReplied      = "tours_review_replied"
+)
+
+// TourEvent se objavljuje kada autor objavi, arhivira ili ponovo objavi turu.
+type TourEvent struct {
+	TourID   string            `json:"tourId"`
+	AuthorID string            `json:"authorId"`
+	Name     string            `json:"name"`
+	Status   models.TourStatus `json:"status"`
+	Price    float32           `json:"price"`
+}
+
+// TourActivityEvent se objavljuje kada turista zapocne ili zavrsi turu.
+// Zavrsene ture koristi follower-service za preporuke; kupovine objavljuje
+// purchase-service.
+type TourActivityEvent struct {
+	UserID       string `json:"userId"`
+	Username     string `json:"username"`
+	TourID       string `json:"tourId"`
+	TourName     string `json:"tourName"`
+	TourAuthorID string `json:"tourAuthorId"`
+}
+
+// ReviewEvent ima ista polja kao TourActivityEvent, uz podatke o recenziji.
+type ReviewEvent struct {
+	TourActivityEvent
+	ReviewID string `json:"reviewId"`
+	Rating   int    `json:"rating"`
+}
+
+// ReviewReplyEvent se objavljuje kada autor ture odgovori na recenziju.
+type ReviewReplyEvent struct {
+	ReviewID  string `json:"reviewId"`
+	TourID    string `json:"tourId"`
+	TourName  string `json:"tourName"`
+	TouristID string `json:"touristId"`
+	GuideID   string `json:"guideId"`
+	Username  string `json:"username"`
+}
+
+func addTourEvent(tx *gorm.DB, subject string, tour models.Tour) error {
+	return outbox.Add(tx, subject, TourEvent{
+		TourID:   tour.ID.String(),
+		AuthorID: tour.UserID,
+		Name:     tour.Name,
+		Status:   tour.Status,
+		Price:    tour.Price,
+	})
+}
+
+func tourActivity(tx *gorm.DB, userId, username string, tourID uuid.UUID) (TourActivityEvent, error) {
+	var tour models.Tour
+	if err := tx.Select("id", "name", "user_id").First(&tour, "id = ?", tourID).Error; err != nil {
+		return TourActivityEvent{}, err
+	}
+	return TourActivityEvent{
+		UserID:       userId,
+		Username:     username,
+		TourID:       tourID.String(),
+		TourName:     tour.Name,
+		TourAuthorID: tour.UserID,
+	}, nil
+}
+
+func addTourActivityEvent(tx *gorm.DB, subject, userId, username string, tourID uuid.UUID) error {
+	event, err := tourActivity(tx, userId, username, tourID)
+	if err != nil {
+		return err
+	}
+	return outbox.Add(tx, subject, event)
+}
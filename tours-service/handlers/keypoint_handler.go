@@ -1,11 +1,9 @@
 package handlers
 
 import (
-	"fmt"
 	"log"
 	"net/http"
-	"path/filepath"
-	"time"
+	"shared/uploads"
 	"tours-service/database"
 	"tours-service/models"
 	"tours-service/services"
@@ -13,6 +11,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 func CreateKeyPoint(c *gin.Context) {
@@ -41,11 +40,13 @@ func CreateKeyPoint(c *gin.Context) {
 		return
 	}
 
-	filename := fmt.Sprintf("%d_%s", time.Now().UnixNano(), filepath.Base(file.Filename))
-	savePath := filepath.Join("static/uploads", filename)
-
-	if err := c.SaveUploadedFile(file, savePath); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save image"})
+	image, err := saveUploadedImage(c, file)
+	if err != nil {
+		code, msg := uploadErrorStatus(err)
+		if msg == "" {
+			msg = "Failed to save image"
+		}
+		c.JSON(code, gin.H{"error": msg})
 		return
 	}
 
@@ -54,16 +55,23 @@ func CreateKeyPoint(c *gin.Context) {
 	database.GORM_DB.Model(&models.KeyPoint{}).Where("tour_id = ?", tourID).Select("COALESCE(MAX(position), -1)").Row().Scan(&maxPosition)
 
 	keypoint := models.KeyPoint{
-		Name:        input.Name,
-		Description: input.Description,
-		Latitude:    input.Latitude,
-		Longitude:   input.Longitude,
-		ImagePath:   savePath,
-		TourID:      tourID,
-		Position:    maxPosition + 1,
-	}
-
-	if err := database.GORM_DB.Create(&keypoint).Error; err != nil {
+		Name:          input.Name,
+		Description:   input.Description,
+		Latitude:      input.Latitude,
+		Longitude:     input.Longitude,
+		ImagePath:     image.URL,
+		ImageVariants: image.Variants,
+		TourID:        tourID,
+		Position:      maxPosition + 1,
+	}
+
+	err = database.GORM_DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&keypoint).Error; err != nil {
+			return err
+		}
+		return uploads.Attach(tx, keypoint.ImagePath, models.UploadRefKeyPoint, keypoint.ID.String(), uploadOwnerID(c))
+	})
+	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save keypoint"})
 		return
 	}
@@ -108,6 +116,8 @@ func UpdateKeyPoint(c *gin.Context) {
 		return
 	}
 
+	previousImage := keyPointToUpdate.ImagePath
+
 	var input struct {
 		Name        string  `form:"name"`
 		Description string  `form:"description"`
@@ -122,15 +132,18 @@ func UpdateKeyPoint(c *gin.Context) {
 		keyPointToUpdate.Longitude = input.Longitude
 
 		if file, err := c.FormFile("image"); err == nil {
-			filename := fmt.Sprintf("%d_%s", time.Now().UnixNano(), filepath.Base(file.Filename))
-			savePath := filepath.Join("static/uploads", filename)
-
-			if err := c.SaveUploadedFile(file, savePath); err != nil {
-				c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save image"})
+			image, err := saveUploadedImage(c, file)
+			if err != nil {
+				code, msg := uploadErrorStatus(err)
+				if msg == "" {
+					msg = "Failed to save image"
+				}
+				c.JSON(code, gin.H{"error": msg})
 				return
 			}
 
-			keyPointToUpdate.ImagePath = savePath
+			keyPointToUpdate.ImagePath = image.URL
+			keyPointToUpdate.ImageVariants = image.Variants
 		}
 	} else {
 		var updatedKeyPoint models.KeyPoint
@@ -145,7 +158,19 @@ func UpdateKeyPoint(c *gin.Context) {
 		keyPointToUpdate.Longitude = updatedKeyPoint.Longitude
 	}
 
-	if err := database.GORM_DB.Save(&keyPointToUpdate).Error; err != nil {
+	err = database.GORM_DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&keyPointToUpdate).Error; err != nil {
+			return err
+		}
+		if keyPointToUpdate.ImagePath == previousImage {
+			return nil
+		}
+		if err := uploads.Release(tx, previousImage); err != nil {
+			return err
+		}
+		return uploads.Attach(tx, keyPointToUpdate.ImagePath, models.UploadRefKeyPoint, keyPointToUpdate.ID.String(), uploadOwnerID(c))
+	})
+	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update keypoint"})
 		return
 	}
@@ -174,7 +199,13 @@ func DeleteKeyPoint(c *gin.Context) {
 	}
 	tourID := keyPoint.TourID
 
-	if err := database.GORM_DB.Delete(&models.KeyPoint{}, keyPointID).Error; err != nil {
+	err = database.GORM_DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&models.KeyPoint{}, keyPointID).Error; err != nil {
+			return err
+		}
+		return uploads.Release(tx, keyPoint.ImagePath)
+	})
+	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete keypoint"})
 		return
 	}
@@ -1,18 +1,25 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
-	"path/filepath"
+	"shared/outbox"
+	"shared/uploads"
 	"time"
 	"tours-service/database"
 	"tours-service/models"
+	"tours-service/services"
 	"tours-service/utils"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
+// Recenzija se moze ostaviti samo za posetu koja nije starija od ovog perioda
+const reviewVisitWindow = 30 * 24 * time.Hour
+
 func CreateReview(c *gin.Context) {
 	claims, err := utils.GetClaimsFromGinContext2Args(c)
 	if err != nil {
@@ -60,6 +67,33 @@ func CreateReview(c *gin.Context) {
 		return
 	}
 
+	if code, msg := checkReviewEligibility(touristId, tourId, visitedDate); code != http.StatusOK {
+		c.JSON(code, gin.H{"error": msg})
+		return
+	}
+
+	// Slike se obradjuju pre upisa recenzije da neispravan fajl ne bi ostavio
+	// recenziju bez slika
+	var reviewImages []models.ReviewImage
+	if form, err := c.MultipartForm(); err == nil {
+		for _, file := range form.File["images"] {
+			image, err := saveUploadedImage(c, file)
+			if err != nil {
+				code, msg := uploadErrorStatus(err)
+				if msg == "" {
+					msg = "failed to save review image"
+				}
+				c.JSON(code, gin.H{"error": msg})
+				return
+			}
+
+			reviewImages = append(reviewImages, models.ReviewImage{
+				ImagePath:     image.URL,
+				ImageVariants: image.Variants,
+			})
+		}
+	}
+
 	review := models.Review{
 		TourID:         tourId,
 		TouristID:      touristId,
@@ -70,36 +104,43 @@ func CreateReview(c *gin.Context) {
 		VisitedDate:    visitedDate,
 	}
 
-	if err := database.GORM_DB.Create(&review).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to save review"})
-		return
-	}
-
-	form, _ := c.MultipartForm()
-	files := form.File["images"]
-
-	var reviewImages []models.ReviewImage
-	for _, file := range files {
-		filename := fmt.Sprintf("%d_%s", time.Now().UnixNano(), filepath.Base(file.Filename))
-
-		savePath := filepath.Join("static", "uploads", filename)
-		if err := c.SaveUploadedFile(file, savePath); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to save review image"})
-			return
+	err = database.GORM_DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&review).Error; err != nil {
+			return err
 		}
-		imagePath := "/uploads/" + filename
-
-		reviewImages = append(reviewImages, models.ReviewImage{
-			ImagePath: imagePath,
-			ReviewID:  review.ID,
+		if len(reviewImages) > 0 {
+			for i := range reviewImages {
+				reviewImages[i].ReviewID = review.ID
+			}
+			if err := tx.Create(&reviewImages).Error; err != nil {
+				return err
+			}
+			for _, image := range reviewImages {
+				if err := uploads.Attach(tx, image.ImagePath, models.UploadRefReviewImage, image.ID.String(), uploadOwnerID(c)); err != nil {
+					return err
+				}
+			}
+		}
+		if err := services.RefreshTourRating(tx, tourId); err != nil {
+			return err
+		}
+		activity, err := tourActivity(tx, touristId, username, tourId)
+		if err != nil {
+			return err
+		}
+		return outbox.Add(tx, SubjectReviewCreated, ReviewEvent{
+			TourActivityEvent: activity,
+			ReviewID:          review.ID.String(),
+			Rating:            review.Rating,
 		})
-	}
-
-	if len(reviewImages) > 0 {
-		if err := database.GORM_DB.Create(&reviewImages).Error; err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to save review images"})
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			c.JSON(http.StatusConflict, gin.H{"error": "you have already reviewed this tour"})
 			return
 		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to save review"})
+		return
 	}
 
 	c.JSON(http.StatusCreated, gin.H{
@@ -118,10 +159,348 @@ func GetReviewsByTourId(c *gin.Context) {
 	}
 
 	var reviews []models.Review
-	if err := database.GORM_DB.Where("tour_id = ?", tourId).Preload("ReviewImages").Find(&reviews).Error; err != nil {
+	if err := database.GORM_DB.Where("tour_id = ? AND hidden = ?", tourId, false).Preload("ReviewImages").Preload("Reply").Find(&reviews).Error; err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch reviews"})
 		return
 	}
 
 	c.JSON(http.StatusOK, reviews)
 }
+
+func UpdateReview(c *gin.Context) {
+	claims, err := utils.GetClaimsFromGinContext2Args(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	touristId, ok := claims["userId"].(string)
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid userId in token"})
+		return
+	}
+
+	reviewId, err := uuid.Parse(c.Param("reviewId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid review ID"})
+		return
+	}
+
+	var body struct {
+		Rating      int    `json:"rating"`
+		Comment     string `json:"comment"`
+		VisitedDate string `json:"visitedDate"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
+
+	if body.Rating < 1 || body.Rating > 5 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid rating format or value (1-5)"})
+		return
+	}
+
+	var review models.Review
+	if err := database.GORM_DB.First(&review, "id = ?", reviewId).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "review not found"})
+		return
+	}
+
+	if review.TouristID != touristId {
+		c.JSON(http.StatusForbidden, gin.H{"error": "you are not the author of this review"})
+		return
+	}
+
+	if body.VisitedDate != "" {
+		visitedDate, err := time.Parse("2006-01-02", body.VisitedDate)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid visited date format, use YYYY-MM-DD"})
+			return
+		}
+		if !visitedDate.Equal(review.VisitedDate) {
+			if msg := validateVisitedDate(visitedDate); msg != "" {
+				c.JSON(http.StatusBadRequest, gin.H{"error": msg})
+				return
+			}
+			review.VisitedDate = visitedDate
+		}
+	}
+
+	now := time.Now()
+	review.Rating = body.Rating
+	review.Comment = body.Comment
+	review.UpdatedAt = &now
+
+	err = database.GORM_DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&review).Error; err != nil {
+			return err
+		}
+		return services.RefreshTourRating(tx, review.TourID)
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update review"})
+		return
+	}
+
+	database.GORM_DB.Where("review_id = ?", review.ID).Find(&review.ReviewImages)
+
+	c.JSON(http.StatusOK, review)
+}
+
+func DeleteReview(c *gin.Context) {
+	claims, err := utils.GetClaimsFromGinContext2Args(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	touristId, ok := claims["userId"].(string)
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid userId in token"})
+		return
+	}
+
+	reviewId, err := uuid.Parse(c.Param("reviewId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid review ID"})
+		return
+	}
+
+	var review models.Review
+	if err := database.GORM_DB.First(&review, "id = ?", reviewId).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "review not found"})
+		return
+	}
+
+	if review.TouristID != touristId {
+		c.JSON(http.StatusForbidden, gin.H{"error": "you are not the author of this review"})
+		return
+	}
+
+	err = database.GORM_DB.Transaction(func(tx *gorm.DB) error {
+		var images []models.ReviewImage
+		if err := tx.Where("review_id = ?", review.ID).Find(&images).Error; err != nil {
+			return err
+		}
+		for _, image := range images {
+			if err := uploads.Release(tx, image.ImagePath); err != nil {
+				return err
+			}
+		}
+		if err := tx.Where("review_id = ?", review.ID).Delete(&models.ReviewImage{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("review_id = ?", review.ID).Delete(&models.ReviewReport{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("review_id = ?", review.ID).Delete(&models.ReviewReply{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&review).Error; err != nil {
+			return err
+		}
+		return services.RefreshTourRating(tx, review.TourID)
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to delete review"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Review deleted successfully"})
+}
+
+// checkReviewEligibility proverava da li turista sme da oceni turu i vraca
+// HTTP status zajedno sa porukom greske kada nema pravo na recenziju.
+func checkReviewEligibility(touristId string, tourId uuid.UUID, visitedDate time.Time) (int, string) {
+	if !IsTourAvailable(tourId) {
+		return http.StatusForbidden, "tour is not available for reviews"
+	}
+
+	if msg := validateVisitedDate(visitedDate); msg != "" {
+		return http.StatusBadRequest, msg
+	}
+
+	var existingCount int64
+	if err := database.GORM_DB.Model(&models.Review{}).Where("tour_id = ? AND tourist_id = ?", tourId, touristId).Count(&existingCount).Error; err != nil {
+		return http.StatusInternalServerError, "failed to check existing reviews"
+	}
+	if existingCount > 0 {
+		return http.StatusConflict, "you have already reviewed this tour"
+	}
+
+	purchased, err := purchaseClient.HasPurchasedTour(touristId, tourId.String())
+	if err != nil {
+		return http.StatusInternalServerError, "failed to verify purchase"
+	}
+	if !purchased {
+		return http.StatusForbidden, "you have not purchased this tour"
+	}
+
+	var progressCount int64
+	err = database.GORM_DB.Model(&models.TourExecution{}).
+		Joins("JOIN completed_key_points ON completed_key_points.tour_execution_id = tour_executions.id").
+		Where("tour_executions.user_id = ? AND tour_executions.tour_id = ?", touristId, tourId).
+		Count(&progressCount).Error
+	if err != nil {
+		return http.StatusInternalServerError, "failed to check tour executions"
+	}
+	if progressCount == 0 {
+		return http.StatusForbidden, "you must start the tour and reach at least one keypoint before reviewing it"
+	}
+
+	return http.StatusOK, ""
+}
+
+func validateVisitedDate(visitedDate time.Time) string {
+	now := time.Now()
+	if visitedDate.After(now) {
+		return "visited date cannot be in the future"
+	}
+	if now.Sub(visitedDate) > reviewVisitWindow {
+		return "visited date is too old, reviews are allowed only for visits in the last 30 days"
+	}
+	return ""
+}
+
+func ReportReview(c *gin.Context) {
+	claims, err := utils.GetClaimsFromGinContext2Args(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	reporterId, ok := claims["userId"].(string)
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid userId in token"})
+		return
+	}
+
+	reviewId, err := uuid.Parse(c.Param("reviewId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid review ID"})
+		return
+	}
+
+	var body struct {
+		Reason string `json:"reason" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "reason is required"})
+		return
+	}
+
+	var review models.Review
+	if err := database.GORM_DB.First(&review, "id = ?", reviewId).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "review not found"})
+		return
+	}
+
+	if review.TouristID == reporterId {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "you cannot report your own review"})
+		return
+	}
+
+	report := models.ReviewReport{
+		ReviewID:   review.ID,
+		ReporterID: reporterId,
+		Reason:     body.Reason,
+		Status:     models.ReportPending,
+	}
+
+	if err := database.GORM_DB.Create(&report).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			c.JSON(http.StatusConflict, gin.H{"error": "you have already reported this review"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to report review"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, report)
+}
+
+func GetReviewReports(c *gin.Context) {
+	if _, ok := requireAdmin(c); !ok {
+		return
+	}
+
+	reportStatus := c.DefaultQuery("status", string(models.ReportPending))
+
+	var reports []models.ReviewReport
+	if err := database.GORM_DB.Where("status = ?", reportStatus).Order("created_at asc").Find(&reports).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch review reports"})
+		return
+	}
+
+	c.JSON(http.StatusOK, reports)
+}
+
+func ModerateReview(c *gin.Context) {
+	adminId, ok := requireAdmin(c)
+	if !ok {
+		return
+	}
+
+	reviewId, err := uuid.Parse(c.Param("reviewId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid review ID"})
+		return
+	}
+
+	var body struct {
+		Hidden bool `json:"hidden"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
+
+	var review models.Review
+	if err := database.GORM_DB.First(&review, "id = ?", reviewId).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "review not found"})
+		return
+	}
+
+	reportStatus := models.ReportDismissed
+	if body.Hidden {
+		reportStatus = models.ReportResolved
+	}
+
+	err = database.GORM_DB.Transaction(func(tx *gorm.DB) error {
+		// Moderacija nije izmena recenzije, pa updated_at ostaje isti
+		if err := tx.Model(&review).UpdateColumn("hidden", body.Hidden).Error; err != nil {
+			return err
+		}
+		now := time.Now()
+		err := tx.Model(&models.ReviewReport{}).
+			Where("review_id = ? AND status = ?", review.ID, models.ReportPending).
+			Updates(map[string]any{"status": reportStatus, "resolved_at": now, "resolved_by": adminId}).Error
+		if err != nil {
+			return err
+		}
+		return services.RefreshTourRating(tx, review.TourID)
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to moderate review"})
+		return
+	}
+
+	c.JSON(http.StatusOK, review)
+}
+
+func requireAdmin(c *gin.Context) (string, bool) {
+	claims, err := utils.GetClaimsFromGinContext2Args(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return "", false
+	}
+
+	if role, _ := claims["role"].(string); role != "admin" {
+		c.JSON(http.StatusForbidden, gin.H{"error": "only admins can access this route"})
+		return "", false
+	}
+
+	userId, _ := claims["userId"].(string)
+	return userId, true
+}
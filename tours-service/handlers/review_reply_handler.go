@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"shared/outbox"
+	"time"
+	"tours-service/database"
+	"tours-service/models"
+	"tours-service/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+func CreateReviewReply(c *gin.Context) {
+	guideId, username, review, tour, text, ok := loadReplyContext(c)
+	if !ok {
+		return
+	}
+
+	reply := models.ReviewReply{
+		ReviewID: review.ID,
+		GuideID:  guideId,
+		Username: username,
+		Text:     text,
+	}
+
+	err := database.GORM_DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&reply).Error; err != nil {
+			return err
+		}
+		return outbox.Add(tx, SubjectReviewReplied, ReviewReplyEvent{
+			ReviewID:  review.ID.String(),
+			TourID:    tour.ID.String(),
+			TourName:  tour.Name,
+			TouristID: review.TouristID,
+			GuideID:   guideId,
+			Username:  username,
+		})
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			c.JSON(http.StatusConflict, gin.H{"error": "this review already has a reply"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to save reply"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, reply)
+}
+
+func UpdateReviewReply(c *gin.Context) {
+	guideId, _, review, _, text, ok := loadReplyContext(c)
+	if !ok {
+		return
+	}
+
+	var reply models.ReviewReply
+	if err := database.GORM_DB.First(&reply, "review_id = ?", review.ID).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "reply not found"})
+		return
+	}
+
+	if reply.GuideID != guideId {
+		c.JSON(http.StatusForbidden, gin.H{"error": "you are not the author of this reply"})
+		return
+	}
+
+	now := time.Now()
+	reply.Text = text
+	reply.UpdatedAt = &now
+
+	if err := database.GORM_DB.Save(&reply).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update reply"})
+		return
+	}
+
+	c.JSON(http.StatusOK, reply)
+}
+
+// loadReplyContext ucitava recenziju i turu na koju se odgovara i proverava da
+// je trenutni korisnik autor ture. U slucaju greske odgovor je vec poslat.
+func loadReplyContext(c *gin.Context) (string, string, models.Review, models.Tour, string, bool) {
+	var review models.Review
+	var tour models.Tour
+
+	claims, err := utils.GetClaimsFromGinContext2Args(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return "", "", review, tour, "", false
+	}
+
+	guideId, ok := claims["userId"].(string)
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid userId in token"})
+		return "", "", review, tour, "", false
+	}
+
+	username, ok := claims["username"].(string)
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid username in token"})
+		return "", "", review, tour, "", false
+	}
+
+	reviewId, err := uuid.Parse(c.Param("reviewId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid review ID"})
+		return "", "", review, tour, "", false
+	}
+
+	var body struct {
+		Text string `json:"text" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "text is required"})
+		return "", "", review, tour, "", false
+	}
+
+	if err := database.GORM_DB.First(&review, "id = ?", reviewId).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "review not found"})
+		return "", "", review, tour, "", false
+	}
+
+	if err := database.GORM_DB.First(&tour, "id = ?", review.TourID).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "tour not found"})
+		return "", "", review, tour, "", false
+	}
+
+	if tour.UserID != guideId {
+		c.JSON(http.StatusForbidden, gin.H{"error": "only the author of the tour can reply to its reviews"})
+		return "", "", review, tour, "", false
+	}
+
+	return guideId, username, review, tour, body.Text, true
+}
@@ -6,6 +6,7 @@ import (
 	"tours-service/database"
 	"tours-service/rest_clients"
 	"tours-service/models"
+	"tours-service/services"
 	"tours-service/utils"
 
 	"github.com/gin-gonic/gin"
@@ -74,7 +75,14 @@ func CreateTourExecution(c *gin.Context) {
 		LastActivityAt: time.Now(),
 	}
 
-	if err := database.GORM_DB.Create(&newExecution).Error; err != nil {
+	username, _ := claims["username"].(string)
+	err = database.GORM_DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&newExecution).Error; err != nil {
+			return err
+		}
+		return addTourActivityEvent(tx, SubjectExecutionStarted, userId, username, tourID)
+	})
+	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create tour execution"})
 		return
 	}
@@ -125,7 +133,17 @@ func UpdateTourExecutionStatus(c *gin.Context) {
 	execution.LastActivityAt = time.Now()
 	execution.Status = newStatus
 
-	if err := database.GORM_DB.Save(&execution).Error; err != nil {
+	username, _ := claims["username"].(string)
+	err = database.GORM_DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&execution).Error; err != nil {
+			return err
+		}
+		if newStatus != models.StatusCompleted {
+			return nil
+		}
+		return addTourActivityEvent(tx, SubjectExecutionCompleted, userId, username, execution.TourID)
+	})
+	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update tour execution"})
 		return
 	}
@@ -147,16 +165,56 @@ func GetAllMyTourExecutions(c *gin.Context) {
 	}
 
 	var executions []models.TourExecution
-	if err := database.GORM_DB.Where("user_id = ?", userId).Find(&executions).Error; err != nil {
+	if err := database.GORM_DB.Preload("CompletedKeyPoints").Where("user_id = ?", userId).Order("created_at desc").Find(&executions).Error; err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch executions"})
 		return
 	}
 
-	c.JSON(http.StatusOK, executions)
+	history, err := services.BuildTourExecutionHistory(executions)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to build execution history"})
+		return
+	}
+
+	c.JSON(http.StatusOK, history)
+}
+
+func GetTourExecutionStats(c *gin.Context) {
+	claims, err := utils.GetClaimsFromGinContext2Args(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+	userId, _ := claims["userId"].(string)
+
+	tourID, err := uuid.Parse(c.Param("tourId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid tour ID"})
+		return
+	}
+
+	var tour models.Tour
+	if err := database.GORM_DB.First(&tour, "id = ?", tourID).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "tour not found"})
+		return
+	}
+
+	if tour.UserID != userId {
+		c.JSON(http.StatusForbidden, gin.H{"error": "you are not the author of this tour"})
+		return
+	}
+
+	stats, err := services.CalculateTourExecutionStats(tourID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to calculate tour statistics"})
+		return
+	}
+
+	c.JSON(http.StatusOK, stats)
 }
 
 func CheckTourLocation(c *gin.Context) {
-	_, err := utils.GetClaimsFromGinContext2Args(c)
+	claims, err := utils.GetClaimsFromGinContext2Args(c)
 	if err != nil {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
 		return
@@ -215,7 +273,14 @@ func CheckTourLocation(c *gin.Context) {
 	execution.LastActivityAt = time.Now()
 	if len(execution.CompletedKeyPoints) == len(checkpoints) && execution.Status != models.StatusCompleted {
 		execution.Status = models.StatusCompleted
-		if err := database.GORM_DB.Save(&execution).Error; err != nil {
+		username, _ := claims["username"].(string)
+		err := database.GORM_DB.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Save(&execution).Error; err != nil {
+				return err
+			}
+			return addTourActivityEvent(tx, SubjectExecutionCompleted, execution.UserID, username, execution.TourID)
+		})
+		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update execution status"})
 			return
 		}
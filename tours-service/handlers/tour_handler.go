@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"log"
 	"net/http"
-	"path/filepath"
+	"shared/uploads"
+	"strconv"
+	"strings"
 	"time"
 	"tours-service/database"
 	"tours-service/models"
@@ -18,6 +20,7 @@ import (
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 	"gorm.io/datatypes"
+	"gorm.io/gorm"
 )
 
 func CreateTour(c *gin.Context) {
@@ -94,27 +97,40 @@ func CreateTour(c *gin.Context) {
 			continue
 		}
 
-		var imagePath string
-		filename := fmt.Sprintf("%d_%s", time.Now().UnixNano(), filepath.Base(file.Filename))
-		savePath := filepath.Join("static/uploads", filename)
-		if err := c.SaveUploadedFile(file, savePath); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to save keypoint image"})
+		image, err := saveUploadedImage(c, file)
+		if err != nil {
+			code, msg := uploadErrorStatus(err)
+			if msg == "" {
+				msg = "failed to save keypoint image"
+			}
+			c.JSON(code, gin.H{"error": msg})
 			return
 		}
-		imagePath = "/" + savePath
 
 		keypoints = append(keypoints, models.KeyPoint{
-			Name:        kp.Name,
-			Description: kp.Description,
-			Latitude:    *kp.Latitude,
-			Longitude:   *kp.Longitude,
-			ImagePath:   imagePath,
-			TourID:      tour.ID,
+			Name:          kp.Name,
+			Description:   kp.Description,
+			Latitude:      *kp.Latitude,
+			Longitude:     *kp.Longitude,
+			ImagePath:     image.URL,
+			ImageVariants: image.Variants,
+			TourID:        tour.ID,
 		})
 	}
 
 	if len(keypoints) > 0 {
-		if err := database.GORM_DB.Create(&keypoints).Error; err != nil {
+		err := database.GORM_DB.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Create(&keypoints).Error; err != nil {
+				return err
+			}
+			for _, kp := range keypoints {
+				if err := uploads.Attach(tx, kp.ImagePath, models.UploadRefKeyPoint, kp.ID.String(), uploadOwnerID(c)); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to save keypoints"})
 			return
 		}
@@ -165,7 +181,29 @@ func GetAllTours(c *gin.Context) {
 func GetAllPublishedTours(c *gin.Context) {
 	var tours []models.Tour
 
-	if err := database.GORM_DB.Where("status = ?", models.Published).Find(&tours).Error; err != nil {
+	query := database.GORM_DB.Where("status = ?", models.Published)
+
+	if minRatingStr := c.Query("minRating"); minRatingStr != "" {
+		minRating, err := strconv.ParseFloat(minRatingStr, 64)
+		if err != nil || minRating < 0 || minRating > 5 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid minRating, expected a number between 0 and 5"})
+			return
+		}
+		query = query.Where("average_rating >= ?", minRating)
+	}
+
+	switch c.Query("sort") {
+	case "":
+	case "rating":
+		query = query.Order("average_rating DESC").Order("rating_count DESC")
+	case "ratingCount":
+		query = query.Order("rating_count DESC").Order("average_rating DESC")
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid sort, use rating or ratingCount"})
+		return
+	}
+
+	if err := query.Find(&tours).Error; err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch published tours"})
 		return
 	}
@@ -173,6 +211,57 @@ func GetAllPublishedTours(c *gin.Context) {
 	c.JSON(http.StatusOK, tours)
 }
 
+// maxSummaryIDs ogranicava broj tura u jednom zahtevu za sazetke
+const maxSummaryIDs = 100
+
+// TourSummary je skraceni prikaz ture koji drugi servisi ugradjuju u svoje
+// odgovore (npr. blog postovi o turi).
+type TourSummary struct {
+	ID            uuid.UUID             `json:"id"`
+	Name          string                `json:"name"`
+	Difficulty    models.TourDifficulty `json:"difficulty"`
+	AverageRating float64               `json:"averageRating"`
+	RatingCount   int                   `json:"ratingCount"`
+}
+
+// GetTourSummaries vraca sazetke objavljenih i arhiviranih tura za zadate
+// ID-jeve (?ids=id1,id2). Nepostojece ture i draftovi se izostavljaju.
+func GetTourSummaries(c *gin.Context) {
+	var ids []uuid.UUID
+	for _, raw := range strings.Split(c.Query("ids"), ",") {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid tour id: " + raw})
+			return
+		}
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		c.JSON(http.StatusOK, []TourSummary{})
+		return
+	}
+	if len(ids) > maxSummaryIDs {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("at most %d tour ids are allowed", maxSummaryIDs)})
+		return
+	}
+
+	summaries := []TourSummary{}
+	err := database.GORM_DB.Model(&models.Tour{}).
+		Select("id", "name", "difficulty", "average_rating", "rating_count").
+		Where("id IN ? AND status IN ?", ids, []models.TourStatus{models.Published, models.Archived}).
+		Find(&summaries).Error
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch tour summaries"})
+		return
+	}
+
+	c.JSON(http.StatusOK, summaries)
+}
+
 func IsTourAvailable(id uuid.UUID) bool {
 	var tour models.Tour
 	if err := database.GORM_DB.First(&tour, "id = ?", id).Error; err != nil {
@@ -276,7 +365,13 @@ func PublishTour(c *gin.Context) {
 	now := time.Now()
 	tour.PublishedAt = &now
 
-	if err := database.GORM_DB.Save(&tour).Error; err != nil {
+	err = database.GORM_DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&tour).Error; err != nil {
+			return err
+		}
+		return addTourEvent(tx, SubjectTourPublished, tour)
+	})
+	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to publish tour"})
 		return
 	}
@@ -320,7 +415,13 @@ func ArchiveTour(c *gin.Context) {
 	now := time.Now()
 	tour.ArchivedAt = &now
 
-	if err := database.GORM_DB.Save(&tour).Error; err != nil {
+	err = database.GORM_DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&tour).Error; err != nil {
+			return err
+		}
+		return addTourEvent(tx, SubjectTourArchived, tour)
+	})
+	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to archive tour"})
 		return
 	}
@@ -363,7 +464,13 @@ func UnarchiveTour(c *gin.Context) {
 	now := time.Now()
 	tour.PublishedAt = &now
 
-	if err := database.GORM_DB.Save(&tour).Error; err != nil {
+	err = database.GORM_DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&tour).Error; err != nil {
+			return err
+		}
+		return addTourEvent(tx, SubjectTourPublished, tour)
+	})
+	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unarchive tour"})
 		return
 	}
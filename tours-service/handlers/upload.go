@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"shared/imaging"
+	"shared/storage"
+	"shared/uploads"
+	"tours-service/database"
+	"tours-service/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+var fileStorage storage.Storage
+
+func InitStorage(s storage.Storage) {
+	fileStorage = s
+}
+
+type uploadedImage struct {
+	URL      string
+	Variants datatypes.JSON
+}
+
+// saveUploadedImage proverava da je fajl zaista slika, uklanja EXIF podatke i
+// cuva sve varijante u storage pod kljucem tours/<uuid>. Vraca URL originala i
+// URL-ove svih varijanti. Upload se evidentira bez reference dok ga handler ne
+// veze za entitet.
+func saveUploadedImage(c *gin.Context, file *multipart.FileHeader) (*uploadedImage, error) {
+	src, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer src.Close()
+
+	data, err := imaging.ReadUpload(src)
+	if err != nil {
+		return nil, err
+	}
+
+	urls, err := imaging.SaveVariants(c.Request.Context(), fileStorage, "tours/"+uuid.New().String(), data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uploads.Register(database.GORM_DB, uploadOwnerID(c), urls); err != nil {
+		for _, url := range urls {
+			if key, ok := storage.KeyFromURL(url); ok {
+				fileStorage.Delete(c.Request.Context(), key)
+			}
+		}
+		return nil, err
+	}
+
+	variants, err := json.Marshal(urls)
+	if err != nil {
+		return nil, err
+	}
+
+	return &uploadedImage{URL: urls["original"], Variants: datatypes.JSON(variants)}, nil
+}
+
+// uploadOwnerID vraca ID prijavljenog korisnika koji uploaduje sliku. Isti ID
+// se prosledjuje uploads.Attach, pa korisnik moze da veze samo svoje uploade.
+func uploadOwnerID(c *gin.Context) string {
+	claims, err := utils.GetClaimsFromGinContext2Args(c)
+	if err != nil {
+		return ""
+	}
+	ownerID, _ := claims["userId"].(string)
+	return ownerID
+}
+
+// uploadErrorStatus razlikuje neispravne slike (400) od gresaka servera (500).
+func uploadErrorStatus(err error) (int, string) {
+	if errors.Is(err, imaging.ErrInvalidImage) {
+		return http.StatusBadRequest, err.Error()
+	}
+	return http.StatusInternalServerError, ""
+}
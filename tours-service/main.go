@@ -4,6 +4,11 @@ import (
 	"context"
 	"log"
 	"os"
+	"shared/events"
+	"shared/outbox"
+	"shared/storage"
+	"shared/uploadgc"
+	"shared/uploads"
 	"tours-service/database"
 	"tours-service/handlers"
 	"tours-service/opentelemetery"
@@ -44,9 +49,31 @@ func main() {
 	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
 
 	database.Connect(connStr)
+	if err := handlers.BackfillTourActivity(); err != nil {
+		log.Fatal("Failed to backfill tour activity events: ", err)
+	}
+	if err := handlers.BackfillNotifications(); err != nil {
+		log.Fatal("Failed to move notifications to notification-service: ", err)
+	}
 
 	handlers.InitPurchaseClient("http://purchase-service:8088")
 
+	// Dogadjaji se upisuju u outbox i bez NATS-a, a relay ih objavljuje kada
+	// se veza uspostavi
+	natsConn, err := events.Connect(os.Getenv("NATS_URL"), "tours-service")
+	if err != nil {
+		log.Fatalf("Failed to configure NATS connection: %v", err)
+	}
+	defer natsConn.Close()
+	outbox.StartRelay(context.Background(), "tours-service", database.GORM_DB, natsConn, outbox.ConfigFromEnv())
+
+	fileStorage, err := storage.NewFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to initialize file storage: %v", err)
+	}
+	handlers.InitStorage(fileStorage)
+	uploads.StartSweeper(context.Background(), "tours-service", database.GORM_DB, fileStorage, uploadgc.ConfigFromEnv())
+
 	r := gin.Default()
 
 	r.Static("/uploads", "./static/uploads")
@@ -66,9 +93,11 @@ func main() {
 	api.GET("/tours", handlers.GetAllTours)
 
 	api.GET("/tours/published", handlers.GetAllPublishedTours)
+	api.GET("/tours/summaries", handlers.GetTourSummaries)
 	api.PATCH("/tours/:tourId/publish", handlers.PublishTour)
 	api.PATCH("/tours/:tourId/archive", handlers.ArchiveTour)
 	api.PATCH("/tours/:tourId/unarchive", handlers.UnarchiveTour)
+	api.GET("/tours/:tourId/execution-stats", handlers.GetTourExecutionStats)
 
 	api.POST("/keypoints", handlers.CreateKeyPoint)
 	api.GET("/tours/:tourId/keypoints", handlers.GetKeyPointsByTourId)
@@ -78,16 +107,22 @@ func main() {
 	api.POST("/tours/:tourId/required-times", handlers.CreateRequiredTime)
 
 	api.POST("/reviews", handlers.CreateReview)
+	api.PUT("/reviews/:reviewId", handlers.UpdateReview)
+	api.DELETE("/reviews/:reviewId", handlers.DeleteReview)
+	api.POST("/reviews/:reviewId/report", handlers.ReportReview)
+	api.POST("/reviews/:reviewId/reply", handlers.CreateReviewReply)
+	api.PUT("/reviews/:reviewId/reply", handlers.UpdateReviewReply)
+	api.GET("/admin/review-reports", handlers.GetReviewReports)
+	api.PATCH("/admin/reviews/:reviewId/moderation", handlers.ModerateReview)
 
 	api.GET("/tours/:tourId/reviews", handlers.GetReviewsByTourId)
 
 	api.POST("/tours/:tourId/start", handlers.CreateTourExecution)
 	api.PATCH("/tour-executions/:tourExecutionId/status", handlers.UpdateTourExecutionStatus)
+	api.GET("/tour-executions", handlers.GetAllMyTourExecutions)
 	api.GET("/tour-executions/active", handlers.GetActiveTourExecution)
 	api.POST("/tour-executions/:tourExecutionId/check-location", handlers.CheckTourLocation)
 
-
-
 	//localhost = "tours-service"
 	localhost = "localhost"
 	_ = localhost
@@ -0,0 +1,10 @@
+package models
+
+import "time"
+
+// Backfill belezi jednokratne poslove nad postojecim podacima koji su vec
+// izvrseni, da se ne bi ponavljali pri svakom pokretanju servisa.
+type Backfill struct {
+	Name        string    `gorm:"type:varchar(100);primaryKey" json:"name"`
+	CompletedAt time.Time `gorm:"not null" json:"completedAt"`
+}
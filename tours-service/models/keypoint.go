@@ -1,14 +1,18 @@
 package models
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
 
 type KeyPoint struct {
-	ID          uuid.UUID `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
-	Name        string    `json:"name"`
-	Description string    `json:"description"`
-	Latitude    float64   `json:"latitude"`
-	Longitude   float64   `json:"longitude"`
-	ImagePath   string    `json:"imagePath"`
-	TourID      uuid.UUID `json:"tourId"`
-	Position    int       `gorm:"nullable;default:0"`
+	ID            uuid.UUID      `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	Name          string         `json:"name"`
+	Description   string         `json:"description"`
+	Latitude      float64        `json:"latitude"`
+	Longitude     float64        `json:"longitude"`
+	ImagePath     string         `json:"imagePath"`
+	ImageVariants datatypes.JSON `gorm:"type:jsonb" json:"imageVariants"`
+	TourID        uuid.UUID      `json:"tourId"`
+	Position      int            `gorm:"nullable;default:0"`
 }
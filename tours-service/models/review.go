@@ -8,12 +8,15 @@ import (
 
 type Review struct {
 	ID             uuid.UUID     `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
-	TourID         uuid.UUID     `json:"tourId" gorm:"not null"`
-	TouristID      string        `json:"touristId" gorm:"type:varchar(24);not null"`
+	TourID         uuid.UUID     `json:"tourId" gorm:"not null;uniqueIndex:idx_review_tour_tourist"`
+	TouristID      string        `json:"touristId" gorm:"type:varchar(24);not null;uniqueIndex:idx_review_tour_tourist"`
 	Username       string        `json:"username" gorm:"type:varchar(255);not null"`
 	Rating         int           `json:"rating" gorm:"not null"`
 	Comment        string        `json:"comment"`
 	SubmissionDate time.Time     `json:"submissionDate" gorm:"not null"`
 	VisitedDate    time.Time     `json:"visitedDate"`
+	UpdatedAt      *time.Time    `json:"updatedAt"`
+	Hidden         bool          `json:"hidden" gorm:"not null;default:false"`
 	ReviewImages   []ReviewImage `gorm:"foreignKey:ReviewID"`
+	Reply          *ReviewReply  `gorm:"foreignKey:ReviewID" json:"reply"`
 }
@@ -1,9 +1,13 @@
 package models
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
 
 type ReviewImage struct {
-	ID        uuid.UUID `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
-	ImagePath string    `json:"imagePath"`
-	ReviewID  uuid.UUID `json:"reviewId" gorm:"not null"`
+	ID            uuid.UUID      `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	ImagePath     string         `json:"imagePath"`
+	ImageVariants datatypes.JSON `gorm:"type:jsonb" json:"imageVariants"`
+	ReviewID      uuid.UUID      `json:"reviewId" gorm:"not null"`
 }
@@ -0,0 +1,17 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ReviewReply struct {
+	ID        uuid.UUID  `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	ReviewID  uuid.UUID  `json:"reviewId" gorm:"type:uuid;not null;uniqueIndex"`
+	GuideID   string     `json:"guideId" gorm:"type:varchar(24);not null"`
+	Username  string     `json:"username" gorm:"type:varchar(255);not null"`
+	Text      string     `json:"text" gorm:"type:text;not null"`
+	CreatedAt time.Time  `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt *time.Time `json:"updatedAt"`
+}
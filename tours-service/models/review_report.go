@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ReviewReportStatus string
+
+const (
+	ReportPending   ReviewReportStatus = "pending"
+	ReportResolved  ReviewReportStatus = "resolved"
+	ReportDismissed ReviewReportStatus = "dismissed"
+)
+
+type ReviewReport struct {
+	ID         uuid.UUID          `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	ReviewID   uuid.UUID          `json:"reviewId" gorm:"type:uuid;not null;uniqueIndex:idx_report_review_reporter"`
+	ReporterID string             `json:"reporterId" gorm:"type:varchar(24);not null;uniqueIndex:idx_report_review_reporter"`
+	Reason     string             `json:"reason" gorm:"type:text;not null"`
+	Status     ReviewReportStatus `json:"status" gorm:"type:varchar(20);not null;default:'pending';index"`
+	CreatedAt  time.Time          `json:"createdAt" gorm:"autoCreateTime"`
+	ResolvedAt *time.Time         `json:"resolvedAt"`
+	ResolvedBy string             `json:"resolvedBy" gorm:"type:varchar(24)"`
+}
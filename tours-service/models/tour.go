@@ -9,16 +9,19 @@ import (
 )
 
 type Tour struct {
-	ID             uuid.UUID          `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
-	UserID         string             `gorm:"type:varchar(24);not null;column:user_id"`
-	Name           string             `json:"name"`
-	Description    string             `json:"description"`
-	Difficulty     TourDifficulty     `json:"difficulty"`
-	Tags           datatypes.JSON     `gorm:"type:jsonb" json:"tags"`
-	Status         TourStatus         `json:"status"`
-	Price          float32            `json:"price"`
-	Distance       float64            `json:"distance"`
-	PublishedAt    *time.Time         `json:"publishedAt"`
-	ArchivedAt     *time.Time         `json:"archivedAt"`
-	Transportation TransportationType `json:"transportation"`
+	ID              uuid.UUID          `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	UserID          string             `gorm:"type:varchar(24);not null;column:user_id"`
+	Name            string             `json:"name"`
+	Description     string             `json:"description"`
+	Difficulty      TourDifficulty     `json:"difficulty"`
+	Tags            datatypes.JSON     `gorm:"type:jsonb" json:"tags"`
+	Status          TourStatus         `json:"status"`
+	Price           float32            `json:"price"`
+	Distance        float64            `json:"distance"`
+	PublishedAt     *time.Time         `json:"publishedAt"`
+	ArchivedAt      *time.Time         `json:"archivedAt"`
+	Transportation  TransportationType `json:"transportation"`
+	AverageRating   float64            `gorm:"not null;default:0" json:"averageRating"`
+	RatingCount     int                `gorm:"not null;default:0" json:"ratingCount"`
+	RatingHistogram datatypes.JSON     `gorm:"type:jsonb" json:"ratingHistogram"`
 }
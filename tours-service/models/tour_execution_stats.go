@@ -0,0 +1,40 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type KeyPointProgress struct {
+	KeyPointID  uuid.UUID  `json:"keyPointId"`
+	Name        string     `json:"name"`
+	Position    int        `json:"position"`
+	CompletedAt *time.Time `json:"completedAt"`
+}
+
+type TourExecutionHistory struct {
+	ID                   uuid.UUID           `json:"id"`
+	TourID               uuid.UUID           `json:"tourId"`
+	TourName             string              `json:"tourName"`
+	Status               TourExecutionStatus `json:"status"`
+	StartedAt            time.Time           `json:"startedAt"`
+	LastActivityAt       time.Time           `json:"lastActivityAt"`
+	CompletionPercentage float64             `json:"completionPercentage"`
+	TimeSpentMinutes     float64             `json:"timeSpentMinutes"`
+	DistanceCoveredKm    float64             `json:"distanceCoveredKm"`
+	KeyPoints            []KeyPointProgress  `json:"keyPoints"`
+}
+
+type TourExecutionStats struct {
+	TourID                  uuid.UUID  `json:"tourId"`
+	Starts                  int        `json:"starts"`
+	InProgress              int        `json:"inProgress"`
+	Completions             int        `json:"completions"`
+	Abandonments            int        `json:"abandonments"`
+	AbandonmentRate         float64    `json:"abandonmentRate"`
+	MedianCompletionMinutes float64    `json:"medianCompletionMinutes"`
+	DropOffKeyPointID       *uuid.UUID `json:"dropOffKeyPointId"`
+	DropOffKeyPointName     string     `json:"dropOffKeyPointName"`
+	DropOffAbandonments     int        `json:"dropOffAbandonments"`
+}
@@ -0,0 +1,7 @@
+package models
+
+// Tipovi entiteta za koje se vezuju uploadi (shared/uploads).
+const (
+	UploadRefKeyPoint    = "keypoint"
+	UploadRefReviewImage = "review_image"
+)
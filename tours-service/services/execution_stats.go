@@ -0,0 +1,173 @@
+package services
+
+import (
+	"math"
+	"sort"
+	"tours-service/database"
+	"tours-service/models"
+
+	"github.com/google/uuid"
+)
+
+func BuildTourExecutionHistory(executions []models.TourExecution) ([]models.TourExecutionHistory, error) {
+	history := make([]models.TourExecutionHistory, 0, len(executions))
+	if len(executions) == 0 {
+		return history, nil
+	}
+
+	tourIDs := make([]uuid.UUID, 0, len(executions))
+	for _, execution := range executions {
+		tourIDs = append(tourIDs, execution.TourID)
+	}
+
+	var tours []models.Tour
+	if err := database.GORM_DB.Where("id IN ?", tourIDs).Find(&tours).Error; err != nil {
+		return nil, err
+	}
+	tourNames := make(map[uuid.UUID]string, len(tours))
+	for _, tour := range tours {
+		tourNames[tour.ID] = tour.Name
+	}
+
+	var keypoints []models.KeyPoint
+	if err := database.GORM_DB.Where("tour_id IN ?", tourIDs).Order("position asc").Find(&keypoints).Error; err != nil {
+		return nil, err
+	}
+	keypointsByTour := make(map[uuid.UUID][]models.KeyPoint)
+	for _, kp := range keypoints {
+		keypointsByTour[kp.TourID] = append(keypointsByTour[kp.TourID], kp)
+	}
+
+	for _, execution := range executions {
+		history = append(history, buildExecutionHistoryItem(execution, tourNames[execution.TourID], keypointsByTour[execution.TourID]))
+	}
+
+	return history, nil
+}
+
+func buildExecutionHistoryItem(execution models.TourExecution, tourName string, keypoints []models.KeyPoint) models.TourExecutionHistory {
+	completedAt := make(map[uuid.UUID]models.CompletedKeyPoint, len(execution.CompletedKeyPoints))
+	for _, ckp := range execution.CompletedKeyPoints {
+		completedAt[ckp.KeyPointID] = ckp
+	}
+
+	progress := make([]models.KeyPointProgress, 0, len(keypoints))
+	var visited []models.KeyPoint
+	for _, kp := range keypoints {
+		item := models.KeyPointProgress{
+			KeyPointID: kp.ID,
+			Name:       kp.Name,
+			Position:   kp.Position,
+		}
+		if ckp, ok := completedAt[kp.ID]; ok {
+			t := ckp.CompletedAt
+			item.CompletedAt = &t
+			visited = append(visited, kp)
+		}
+		progress = append(progress, item)
+	}
+
+	// Udaljenost se racuna redosledom kojim je turista stvarno obilazio tacke
+	sort.SliceStable(visited, func(i, j int) bool {
+		return completedAt[visited[i].ID].CompletedAt.Before(completedAt[visited[j].ID].CompletedAt)
+	})
+	var distance float64
+	for i := 0; i < len(visited)-1; i++ {
+		distance += haversineDistance(visited[i].Latitude, visited[i].Longitude, visited[i+1].Latitude, visited[i+1].Longitude)
+	}
+
+	var percentage float64
+	if len(keypoints) > 0 {
+		percentage = float64(len(visited)) / float64(len(keypoints)) * 100
+	}
+
+	return models.TourExecutionHistory{
+		ID:                   execution.ID,
+		TourID:               execution.TourID,
+		TourName:             tourName,
+		Status:               execution.Status,
+		StartedAt:            execution.CreatedAt,
+		LastActivityAt:       execution.LastActivityAt,
+		CompletionPercentage: math.Round(percentage*100) / 100,
+		TimeSpentMinutes:     math.Round(execution.LastActivityAt.Sub(execution.CreatedAt).Minutes()*100) / 100,
+		DistanceCoveredKm:    math.Round(distance*1000) / 1000,
+		KeyPoints:            progress,
+	}
+}
+
+func CalculateTourExecutionStats(tourID uuid.UUID) (*models.TourExecutionStats, error) {
+	var executions []models.TourExecution
+	if err := database.GORM_DB.Preload("CompletedKeyPoints").Where("tour_id = ?", tourID).Find(&executions).Error; err != nil {
+		return nil, err
+	}
+
+	var keypoints []models.KeyPoint
+	if err := database.GORM_DB.Where("tour_id = ?", tourID).Order("position asc").Find(&keypoints).Error; err != nil {
+		return nil, err
+	}
+
+	stats := &models.TourExecutionStats{
+		TourID: tourID,
+		Starts: len(executions),
+	}
+
+	var completionMinutes []float64
+	dropOffs := make(map[uuid.UUID]int)
+	for _, execution := range executions {
+		switch execution.Status {
+		case models.StatusCompleted:
+			stats.Completions++
+			completionMinutes = append(completionMinutes, execution.LastActivityAt.Sub(execution.CreatedAt).Minutes())
+		case models.StatusAbandoned:
+			stats.Abandonments++
+			if kp := firstUnvisitedKeyPoint(execution, keypoints); kp != nil {
+				dropOffs[kp.ID]++
+			}
+		default:
+			stats.InProgress++
+		}
+	}
+
+	if finished := stats.Completions + stats.Abandonments; finished > 0 {
+		stats.AbandonmentRate = math.Round(float64(stats.Abandonments)/float64(finished)*10000) / 100
+	}
+	stats.MedianCompletionMinutes = math.Round(median(completionMinutes)*100) / 100
+
+	// Tacka na kojoj turisti najcesce odustaju; kod istog broja prednost ima ranija tacka
+	for _, kp := range keypoints {
+		if count := dropOffs[kp.ID]; count > stats.DropOffAbandonments {
+			id := kp.ID
+			stats.DropOffKeyPointID = &id
+			stats.DropOffKeyPointName = kp.Name
+			stats.DropOffAbandonments = count
+		}
+	}
+
+	return stats, nil
+}
+
+func firstUnvisitedKeyPoint(execution models.TourExecution, keypoints []models.KeyPoint) *models.KeyPoint {
+	completed := make(map[uuid.UUID]bool, len(execution.CompletedKeyPoints))
+	for _, ckp := range execution.CompletedKeyPoints {
+		completed[ckp.KeyPointID] = true
+	}
+	for i := range keypoints {
+		if !completed[keypoints[i].ID] {
+			return &keypoints[i]
+		}
+	}
+	return nil
+}
+
+func median(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
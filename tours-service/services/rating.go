@@ -0,0 +1,61 @@
+package services
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"tours-service/models"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// RefreshTourRating ponovo racuna prosek, broj i histogram ocena ture iz
+// vidljivih recenzija. Poziva se unutar iste transakcije u kojoj se recenzija
+// kreira, menja ili brise, a red ture se zakljucava da bi paralelne izmene
+// bile serijalizovane.
+func RefreshTourRating(tx *gorm.DB, tourID uuid.UUID) error {
+	var tour models.Tour
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&tour, "id = ?", tourID).Error; err != nil {
+		return err
+	}
+
+	var rows []struct {
+		Rating int
+		Count  int
+	}
+	err := tx.Model(&models.Review{}).
+		Select("rating, COUNT(*) AS count").
+		Where("tour_id = ? AND hidden = ?", tourID, false).
+		Group("rating").
+		Scan(&rows).Error
+	if err != nil {
+		return err
+	}
+
+	histogram := map[string]int{"1": 0, "2": 0, "3": 0, "4": 0, "5": 0}
+	var count, sum int
+	for _, row := range rows {
+		histogram[strconv.Itoa(row.Rating)] = row.Count
+		count += row.Count
+		sum += row.Rating * row.Count
+	}
+
+	var average float64
+	if count > 0 {
+		average = math.Round(float64(sum)/float64(count)*100) / 100
+	}
+
+	histogramJSON, err := json.Marshal(histogram)
+	if err != nil {
+		return err
+	}
+
+	return tx.Model(&models.Tour{}).Where("id = ?", tourID).Updates(map[string]any{
+		"average_rating":   average,
+		"rating_count":     count,
+		"rating_histogram": datatypes.JSON(histogramJSON),
+	}).Error
+}